@@ -107,6 +107,16 @@ func (acc *Account) Copy() *Account {
 	accCopy := *acc
 	accCopy.Permissions.Roles = make([]string, len(acc.Permissions.Roles))
 	copy(accCopy.Permissions.Roles, acc.Permissions.Roles)
+	permsClone := acc.Permissions.Clone()
+	accCopy.Permissions.CallACL = permsClone.CallACL
+	accCopy.Permissions.Grants = permsClone.Grants
+	if acc.Multisig != nil {
+		accCopy.Multisig = &Multisig{
+			Threshold:  acc.Multisig.Threshold,
+			PublicKeys: make([]crypto.PublicKey, len(acc.Multisig.PublicKeys)),
+		}
+		copy(accCopy.Multisig.PublicKeys, acc.Multisig.PublicKeys)
+	}
 	return &accCopy
 }
 
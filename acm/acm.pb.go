@@ -9,6 +9,7 @@
 
 	It has these top-level messages:
 		Account
+		Multisig
 */
 package acm
 
@@ -43,6 +44,10 @@ type Account struct {
 	Balance     uint64                                       `protobuf:"varint,4,opt,name=Balance,proto3" json:"Balance,omitempty"`
 	Code        Bytecode                                     `protobuf:"bytes,5,opt,name=Code,proto3,customtype=Bytecode" json:"Code"`
 	Permissions permission.AccountPermissions                `protobuf:"bytes,6,opt,name=Permissions" json:"Permissions"`
+	// Metadata (such as the ABI) of the contract whose code this account holds
+	ContractMeta string `protobuf:"bytes,7,opt,name=ContractMeta,proto3" json:"ContractMeta,omitempty"`
+	// Set for a multisig account, whose transactions must be signed by the threshold number of its keys
+	Multisig *Multisig `protobuf:"bytes,8,opt,name=Multisig" json:"Multisig,omitempty"`
 }
 
 func (m *Account) Reset()                    { *m = Account{} }
@@ -77,12 +82,57 @@ func (m *Account) GetPermissions() permission.AccountPermissions {
 	return permission.AccountPermissions{}
 }
 
+func (m *Account) GetContractMeta() string {
+	if m != nil {
+		return m.ContractMeta
+	}
+	return ""
+}
+
+func (m *Account) GetMultisig() *Multisig {
+	if m != nil {
+		return m.Multisig
+	}
+	return nil
+}
+
 func (*Account) XXX_MessageName() string {
 	return "acm.Account"
 }
+
+type Multisig struct {
+	// The number of keys that must sign
+	Threshold  uint64             `protobuf:"varint,1,opt,name=Threshold,proto3" json:"Threshold,omitempty"`
+	PublicKeys []crypto.PublicKey `protobuf:"bytes,2,rep,name=PublicKeys" json:"PublicKeys"`
+}
+
+func (m *Multisig) Reset()                    { *m = Multisig{} }
+func (m *Multisig) String() string            { return proto.CompactTextString(m) }
+func (*Multisig) ProtoMessage()               {}
+func (*Multisig) Descriptor() ([]byte, []int) { return fileDescriptorAcm, []int{1} }
+
+func (m *Multisig) GetThreshold() uint64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *Multisig) GetPublicKeys() []crypto.PublicKey {
+	if m != nil {
+		return m.PublicKeys
+	}
+	return nil
+}
+
+func (*Multisig) XXX_MessageName() string {
+	return "acm.Multisig"
+}
 func init() {
 	proto.RegisterType((*Account)(nil), "acm.Account")
 	golang_proto.RegisterType((*Account)(nil), "acm.Account")
+	proto.RegisterType((*Multisig)(nil), "acm.Multisig")
+	golang_proto.RegisterType((*Multisig)(nil), "acm.Multisig")
 }
 func (m *Account) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -141,6 +191,57 @@ func (m *Account) MarshalTo(dAtA []byte) (int, error) {
 		return 0, err
 	}
 	i += n4
+	if len(m.ContractMeta) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintAcm(dAtA, i, uint64(len(m.ContractMeta)))
+		i += copy(dAtA[i:], m.ContractMeta)
+	}
+	if m.Multisig != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintAcm(dAtA, i, uint64(m.Multisig.Size()))
+		n5, err := m.Multisig.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	return i, nil
+}
+
+func (m *Multisig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Multisig) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintAcm(dAtA, i, uint64(m.Threshold))
+	}
+	if len(m.PublicKeys) > 0 {
+		for _, msg := range m.PublicKeys {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintAcm(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -170,6 +271,29 @@ func (m *Account) Size() (n int) {
 	n += 1 + l + sovAcm(uint64(l))
 	l = m.Permissions.Size()
 	n += 1 + l + sovAcm(uint64(l))
+	l = len(m.ContractMeta)
+	if l > 0 {
+		n += 1 + l + sovAcm(uint64(l))
+	}
+	if m.Multisig != nil {
+		l = m.Multisig.Size()
+		n += 1 + l + sovAcm(uint64(l))
+	}
+	return n
+}
+
+func (m *Multisig) Size() (n int) {
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		n += 1 + sovAcm(uint64(m.Threshold))
+	}
+	if len(m.PublicKeys) > 0 {
+		for _, e := range m.PublicKeys {
+			l = e.Size()
+			n += 1 + l + sovAcm(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -373,6 +497,168 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractMeta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAcm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAcm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractMeta = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multisig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAcm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAcm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Multisig == nil {
+				m.Multisig = &Multisig{}
+			}
+			if err := m.Multisig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAcm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAcm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Multisig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAcm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Multisig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Multisig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAcm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAcm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAcm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKeys = append(m.PublicKeys, crypto.PublicKey{})
+			if err := m.PublicKeys[len(m.PublicKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAcm(dAtA[iNdEx:])
@@ -503,26 +789,30 @@ func init() { proto.RegisterFile("acm.proto", fileDescriptorAcm) }
 func init() { golang_proto.RegisterFile("acm.proto", fileDescriptorAcm) }
 
 var fileDescriptorAcm = []byte{
-	// 323 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xbd, 0x4e, 0xfb, 0x30,
-	0x14, 0xc5, 0xeb, 0x36, 0xff, 0x7e, 0xb8, 0x1d, 0xfa, 0xf7, 0x64, 0x75, 0x70, 0x0b, 0x62, 0xe8,
-	0x00, 0x89, 0xc4, 0x87, 0x90, 0xd8, 0x1a, 0x24, 0x16, 0x24, 0x54, 0x85, 0x8d, 0x2d, 0x71, 0x2e,
-	0x69, 0xa4, 0xa6, 0x0e, 0x4e, 0x2c, 0x94, 0x37, 0x61, 0xe4, 0x39, 0x98, 0x18, 0x3b, 0x32, 0x33,
-	0x54, 0x28, 0x7d, 0x11, 0x54, 0xe3, 0x86, 0x4c, 0x6c, 0x3e, 0xfe, 0xdd, 0x73, 0xef, 0xd1, 0xc1,
-	0x3d, 0x9f, 0x27, 0x76, 0x2a, 0x45, 0x2e, 0x48, 0xcb, 0xe7, 0xc9, 0xe8, 0x24, 0x8a, 0xf3, 0x85,
-	0x0a, 0x6c, 0x2e, 0x12, 0x27, 0x12, 0x91, 0x70, 0x34, 0x0b, 0xd4, 0xa3, 0x56, 0x5a, 0xe8, 0xd7,
-	0x8f, 0x67, 0x34, 0x4c, 0x41, 0x26, 0x71, 0x96, 0xc5, 0x62, 0x65, 0x7e, 0x06, 0x5c, 0x16, 0x69,
-	0x6e, 0xf8, 0xe1, 0x5b, 0x13, 0x77, 0x66, 0x9c, 0x0b, 0xb5, 0xca, 0xc9, 0x1d, 0xee, 0xcc, 0xc2,
-	0x50, 0x42, 0x96, 0x51, 0x34, 0x41, 0xd3, 0x81, 0x7b, 0xbe, 0xde, 0x8c, 0x1b, 0x9f, 0x9b, 0xf1,
-	0x71, 0xed, 0xe6, 0xa2, 0x48, 0x41, 0x2e, 0x21, 0x8c, 0x40, 0x3a, 0x81, 0x92, 0x52, 0x3c, 0x3b,
-	0x66, 0xa1, 0xf1, 0x7a, 0xfb, 0x25, 0xe4, 0x02, 0xf7, 0xe6, 0x2a, 0x58, 0xc6, 0xfc, 0x16, 0x0a,
-	0xda, 0x9c, 0xa0, 0x69, 0xff, 0xf4, 0xbf, 0x6d, 0x86, 0x2b, 0xe0, 0x5a, 0xbb, 0x23, 0xde, 0xef,
-	0x24, 0x19, 0xe1, 0xee, 0x3d, 0x3c, 0x29, 0x58, 0x71, 0xa0, 0xad, 0x09, 0x9a, 0x5a, 0x5e, 0xa5,
-	0x09, 0xc5, 0x1d, 0xd7, 0x5f, 0xfa, 0x3b, 0x64, 0x69, 0xb4, 0x97, 0xe4, 0x08, 0x5b, 0xd7, 0x22,
-	0x04, 0xfa, 0x4f, 0x27, 0x1f, 0x9a, 0xe4, 0x5d, 0xb7, 0xc8, 0x81, 0x8b, 0x10, 0x3c, 0x4d, 0xc9,
-	0x0d, 0xee, 0xcf, 0xab, 0x42, 0x32, 0xda, 0xd6, 0xa1, 0x98, 0x5d, 0x2b, 0xc9, 0x94, 0x51, 0x9b,
-	0x32, 0x09, 0xeb, 0xc6, 0x2b, 0xeb, 0xe5, 0x75, 0xdc, 0x70, 0x2f, 0xd7, 0x25, 0x43, 0x1f, 0x25,
-	0x43, 0x5f, 0x25, 0x43, 0xef, 0x5b, 0x86, 0xd6, 0x5b, 0x86, 0x1e, 0x0e, 0xfe, 0x6e, 0xcb, 0xe7,
-	0x49, 0xd0, 0xd6, 0xe5, 0x9f, 0x7d, 0x07, 0x00, 0x00, 0xff, 0xff, 0x10, 0xec, 0x45, 0x82, 0xdd,
-	0x01, 0x00, 0x00,
+	// 397 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x4d, 0x8f, 0x94, 0x30,
+	0x18, 0xde, 0x2e, 0xb8, 0x40, 0x77, 0x4c, 0xd6, 0x9e, 0x9a, 0x89, 0x61, 0x70, 0xe2, 0x01, 0x13,
+	0x85, 0xc4, 0x8f, 0x6c, 0xe2, 0x6d, 0xd9, 0xc4, 0x8b, 0x59, 0x33, 0x41, 0x4f, 0xde, 0x4a, 0xa9,
+	0x40, 0x02, 0x14, 0xdb, 0x12, 0xc3, 0x4f, 0xf0, 0x1f, 0x78, 0xf4, 0xa7, 0x78, 0x9c, 0xa3, 0x67,
+	0x0f, 0x13, 0x33, 0xf3, 0x47, 0xcc, 0xd4, 0x0e, 0xc3, 0x5e, 0xe6, 0xc6, 0xfb, 0x7c, 0xf4, 0x7d,
+	0xf2, 0xbc, 0x40, 0x8f, 0xd0, 0x26, 0xea, 0x04, 0x57, 0x1c, 0x59, 0x84, 0x36, 0xf3, 0x17, 0x45,
+	0xa5, 0xca, 0x3e, 0x8b, 0x28, 0x6f, 0xe2, 0x82, 0x17, 0x3c, 0xd6, 0x5c, 0xd6, 0x7f, 0xd1, 0x93,
+	0x1e, 0xf4, 0xd7, 0x7f, 0xcf, 0xfc, 0xaa, 0x63, 0xa2, 0xa9, 0xa4, 0xac, 0x78, 0x6b, 0x90, 0x19,
+	0x15, 0x43, 0xa7, 0x0c, 0xbf, 0xfc, 0x6e, 0x41, 0xe7, 0x86, 0x52, 0xde, 0xb7, 0x0a, 0x7d, 0x80,
+	0xce, 0x4d, 0x9e, 0x0b, 0x26, 0x25, 0x06, 0x01, 0x08, 0x67, 0xc9, 0xeb, 0xf5, 0x66, 0x71, 0xf6,
+	0x67, 0xb3, 0x78, 0x3e, 0xd9, 0x59, 0x0e, 0x1d, 0x13, 0x35, 0xcb, 0x0b, 0x26, 0xe2, 0xac, 0x17,
+	0x82, 0x7f, 0x8b, 0xcd, 0x83, 0xc6, 0x9b, 0x1e, 0x1e, 0x41, 0x6f, 0xa0, 0xb7, 0xea, 0xb3, 0xba,
+	0xa2, 0xef, 0xd9, 0x80, 0xcf, 0x03, 0x10, 0x5e, 0xbe, 0x7c, 0x14, 0x19, 0xf1, 0x48, 0x24, 0xf6,
+	0x7e, 0x49, 0x7a, 0x54, 0xa2, 0x39, 0x74, 0x3f, 0xb2, 0xaf, 0x3d, 0x6b, 0x29, 0xc3, 0x56, 0x00,
+	0x42, 0x3b, 0x1d, 0x67, 0x84, 0xa1, 0x93, 0x90, 0x9a, 0xec, 0x29, 0x5b, 0x53, 0x87, 0x11, 0x3d,
+	0x85, 0xf6, 0x2d, 0xcf, 0x19, 0x7e, 0xa0, 0x93, 0x5f, 0x99, 0xe4, 0x6e, 0x32, 0x28, 0x46, 0x79,
+	0xce, 0x52, 0xcd, 0xa2, 0x77, 0xf0, 0x72, 0x35, 0x16, 0x22, 0xf1, 0x85, 0x0e, 0xe5, 0x47, 0x93,
+	0x92, 0x4c, 0x19, 0x13, 0x95, 0x49, 0x38, 0x35, 0xa2, 0x25, 0x9c, 0xdd, 0xf2, 0x56, 0x09, 0x42,
+	0xd5, 0x1d, 0x53, 0x04, 0x3b, 0x01, 0x08, 0xbd, 0xf4, 0x1e, 0x86, 0x9e, 0x41, 0xf7, 0xae, 0xaf,
+	0x55, 0x25, 0xab, 0x02, 0xbb, 0x7a, 0xd1, 0xc3, 0x68, 0x7f, 0xcc, 0x03, 0x98, 0x8e, 0xf4, 0x5b,
+	0xfb, 0xc7, 0xcf, 0xc5, 0xd9, 0x92, 0x1c, 0x0d, 0xe8, 0x31, 0xf4, 0x3e, 0x95, 0x82, 0xc9, 0x92,
+	0xd7, 0xb9, 0xbe, 0x86, 0x9d, 0x1e, 0x01, 0x74, 0x0d, 0xe1, 0xd8, 0x97, 0xc4, 0xe7, 0x81, 0x75,
+	0xaa, 0xda, 0x89, 0x34, 0xb9, 0x5e, 0x6f, 0x7d, 0xf0, 0x7b, 0xeb, 0x83, 0xbf, 0x5b, 0x1f, 0xfc,
+	0xda, 0xf9, 0x60, 0xbd, 0xf3, 0xc1, 0xe7, 0x27, 0xa7, 0xef, 0x4b, 0x68, 0x93, 0x5d, 0xe8, 0xdf,
+	0xe5, 0xd5, 0xbf, 0x01, 0x00, 0x00, 0x41, 0xc5, 0x8a, 0x8f, 0x02, 0x00, 0x00,
 }
@@ -0,0 +1,81 @@
+package acm
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"sort"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+)
+
+// The most keys a multisig account may have, which bounds the work of verifying its transactions
+const MaxMultisigPublicKeys = 32
+
+// NewMultisig returns a multisig requiring threshold of publicKeys to sign, with publicKeys in a canonical order so that
+// the same keys and threshold always give the same address
+func NewMultisig(threshold uint64, publicKeys ...crypto.PublicKey) (*Multisig, error) {
+	ms := &Multisig{
+		Threshold:  threshold,
+		PublicKeys: make([]crypto.PublicKey, len(publicKeys)),
+	}
+	copy(ms.PublicKeys, publicKeys)
+	sort.Slice(ms.PublicKeys, func(i, j int) bool {
+		return ms.PublicKeys[i].GetAddress().String() < ms.PublicKeys[j].GetAddress().String()
+	})
+	err := ms.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return ms, nil
+}
+
+func (ms *Multisig) Validate() error {
+	if ms.Threshold == 0 {
+		return fmt.Errorf("multisig threshold must be at least 1")
+	}
+	if ms.Threshold > uint64(len(ms.PublicKeys)) {
+		return fmt.Errorf("multisig threshold %d is greater than its number of public keys %d", ms.Threshold,
+			len(ms.PublicKeys))
+	}
+	if len(ms.PublicKeys) > MaxMultisigPublicKeys {
+		return fmt.Errorf("multisig has %d public keys but may have at most %d", len(ms.PublicKeys),
+			MaxMultisigPublicKeys)
+	}
+	addresses := make(map[crypto.Address]bool)
+	for _, publicKey := range ms.PublicKeys {
+		if !publicKey.IsValid() {
+			return fmt.Errorf("multisig public key %v is invalid", publicKey)
+		}
+		address := publicKey.GetAddress()
+		if addresses[address] {
+			return fmt.Errorf("multisig public key %v appears more than once", publicKey)
+		}
+		addresses[address] = true
+	}
+	return nil
+}
+
+// Address derived from the multisig's threshold and public keys, which can be used for a new multisig account
+func (ms *Multisig) Address() (address crypto.Address) {
+	hasher := sha256.New()
+	bs := make([]byte, 8)
+	binary.PutUint64BE(bs, ms.Threshold)
+	hasher.Write(bs)
+	for _, publicKey := range ms.PublicKeys {
+		hasher.Write([]byte{byte(publicKey.CurveType)})
+		hasher.Write(publicKey.PublicKey)
+	}
+	copy(address[:], hasher.Sum(nil))
+	return
+}
+
+// Returns the public key with address if it is one of the multisig's keys
+func (ms *Multisig) PublicKey(address crypto.Address) (crypto.PublicKey, bool) {
+	for _, publicKey := range ms.PublicKeys {
+		if publicKey.GetAddress() == address {
+			return publicKey, true
+		}
+	}
+	return crypto.PublicKey{}, false
+}
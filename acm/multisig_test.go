@@ -0,0 +1,58 @@
+package acm
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMultisig(t *testing.T) {
+	keys := []crypto.PublicKey{
+		GeneratePrivateAccountFromSecret("alice").GetPublicKey(),
+		GeneratePrivateAccountFromSecret("bob").GetPublicKey(),
+		GeneratePrivateAccountFromSecret("carol").GetPublicKey(),
+	}
+	ms, err := NewMultisig(2, keys...)
+	require.NoError(t, err)
+	reordered, err := NewMultisig(2, keys[2], keys[0], keys[1])
+	require.NoError(t, err)
+	assert.Equal(t, ms, reordered)
+	assert.Equal(t, ms.Address(), reordered.Address())
+
+	other, err := NewMultisig(3, keys...)
+	require.NoError(t, err)
+	assert.NotEqual(t, ms.Address(), other.Address())
+
+	publicKey, ok := ms.PublicKey(keys[1].GetAddress())
+	assert.True(t, ok)
+	assert.Equal(t, keys[1], publicKey)
+
+	_, err = NewMultisig(0, keys...)
+	assert.Error(t, err)
+	_, err = NewMultisig(4, keys...)
+	assert.Error(t, err)
+	_, err = NewMultisig(2, keys[0], keys[0])
+	assert.Error(t, err)
+}
+
+func TestEncodeMultisigAccount(t *testing.T) {
+	ms, err := NewMultisig(1, GeneratePrivateAccountFromSecret("alice").GetPublicKey(),
+		GeneratePrivateAccountFromSecret("bob").GetPublicKey())
+	require.NoError(t, err)
+	acc := &Account{
+		Address:  ms.Address(),
+		Balance:  100,
+		Multisig: ms,
+	}
+	bs, err := acc.Encode()
+	require.NoError(t, err)
+	accOut, err := Decode(bs)
+	require.NoError(t, err)
+	assert.Equal(t, ms, accOut.Multisig)
+
+	accCopy := acc.Copy()
+	accCopy.Multisig.Threshold = 2
+	assert.Equal(t, uint64(1), acc.Multisig.Threshold)
+}
@@ -27,6 +27,24 @@ type AddressableSigner interface {
 	crypto.Signer
 }
 
+// RotatedSigner returns an AddressableSigner that signs for the account at address, whose key has been rotated to
+// the key of signer
+func RotatedSigner(address crypto.Address, signer AddressableSigner) AddressableSigner {
+	return &rotatedSigner{
+		AddressableSigner: signer,
+		address:           address,
+	}
+}
+
+type rotatedSigner struct {
+	AddressableSigner
+	address crypto.Address
+}
+
+func (rs *rotatedSigner) GetAddress() crypto.Address {
+	return rs.address
+}
+
 type PrivateAccount struct {
 	concretePrivateAccount *ConcretePrivateAccount
 }
@@ -34,6 +34,11 @@ type ReaderWriter interface {
 	Writer
 }
 
+type ReaderAlterer interface {
+	Reader
+	Alterer
+}
+
 type IterableReaderWriter interface {
 	ReaderWriter
 	Iterable
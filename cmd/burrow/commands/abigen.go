@@ -0,0 +1,43 @@
+package commands
+
+import (
+	"io/ioutil"
+
+	"github.com/hyperledger/burrow/deploy/abigen"
+	cli "github.com/jawher/mow.cli"
+)
+
+// Abigen generates Go bindings to contracts
+func Abigen(output Output) func(cmd *cli.Cmd) {
+	return func(cmd *cli.Cmd) {
+		packageOpt := cmd.StringOpt("p package", "bindings", "Go package to declare the bindings in")
+		outputOpt := cmd.StringOpt("o output", "", "File to write the bindings to, by default they are printed")
+		filesArg := cmd.StringsArg("FILE", nil, "Contract .bin files written by burrow deploy or ABI JSON files, "+
+			"a binding is generated for each named after the file")
+
+		cmd.Spec = "[--package=<package>] [--output=<file>] FILE..."
+
+		cmd.Action = func() {
+			var contracts []*abigen.Contract
+			for _, file := range *filesArg {
+				contract, err := abigen.LoadContract(file)
+				if err != nil {
+					output.Fatalf("could not load contract from %s: %v", file, err)
+				}
+				contracts = append(contracts, contract)
+			}
+			src, err := abigen.Generate(*packageOpt, contracts...)
+			if err != nil {
+				output.Fatalf("%v", err)
+			}
+			if *outputOpt == "" {
+				output.Printf("%s", src)
+				return
+			}
+			err = ioutil.WriteFile(*outputOpt, src, 0644)
+			if err != nil {
+				output.Fatalf("could not write bindings to %s: %v", *outputOpt, err)
+			}
+		}
+	}
+}
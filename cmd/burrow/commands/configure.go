@@ -41,6 +41,12 @@ func Configure(output Output) func(cmd *cli.Cmd) {
 
 		keysDir := cmd.StringOpt("keys-dir", "", "Directory where keys are stored")
 
+		pkcs11ModuleOpt := cmd.StringOpt("pkcs11-module", "", "PKCS#11 module (shared library) of a token, "+
+			"such as an HSM, to generate and hold keys in instead of the keys directory")
+
+		pkcs11TokenOpt := cmd.StringOpt("pkcs11-token", "", fmt.Sprintf("Label of the PKCS#11 token to use, "+
+			"whose PIN is read from $%s", keys.PKCS11PINEnv))
+
 		generateNodeKeys := cmd.BoolOpt("generate-node-keys", false, "Generate node keys for validators")
 
 		configTemplateIn := cmd.StringsOpt("config-template-in", nil,
@@ -70,7 +76,8 @@ func Configure(output Output) func(cmd *cli.Cmd) {
 
 		pool := cmd.BoolOpt("pool", false, "Write config files for all the validators called burrowNNN.toml")
 
-		cmd.Spec = "[--keys-url=<keys URL> | --keys-dir=<keys directory>] " +
+		cmd.Spec = "[--keys-url=<keys URL> | --keys-dir=<keys directory> | " +
+			"--pkcs11-module=<module> --pkcs11-token=<token label>] " +
 			"[ --config-template-in=<text template> --config-out=<output file>]... " +
 			"[--genesis-spec=<GenesisSpec file>] [--separate-genesis-doc=<genesis JSON file>] " +
 			"[--chain-name=<chain name>] [--generate-node-keys] [--restore-dump=<dump file>] " +
@@ -102,6 +109,13 @@ func Configure(output Output) func(cmd *cli.Cmd) {
 				conf.Keys.RemoteAddress = *keysURLOpt
 			}
 
+			if *pkcs11ModuleOpt != "" {
+				conf.Keys.PKCS11 = &keys.PKCS11Config{
+					Module:     *pkcs11ModuleOpt,
+					TokenLabel: *pkcs11TokenOpt,
+				}
+			}
+
 			if len(*configTemplateIn) != len(*configOut) {
 				output.Fatalf("--config-template-in and --config-out must be specified the same number of times")
 			}
@@ -118,7 +132,20 @@ func Configure(output Output) func(cmd *cli.Cmd) {
 				if *restoreDumpOpt != "" {
 					output.Fatalf("Cannot restore using GenesisSpec, please provide GenesisDoc")
 				}
-				if conf.Keys.RemoteAddress == "" {
+				if conf.Keys.PKCS11 != nil {
+					// Node keys are used by Tendermint directly so must be written to disk
+					if *generateNodeKeys || *pool {
+						output.Fatalf("Cannot generate node keys in a PKCS#11 token")
+					}
+					keyClient, err := keys.NewPKCS11KeyClient(conf.Keys.PKCS11, logging.NewNoopLogger())
+					if err != nil {
+						output.Fatalf("Could not create PKCS#11 key client: %v", err)
+					}
+					conf.GenesisDoc, err = genesisSpec.GenesisDoc(keyClient, false)
+					if err != nil {
+						output.Fatalf("Could not generate GenesisDoc from GenesisSpec using PKCS#11 token: %v", err)
+					}
+				} else if conf.Keys.RemoteAddress == "" {
 					dir := conf.Keys.KeysDirectory
 					if *keysDir != "" {
 						dir = *keysDir
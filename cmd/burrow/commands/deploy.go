@@ -8,6 +8,7 @@ import (
 
 	"github.com/go-kit/kit/log"
 	pkgs "github.com/hyperledger/burrow/deploy"
+	"github.com/hyperledger/burrow/deploy/compile"
 	"github.com/hyperledger/burrow/deploy/def"
 	"github.com/hyperledger/burrow/deploy/proposals"
 	"github.com/hyperledger/burrow/logging"
@@ -49,6 +50,9 @@ func Deploy(output Output) func(cmd *cli.Cmd) {
 		jobsOpt := cmd.IntOpt("j jobs", 1,
 			"default number of concurrent playbooks to run if multiple are specified")
 
+		parallelJobsOpt := cmd.IntOpt("parallel-jobs", 1,
+			"number of jobs within a playbook to run concurrently where they do not depend on one another")
+
 		addressOpt := cmd.StringOpt("a address", "",
 			"default address (or account name) to use; operates the same way as the [account] job, only before the deploy file is ran")
 
@@ -67,14 +71,36 @@ func Deploy(output Output) func(cmd *cli.Cmd) {
 
 		proposalCreate := cmd.BoolOpt("proposal-create", false, "Create new proposal")
 
+		proposalAgainst := cmd.BoolOpt("proposal-against", false, "Vote against proposal, do NOT create new proposal")
+
+		proposalAbstain := cmd.BoolOpt("proposal-abstain", false, "Abstain on proposal, do NOT create new proposal")
+
+		proposalWithdraw := cmd.BoolOpt("proposal-withdraw", false, "Withdraw vote on proposal, do NOT create new "+
+			"proposal")
+
 		timeoutSecondsOpt := cmd.IntOpt("t timeout", int(defaultChainTimeout/time.Second), "Timeout to talk to the chain in seconds")
 
 		proposalList := cmd.StringOpt("list-proposals state", "", "List proposals, either all, executed, expired, or current")
 
+		dryRunOpt := cmd.BoolOpt("dry-run", false, "Run playbooks against an in-memory fork of the chain's state "+
+			"without sending any transactions, then print a plan of the changes they would make")
+
+		forceOpt := cmd.BoolOpt("force", false, "Run every job even if the playbook's deployment state shows it "+
+			"has already completed with the same inputs")
+
+		solcDirOpt := cmd.StringOpt("solc-dir", compile.DefaultSolcDir(), "directory of solc binaries named for "+
+			"their version such as solc-0.5.4, from which the latest satisfying a contract's version pragma is used "+
+			"(solc on the path is also considered)")
+
+		compileCacheOpt := cmd.StringOpt("compile-cache", compile.DefaultCacheDir(), "directory to cache "+
+			"compiler outputs in so that unchanged contracts are not recompiled, pass an empty string to disable")
+
 		cmd.Spec = "[--chain=<host:port>] [--keys=<host:port>] [--mempool-signing] [--dir=<root directory>] " +
 			"[--output=<output file>] [--set=<KEY=VALUE>]... [--bin-path=<path>] [--gas=<gas>] " +
-			"[--jobs=<concurrent playbooks>] [--address=<address>] [--fee=<fee>] [--amount=<amount>] " +
-			"[--verbose] [--debug] [--timeout=<timeout>] [--proposal-create|--proposal-verify|--proposal-create] FILE..."
+			"[--jobs=<concurrent playbooks>] [--parallel-jobs=<concurrent jobs>] [--address=<address>] [--fee=<fee>] [--amount=<amount>] " +
+			"[--verbose] [--debug] [--timeout=<timeout>] " +
+			"[--proposal-create|--proposal-verify|--proposal-vote|--proposal-against|--proposal-abstain|--proposal-withdraw] " +
+			"[--dry-run] [--force] [--solc-dir=<dir>] [--compile-cache=<dir>] FILE..."
 
 		cmd.Action = func() {
 			args := new(def.DeployArgs)
@@ -105,9 +131,17 @@ func Deploy(output Output) func(cmd *cli.Cmd) {
 			args.Verbose = *verboseOpt
 			args.Debug = *debugOpt
 			args.Jobs = *jobsOpt
+			args.ParallelJobs = *parallelJobsOpt
 			args.ProposeVerify = *proposalVerify
 			args.ProposeVote = *proposalVote
 			args.ProposeCreate = *proposalCreate
+			args.ProposeAgainst = *proposalAgainst
+			args.ProposeAbstain = *proposalAbstain
+			args.ProposeWithdraw = *proposalWithdraw
+			args.DryRun = *dryRunOpt
+			args.Force = *forceOpt
+			args.SolcDir = *solcDirOpt
+			args.CompileCache = *compileCacheOpt
 			stderrLogger := log.NewLogfmtLogger(os.Stderr)
 			logger := logging.NewLogger(stderrLogger)
 			handleTerm()
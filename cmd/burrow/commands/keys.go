@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"bufio"
 	"context"
 	"encoding/hex"
 	"encoding/json"
@@ -15,6 +16,8 @@ import (
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/deployment"
 	"github.com/hyperledger/burrow/keys"
+	"github.com/hyperledger/burrow/keys/hd"
+	"github.com/hyperledger/burrow/logging/lifecycle"
 	cli "github.com/jawher/mow.cli"
 	"google.golang.org/grpc"
 )
@@ -36,10 +39,34 @@ func Keys(output Output) func(cmd *cli.Cmd) {
 			EnvVar: "BURROW_KEYS_PORT",
 		})
 
+		keysCA := cmd.String(cli.StringOpt{
+			Name:   "tls-ca",
+			Desc:   "connect to the key daemon over TLS, checking its certificate is signed by a CA in this PEM file",
+			EnvVar: "BURROW_KEYS_TLS_CA",
+		})
+
+		keysClientCert := cmd.String(cli.StringOpt{
+			Name:   "tls-client-cert",
+			Desc:   "certificate (PEM) to present to a key daemon that requires client certificates",
+			EnvVar: "BURROW_KEYS_TLS_CLIENT_CERT",
+		})
+
+		keysClientKey := cmd.String(cli.StringOpt{
+			Name:   "tls-client-key",
+			Desc:   "private key (PEM) of the client certificate",
+			EnvVar: "BURROW_KEYS_TLS_CLIENT_KEY",
+		})
+
 		grpcKeysClient := func(output Output) keys.KeysClient {
-			var opts []grpc.DialOption
-			opts = append(opts, grpc.WithInsecure())
-			conn, err := grpc.Dial(*keysHost+":"+*keysPort, opts...)
+			var tlsConf *keys.ClientTLSConfig
+			if *keysCA != "" || *keysClientCert != "" {
+				tlsConf = &keys.ClientTLSConfig{CAFile: *keysCA, CertFile: *keysClientCert, KeyFile: *keysClientKey}
+			}
+			opt, err := tlsConf.DialOption()
+			if err != nil {
+				output.Fatalf("Failed to configure TLS: %v", err)
+			}
+			conn, err := grpc.Dial(*keysHost+":"+*keysPort, opt)
 			if err != nil {
 				output.Fatalf("Failed to connect to grpc server: %v", err)
 			}
@@ -50,6 +77,12 @@ func Keys(output Output) func(cmd *cli.Cmd) {
 			keysDir := cmd.StringOpt("dir", "", "specify the location of the directory containing key files")
 			badPerm := cmd.BoolOpt("allow-bad-perm", false, "Allow unix key file permissions to be readable other than user")
 			configOpt := cmd.StringOpt("c config", "", "Use the a specified burrow config file")
+			tlsCert := cmd.StringOpt("tls-cert", "", "serve TLS with this certificate (PEM), overrides Keys.Server.TLSCertFile")
+			tlsKey := cmd.StringOpt("tls-key", "", "private key (PEM) of the TLS certificate, overrides Keys.Server.TLSKeyFile")
+			clientCA := cmd.StringOpt("client-ca", "", "require clients to present a certificate signed by a CA in this "+
+				"PEM file, overrides Keys.Server.ClientCAFile. Client policies are set in Keys.Server.Policies")
+			auditLog := cmd.StringOpt("audit-log", "", "file to write the audit log of requests that use keys to, "+
+				"overrides Keys.Server.AuditLog")
 
 			var conf *config.BurrowConfig
 
@@ -68,7 +101,28 @@ func Keys(output Output) func(cmd *cli.Cmd) {
 					conf.Keys.KeysDirectory = *keysDir
 				}
 
-				server := keys.StandAloneServer(conf.Keys.KeysDirectory, conf.Keys.AllowBadFilePermissions)
+				if conf.Keys.Server == nil {
+					conf.Keys.Server = new(keys.ServerConfig)
+				}
+				for opt, field := range map[*string]*string{
+					tlsCert:  &conf.Keys.Server.TLSCertFile,
+					tlsKey:   &conf.Keys.Server.TLSKeyFile,
+					clientCA: &conf.Keys.Server.ClientCAFile,
+					auditLog: &conf.Keys.Server.AuditLog,
+				} {
+					if *opt != "" {
+						*field = *opt
+					}
+				}
+
+				logger, err := lifecycle.NewLoggerFromLoggingConfig(conf.Logging)
+				if err != nil {
+					output.Fatalf("Could not create logger: %v", err)
+				}
+				server, err := keys.NewServer(conf.Keys, logger)
+				if err != nil {
+					output.Fatalf("Could not create keys server: %v", err)
+				}
 				address := fmt.Sprintf("%s:%s", *keysHost, *keysPort)
 				listener, err := net.Listen("tcp", address)
 				if err != nil {
@@ -88,12 +142,38 @@ func Keys(output Output) func(cmd *cli.Cmd) {
 
 			keyName := cmd.StringOpt("name", "", "name of key to use")
 
+			seed := cmd.StringOpt("seed", "", "derive the key from this seed (see 'burrow keys seed') rather than generating a random key")
+
+			path := cmd.StringOpt("path", "", "derivation path of the key, such as m/44'/60'/0'/0/0 for secp256k1; "+
+				"ed25519 keys can only be derived along hardened indices, such as m/44'/60'/0'/0'/0'")
+
+			count := cmd.IntOpt("count", 1, "derive this many keys at consecutive indices from the last index of path, "+
+				"naming each <name>-<index>")
+
+			cmd.Spec = "[-n] [--curvetype=<curve>] [--name=<name>] [--seed=<seed> --path=<path> [--count=<count>]]"
+
 			cmd.Action = func() {
 				curve, err := crypto.CurveTypeFromString(*keyType)
 				if err != nil {
 					output.Fatalf("Unrecognised curve type %v", *keyType)
 				}
 
+				var paths []hd.Path
+				if *seed != "" {
+					basePath, err := hd.ParsePath(*path)
+					if err != nil {
+						output.Fatalf("%v", err)
+					}
+					if len(basePath) == 0 {
+						output.Fatalf("Cannot derive keys along the master path m")
+					}
+					for i := 0; i < *count; i++ {
+						p := append(hd.Path{}, basePath...)
+						p[len(p)-1] += uint32(i)
+						paths = append(paths, p)
+					}
+				}
+
 				var password string
 				if !*noPassword {
 					fmt.Printf("Enter Password:")
@@ -104,15 +184,96 @@ func Keys(output Output) func(cmd *cli.Cmd) {
 					password = string(pwd)
 				}
 
+				c := grpcKeysClient(output)
+				if len(paths) == 0 {
+					ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+					defer cancel()
+					resp, err := c.GenerateKey(ctx, &keys.GenRequest{Passphrase: password, CurveType: curve.String(), KeyName: *keyName})
+					if err != nil {
+						output.Fatalf("failed to generate key: %v", err)
+					}
+
+					fmt.Printf("%v\n", resp.GetAddress())
+					return
+				}
+
+				for _, p := range paths {
+					name := *keyName
+					if name != "" && len(paths) > 1 {
+						name = fmt.Sprintf("%s-%d", name, p[len(p)-1]%hd.HardenedOffset)
+					}
+					ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+					resp, err := c.GenerateKey(ctx, &keys.GenRequest{Passphrase: password, CurveType: curve.String(),
+						KeyName: name, Seed: *seed, Path: p.String()})
+					cancel()
+					if err != nil {
+						output.Fatalf("failed to derive key %v: %v", p, err)
+					}
+
+					if len(paths) > 1 {
+						fmt.Printf("%v %v\n", p, resp.GetAddress())
+					} else {
+						fmt.Printf("%v\n", resp.GetAddress())
+					}
+				}
+			}
+		})
+
+		cmd.Command("seed", "Generates or recovers a BIP-39 mnemonic seed from which keys can be derived", func(cmd *cli.Cmd) {
+			noPassword := cmd.BoolOpt("n no-password", false, "don't use a password for this seed")
+
+			recoverOpt := cmd.BoolOpt("recover", false, "recover the seed from a mnemonic read from stdin rather than generating a new mnemonic")
+
+			entropyBits := cmd.IntOpt("entropy-bits", hd.DefaultEntropyBits, "bits of entropy of a new mnemonic, from 128 (12 words) to 256 (24 words)")
+
+			mnemonicPassphrase := cmd.BoolOpt("mnemonic-passphrase", false, "prompt for a BIP-39 passphrase to combine with the mnemonic")
+
+			name := cmd.StringArg("NAME", "", "name of the seed")
+
+			cmd.Action = func() {
+				var password string
+				if !*noPassword {
+					fmt.Printf("Enter Password:")
+					pwd, err := gopass.GetPasswdMasked()
+					if err != nil {
+						os.Exit(1)
+					}
+					password = string(pwd)
+				}
+
+				var bip39Passphrase string
+				if *mnemonicPassphrase {
+					fmt.Printf("Enter Mnemonic Passphrase:")
+					pwd, err := gopass.GetPasswdMasked()
+					if err != nil {
+						os.Exit(1)
+					}
+					bip39Passphrase = string(pwd)
+				}
+
+				var mnemonic string
+				if *recoverOpt {
+					fmt.Fprintf(os.Stderr, "Enter Mnemonic:")
+					line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+					if err != nil && line == "" {
+						output.Fatalf("failed to read mnemonic: %v", err)
+					}
+					mnemonic = line
+				}
+
 				c := grpcKeysClient(output)
 				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 				defer cancel()
-				resp, err := c.GenerateKey(ctx, &keys.GenRequest{Passphrase: password, CurveType: curve.String(), KeyName: *keyName})
+				resp, err := c.GenerateSeed(ctx, &keys.GenSeedRequest{Passphrase: password, Name: *name,
+					Mnemonic: mnemonic, MnemonicPassphrase: bip39Passphrase, EntropyBits: uint32(*entropyBits)})
 				if err != nil {
-					output.Fatalf("failed to generate key: %v", err)
+					output.Fatalf("failed to generate seed: %v", err)
 				}
 
-				fmt.Printf("%v\n", resp.GetAddress())
+				if resp.GetMnemonic() != "" {
+					fmt.Fprintln(os.Stderr, "Write down this mnemonic and keep it safe, it is the only way to recover keys derived from the seed:")
+					fmt.Printf("%s\n", resp.GetMnemonic())
+				}
 			}
 		})
 
@@ -152,8 +313,13 @@ func Keys(output Output) func(cmd *cli.Cmd) {
 			keyAddr := cmd.StringOpt("addr", "", "address of key to use")
 			passphrase := cmd.StringOpt("passphrase", "", "passphrase for encrypted key")
 			keyTemplate := cmd.StringOpt("t template", deployment.DefaultKeysExportFormat, "template for export key")
+			format := cmd.StringOpt("format", "template", "'template' to export the key with --template, or 'ethereum' "+
+				"to export a secp256k1 key as an Ethereum (V3) key file encrypted with --passphrase")
 
 			cmd.Action = func() {
+				if *format != "template" && *format != "ethereum" {
+					output.Fatalf("unknown export format %s, should be 'template' or 'ethereum'", *format)
+				}
 				c := grpcKeysClient(output)
 				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 				defer cancel()
@@ -167,6 +333,23 @@ func Keys(output Output) func(cmd *cli.Cmd) {
 					output.Fatalf("failed to convert address: %v", err)
 				}
 
+				if *format == "ethereum" {
+					curveType, err := crypto.CurveTypeFromString(resp.GetCurveType())
+					if err != nil {
+						output.Fatalf("failed to read curve type: %v", err)
+					}
+					key, err := keys.NewKeyFromPriv(curveType, resp.GetPrivatekey())
+					if err != nil {
+						output.Fatalf("failed to read key: %v", err)
+					}
+					keyJSON, err := keys.EncryptEthereumKey(*passphrase, key)
+					if err != nil {
+						output.Fatalf("failed to export Ethereum key: %v", err)
+					}
+					fmt.Printf("%s\n", keyJSON)
+					return
+				}
+
 				key := deployment.Key{
 					Name:       *keyName,
 					CurveType:  resp.GetCurveType(),
@@ -184,7 +367,7 @@ func Keys(output Output) func(cmd *cli.Cmd) {
 			}
 		})
 
-		cmd.Command("import", "import <priv key> | /path/to/keyfile | <key json>", func(cmd *cli.Cmd) {
+		cmd.Command("import", "import <priv key> | /path/to/keyfile | <key json> | <Ethereum (V3) key file>", func(cmd *cli.Cmd) {
 			curveType := cmd.StringOpt("t curvetype", "ed25519", "specify the curve type of key to create. Supports 'secp256k1' (ethereum),  'ed25519' (tendermint)")
 			noPassword := cmd.BoolOpt("n no-password", false, "don't use a password for this key")
 			key := cmd.StringArg("KEY", "", "private key, filename, or raw json")
@@ -211,7 +394,7 @@ func Keys(output Output) func(cmd *cli.Cmd) {
 				defer cancel()
 
 				if (*key)[:1] == "{" {
-					resp, err := c.ImportJSON(ctx, &keys.ImportJSONRequest{JSON: *key})
+					resp, err := c.ImportJSON(ctx, &keys.ImportJSONRequest{Passphrase: password, JSON: *key})
 					if err != nil {
 						output.Fatalf("failed to import json key: %v", err)
 					}
@@ -337,12 +520,13 @@ func Keys(output Output) func(cmd *cli.Cmd) {
 
 		cmd.Command("list", "list keys", func(cmd *cli.Cmd) {
 			name := cmd.StringOpt("name", "", "name or address of key to use")
+			seed := cmd.StringOpt("seed", "", "only list keys derived from this seed")
 
 			cmd.Action = func() {
 				c := grpcKeysClient(output)
 				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 				defer cancel()
-				resp, err := c.List(ctx, &keys.ListRequest{KeyName: *name})
+				resp, err := c.List(ctx, &keys.ListRequest{KeyName: *name, Seed: *seed})
 				if err != nil {
 					output.Fatalf("failed to list key: %v", err)
 				}
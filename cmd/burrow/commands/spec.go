@@ -26,6 +26,8 @@ func Spec(output Output) func(cmd *cli.Cmd) {
 		participantsOpt := cmd.IntOpt("p participant-accounts", 0, "Number of preset Participant type accounts")
 		chainNameOpt := cmd.StringOpt("n chain-name", "", "Default chain name")
 		proposalThresholdOpt := cmd.IntOpt("param-proposalthreshold", 3, "Number of votes required for a proposal to pass")
+		proposalQuorumOpt := cmd.IntOpt("param-proposalquorum", 0, "Number of votes for, against or abstaining "+
+			"required before a proposal can pass")
 
 		cmd.Spec = "[--name-prefix=<prefix for account names>][--full-accounts] [--validator-accounts] [--root-accounts] " +
 			"[--developer-accounts] [--participant-accounts] [--chain-name] [--toml] [BASE...]"
@@ -60,6 +62,7 @@ func Spec(output Output) func(cmd *cli.Cmd) {
 				genesisSpec.ChainName = *chainNameOpt
 			}
 			genesisSpec.Params.ProposalThreshold = uint64(*proposalThresholdOpt)
+			genesisSpec.Params.ProposalQuorum = uint64(*proposalQuorumOpt)
 			if *tomlOpt {
 				output.Printf(source.TOMLString(genesisSpec))
 			} else {
@@ -8,7 +8,6 @@ import (
 	"time"
 
 	"github.com/hyperledger/burrow/config/source"
-	"github.com/hyperledger/burrow/execution/evm/abi"
 	"github.com/hyperledger/burrow/vent/config"
 	"github.com/hyperledger/burrow/vent/logger"
 	"github.com/hyperledger/burrow/vent/service"
@@ -31,11 +30,15 @@ func Vent(output Output) func(cmd *cli.Cmd) {
 				grpcAddrOpt := cmd.StringOpt("grpc-addr", cfg.GRPCAddr, "Address to connect to the Hyperledger Burrow gRPC server")
 				httpAddrOpt := cmd.StringOpt("http-addr", cfg.HTTPAddr, "Address to bind the HTTP server")
 				logLevelOpt := cmd.StringOpt("log-level", cfg.LogLevel, "Logging level (error, warn, info, debug)")
-				abiFileOpt := cmd.StringsOpt("abi", cfg.AbiFileOrDirs, "EVM Contract ABI file or folder")
+				abiFileOpt := cmd.StringsOpt("abi", cfg.AbiFileOrDirs, "EVM Contract ABI file or folder, ABIs stored on chain are used for contracts not covered")
 				specFileOrDirOpt := cmd.StringsOpt("spec", cfg.SpecFileOrDirs, "SQLSol specification file or folder")
 				dbBlockTxOpt := cmd.BoolOpt("db-block", cfg.DBBlockTx, "Create block & transaction tables and persist related data (true/false)")
 
 				announceEveryOpt := cmd.StringOpt("announce-every", "5s", "Announce vent status every period as a Go duration, e.g. 1ms, 3s, 1h")
+				watchEveryOpt := cmd.StringOpt("watch-every", "", "Check spec and ABI files for changes to apply live every period as a Go duration, e.g. 1ms, 3s, 1h")
+				sinkOpt := cmd.StringOpt("sink", cfg.Sink, "Sink to write rows to, 'sql' (the database given by the db options), 'jsonfile' or 'webhook'")
+				sinkTargetOpt := cmd.StringOpt("sink-target", cfg.SinkTarget, "File to append rows to (jsonfile) or URL to post rows to (webhook)")
+				sinkCheckpointOpt := cmd.StringOpt("sink-checkpoint", cfg.SinkCheckpoint, "File to keep the last block written to a jsonfile or webhook sink in")
 
 				cmd.Before = func() {
 					// Rather annoying boilerplate here... but there is no way to pass mow.cli a pointer for it to fill you value
@@ -48,6 +51,9 @@ func Vent(output Output) func(cmd *cli.Cmd) {
 					cfg.AbiFileOrDirs = *abiFileOpt
 					cfg.SpecFileOrDirs = *specFileOrDirOpt
 					cfg.DBBlockTx = *dbBlockTxOpt
+					cfg.Sink = *sinkOpt
+					cfg.SinkTarget = *sinkTargetOpt
+					cfg.SinkCheckpoint = *sinkCheckpointOpt
 
 					if *announceEveryOpt != "" {
 						var err error
@@ -56,10 +62,19 @@ func Vent(output Output) func(cmd *cli.Cmd) {
 							output.Fatalf("could not parse announce-every duration %s: %v", *announceEveryOpt, err)
 						}
 					}
+
+					if *watchEveryOpt != "" {
+						var err error
+						cfg.WatchEvery, err = time.ParseDuration(*watchEveryOpt)
+						if err != nil {
+							output.Fatalf("could not parse watch-every duration %s: %v", *watchEveryOpt, err)
+						}
+					}
 				}
 
-				cmd.Spec = "--spec=<spec file or dir> --abi=<abi file or dir> [--db-adapter] [--db-url] [--db-schema] " +
-					"[--db-block] [--grpc-addr] [--http-addr] [--log-level] [--announce-every=<duration>]"
+				cmd.Spec = "--spec=<spec file or dir> [--abi=<abi file or dir>] [--db-adapter] [--db-url] [--db-schema] " +
+					"[--db-block] [--grpc-addr] [--http-addr] [--log-level] [--announce-every=<duration>] " +
+					"[--watch-every=<duration>] [--sink] [--sink-target] [--sink-checkpoint]"
 
 				cmd.Action = func() {
 					log := logger.NewLogger(cfg.LogLevel)
@@ -70,7 +85,7 @@ func Vent(output Output) func(cmd *cli.Cmd) {
 					if err != nil {
 						output.Fatalf("Spec loader error: %v", err)
 					}
-					abiSpec, err := abi.LoadPath(cfg.AbiFileOrDirs...)
+					abiSpec, err := service.LoadAbiSpec(cfg.AbiFileOrDirs)
 					if err != nil {
 						output.Fatalf("ABI loader error: %v", err)
 					}
@@ -115,6 +130,90 @@ func Vent(output Output) func(cmd *cli.Cmd) {
 				}
 			})
 
+		cmd.Command("rewind", "Revert the Vent database to its state at a given height, the consumer resumes from there",
+			func(cmd *cli.Cmd) {
+				cfg := config.DefaultVentConfig()
+
+				dbAdapterOpt := cmd.StringOpt("db-adapter", cfg.DBAdapter, "Database adapter, 'postgres' or 'sqlite' (if built with the sqlite tag) are supported")
+				dbURLOpt := cmd.StringOpt("db-url", cfg.DBURL, "PostgreSQL database URL or SQLite db file path")
+				dbSchemaOpt := cmd.StringOpt("db-schema", cfg.DBSchema, "PostgreSQL database schema (empty for SQLite)")
+				grpcAddrOpt := cmd.StringOpt("grpc-addr", cfg.GRPCAddr, "Address to connect to the Hyperledger Burrow gRPC server")
+				logLevelOpt := cmd.StringOpt("log-level", cfg.LogLevel, "Logging level (error, warn, info, debug)")
+				heightOpt := cmd.IntOpt("height", 0, "Height to rewind to, tables will reflect all blocks up to and including this height")
+
+				cmd.Before = func() {
+					cfg.DBAdapter = *dbAdapterOpt
+					cfg.DBURL = *dbURLOpt
+					cfg.DBSchema = *dbSchemaOpt
+					cfg.GRPCAddr = *grpcAddrOpt
+					cfg.LogLevel = *logLevelOpt
+
+					if *heightOpt < 0 {
+						output.Fatalf("height must not be negative")
+					}
+				}
+
+				cmd.Spec = "--height=<height> [--db-adapter] [--db-url] [--db-schema] [--grpc-addr] [--log-level]"
+
+				cmd.Action = func() {
+					log := logger.NewLogger(cfg.LogLevel)
+					consumer := service.NewConsumer(cfg, log, make(chan types.EventData))
+
+					if err := consumer.Rewind(uint64(*heightOpt)); err != nil {
+						output.Fatalf("Rewind error: %v", err)
+					}
+				}
+			})
+
+		cmd.Command("reproject", "Rebuild a single table from the chain's history after its spec has changed",
+			func(cmd *cli.Cmd) {
+				cfg := config.DefaultVentConfig()
+
+				dbAdapterOpt := cmd.StringOpt("db-adapter", cfg.DBAdapter, "Database adapter, 'postgres' or 'sqlite' (if built with the sqlite tag) are supported")
+				dbURLOpt := cmd.StringOpt("db-url", cfg.DBURL, "PostgreSQL database URL or SQLite db file path")
+				dbSchemaOpt := cmd.StringOpt("db-schema", cfg.DBSchema, "PostgreSQL database schema (empty for SQLite)")
+				grpcAddrOpt := cmd.StringOpt("grpc-addr", cfg.GRPCAddr, "Address to connect to the Hyperledger Burrow gRPC server")
+				logLevelOpt := cmd.StringOpt("log-level", cfg.LogLevel, "Logging level (error, warn, info, debug)")
+				abiFileOpt := cmd.StringsOpt("abi", cfg.AbiFileOrDirs, "EVM Contract ABI file or folder, ABIs stored on chain are used for contracts not covered")
+				specFileOrDirOpt := cmd.StringsOpt("spec", cfg.SpecFileOrDirs, "SQLSol specification file or folder")
+				dbBlockTxOpt := cmd.BoolOpt("db-block", cfg.DBBlockTx, "Create block & transaction tables and persist related data (true/false)")
+				tableOpt := cmd.StringOpt("table", "", "Name of the table to rebuild")
+
+				cmd.Before = func() {
+					cfg.DBAdapter = *dbAdapterOpt
+					cfg.DBURL = *dbURLOpt
+					cfg.DBSchema = *dbSchemaOpt
+					cfg.GRPCAddr = *grpcAddrOpt
+					cfg.LogLevel = *logLevelOpt
+					cfg.AbiFileOrDirs = *abiFileOpt
+					cfg.SpecFileOrDirs = *specFileOrDirOpt
+					cfg.DBBlockTx = *dbBlockTxOpt
+					// Announcements are only useful for long running consumers
+					cfg.AnnounceEvery = 0
+				}
+
+				cmd.Spec = "--table=<table name> --spec=<spec file or dir> [--abi=<abi file or dir>] [--db-adapter] [--db-url] " +
+					"[--db-schema] [--db-block] [--grpc-addr] [--log-level]"
+
+				cmd.Action = func() {
+					log := logger.NewLogger(cfg.LogLevel)
+					consumer := service.NewConsumer(cfg, log, make(chan types.EventData))
+
+					projection, err := sqlsol.SpecLoader(cfg.SpecFileOrDirs, cfg.DBBlockTx)
+					if err != nil {
+						output.Fatalf("Spec loader error: %v", err)
+					}
+					abiSpec, err := service.LoadAbiSpec(cfg.AbiFileOrDirs)
+					if err != nil {
+						output.Fatalf("ABI loader error: %v", err)
+					}
+
+					if err := consumer.Reproject(projection, abiSpec, *tableOpt); err != nil {
+						output.Fatalf("Reproject error: %v", err)
+					}
+				}
+			})
+
 		cmd.Command("schema", "Print JSONSchema for spec file format to validate table specs",
 			func(cmd *cli.Cmd) {
 				cmd.Action = func() {
@@ -0,0 +1,93 @@
+package commands
+
+import (
+	"context"
+	"path/filepath"
+	"sort"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/deploy/compile"
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/deploy/verify"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/rpc/rpcquery"
+	cli "github.com/jawher/mow.cli"
+)
+
+// Verify checks deployed contracts against their sources
+func Verify(output Output) func(cmd *cli.Cmd) {
+	return func(cmd *cli.Cmd) {
+		chainOpt := cmd.StringOpt("c chain", "127.0.0.1:10997", "chain to be used in IP:PORT format")
+		dirOpt := cmd.StringOpt("i dir", "", "directory the sources were compiled in, "+
+			"by default the directory of the state file")
+		solcDirOpt := cmd.StringOpt("solc-dir", compile.DefaultSolcDir(),
+			"directory of solc binaries named for their version such as solc-0.5.4, searched along with the path")
+		stateArg := cmd.StringArg("STATE", "", "state file burrow deploy wrote next to the playbook, such as deploy.state.json")
+		addressesArg := cmd.StringsArg("ADDRESS", nil, "addresses of the contracts to verify, "+
+			"by default every contract in the state file")
+
+		cmd.Spec = "[--chain=<ip:port>] [--dir=<dir>] [--solc-dir=<dir>] STATE [ADDRESS...]"
+
+		cmd.Action = func() {
+			state, err := def.LoadDeployState(*stateArg)
+			if err != nil {
+				output.Fatalf("could not load state file %s: %v", *stateArg, err)
+			}
+			if state == nil {
+				output.Fatalf("state file %s does not exist", *stateArg)
+			}
+			dir := *dirOpt
+			if dir == "" {
+				dir = filepath.Dir(*stateArg)
+			}
+			var addresses []crypto.Address
+			for _, a := range *addressesArg {
+				address, err := crypto.AddressFromHexString(a)
+				if err != nil {
+					output.Fatalf("could not parse address %s: %v", a, err)
+				}
+				addresses = append(addresses, address)
+			}
+			if len(addresses) == 0 {
+				for address := range state.Contracts {
+					addresses = append(addresses, address)
+				}
+				sort.Slice(addresses, func(i, j int) bool {
+					return addresses[i].String() < addresses[j].String()
+				})
+			}
+
+			logger := logging.NewNoopLogger()
+			client := def.NewClient(*chainOpt, "", false, defaultChainTimeout)
+			qc, err := client.Query(logger)
+			if err != nil {
+				output.Fatalf("could not connect to %s: %v", *chainOpt, err)
+			}
+			failed := false
+			for _, address := range addresses {
+				record, ok := state.Contracts[address]
+				if !ok {
+					output.Printf("%v: not deployed by %s", address, *stateArg)
+					failed = true
+					continue
+				}
+				ctx, cancel := context.WithTimeout(context.Background(), defaultChainTimeout)
+				acc, err := qc.GetAccount(ctx, &rpcquery.GetAccountParam{Address: address})
+				cancel()
+				if err != nil {
+					output.Fatalf("could not get account %v: %v", address, err)
+				}
+				match, err := verify.Contract(record, acc.Code, dir, *solcDirOpt, logger)
+				if err != nil {
+					output.Printf("%v: %s (%s): %v", address, record.Contract, record.Job, err)
+					failed = true
+					continue
+				}
+				output.Printf("%v: %s (%s): %v", address, record.Contract, record.Job, match)
+			}
+			if failed {
+				output.Fatalf("verification failed")
+			}
+		}
+	}
+}
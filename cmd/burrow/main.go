@@ -56,6 +56,12 @@ func burrow(output commands.Output) *cli.Cli {
 	app.Command("snatives", "Dump Solidity interface contracts for SNatives",
 		commands.Snatives(output))
 
+	app.Command("abigen", "Generate Go bindings to contracts from their ABIs",
+		commands.Abigen(output))
+
+	app.Command("verify", "Verify the code of deployed contracts against the sources they were compiled from",
+		commands.Verify(output))
+
 	app.Command("vent", "Start the Vent EVM event and blocks consumer service to populated databases from smart contracts",
 		commands.Vent(output))
 
@@ -0,0 +1,68 @@
+package tendermint
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/keys"
+	tmCrypto "github.com/tendermint/tendermint/crypto"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+type privValidatorKeys struct {
+	crypto.Addressable
+	signer keys.ConsensusSigner
+}
+
+var _ tmTypes.PrivValidator = &privValidatorKeys{}
+
+// Create a PrivValidator that signs through a keys service, which holds the validator's private key and keeps the
+// state that protects it from double signing
+func NewPrivValidatorKeys(addressable crypto.Addressable, signer keys.ConsensusSigner) *privValidatorKeys {
+	return &privValidatorKeys{
+		Addressable: addressable,
+		signer:      signer,
+	}
+}
+
+func (pvk *privValidatorKeys) GetAddress() tmTypes.Address {
+	return pvk.Addressable.GetAddress().Bytes()
+}
+
+func (pvk *privValidatorKeys) GetPubKey() tmCrypto.PubKey {
+	return pvk.GetPublicKey().TendermintPubKey()
+}
+
+func (pvk *privValidatorKeys) SignVote(chainID string, vote *tmTypes.Vote) error {
+	sig, timestamp, err := pvk.sign(vote.SignBytes(chainID))
+	if err != nil {
+		return fmt.Errorf("error signing vote: %v", err)
+	}
+	// The keys service may return an earlier signature of the same vote made at another time
+	vote.Timestamp = timestamp
+	vote.Signature = sig
+	return nil
+}
+
+func (pvk *privValidatorKeys) SignProposal(chainID string, proposal *tmTypes.Proposal) error {
+	sig, timestamp, err := pvk.sign(proposal.SignBytes(chainID))
+	if err != nil {
+		return fmt.Errorf("error signing proposal: %v", err)
+	}
+	proposal.Timestamp = timestamp
+	proposal.Signature = sig
+	return nil
+}
+
+func (pvk *privValidatorKeys) sign(signBytes []byte) ([]byte, time.Time, error) {
+	sig, signedBytes, err := pvk.signer.SignConsensus(pvk.Addressable.GetAddress(), signBytes)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	timestamp, err := keys.ConsensusTimestamp(signedBytes)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	return sig.TendermintSignature(), timestamp, nil
+}
@@ -0,0 +1,58 @@
+package tendermint
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/keys"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+func TestPrivValidatorKeys(t *testing.T) {
+	dir, err := ioutil.TempDir("", "burrow-privval")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	keyClient := keys.NewLocalKeyClient(keys.NewKeyStore(dir, false), logging.NewNoopLogger())
+	address, err := keyClient.Generate("validator", crypto.CurveTypeEd25519)
+	require.NoError(t, err)
+	val, err := keys.AddressableSigner(keyClient, address)
+	require.NoError(t, err)
+	privVal := NewPrivValidatorKeys(val, keyClient.(keys.ConsensusSigner))
+
+	signedAt := time.Now().UTC().Round(0)
+	vote := &tmTypes.Vote{
+		Type:             tmTypes.PrecommitType,
+		Height:           3,
+		Timestamp:        signedAt,
+		ValidatorAddress: privVal.GetAddress(),
+	}
+	require.NoError(t, privVal.SignVote("chain", vote))
+	assert.True(t, privVal.GetPubKey().VerifyBytes(vote.SignBytes("chain"), vote.Signature))
+
+	// Signing the vote again after a crash keeps its first timestamp, so that its signature stays valid
+	resigned := *vote
+	resigned.Timestamp = signedAt.Add(time.Second)
+	resigned.Signature = nil
+	require.NoError(t, privVal.SignVote("chain", &resigned))
+	assert.True(t, signedAt.Equal(resigned.Timestamp))
+	assert.Equal(t, vote.Signature, resigned.Signature)
+
+	resigned.BlockID = tmTypes.BlockID{Hash: []byte("another block")}
+	assert.Error(t, privVal.SignVote("chain", &resigned))
+
+	proposal := &tmTypes.Proposal{
+		Type:      tmTypes.ProposalType,
+		Height:    4,
+		POLRound:  -1,
+		Timestamp: signedAt,
+	}
+	require.NoError(t, privVal.SignProposal("chain", proposal))
+	assert.True(t, privVal.GetPubKey().VerifyBytes(proposal.SignBytes("chain"), proposal.Signature))
+}
@@ -22,8 +22,14 @@ import (
 // LoadKeysFromConfig sets the keyClient & keyStore based on the given config
 func (kern *Kernel) LoadKeysFromConfig(conf *keys.KeysConfig) (err error) {
 	kern.keyStore = keys.NewKeyStore(conf.KeysDirectory, conf.AllowBadFilePermissions)
-	if conf.RemoteAddress != "" {
-		kern.keyClient, err = keys.NewRemoteKeyClient(conf.RemoteAddress, kern.Logger)
+	kern.signConsensus = conf.SignConsensus
+	if conf.PKCS11 != nil {
+		kern.keyClient, err = keys.NewPKCS11KeyClient(conf.PKCS11, kern.Logger)
+		if err != nil {
+			return err
+		}
+	} else if conf.RemoteAddress != "" {
+		kern.keyClient, err = keys.NewRemoteKeyClientWithTLS(conf.RemoteAddress, conf.RemoteTLS, kern.Logger)
 		if err != nil {
 			return err
 		}
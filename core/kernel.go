@@ -73,6 +73,7 @@ type Kernel struct {
 	committer      execution.BatchCommitter
 	keyClient      keys.KeyClient
 	keyStore       *keys.KeyStore
+	signConsensus  bool
 	info           string
 	processes      map[string]process.Process
 	listeners      map[string]net.Listener
@@ -229,12 +230,20 @@ func (kern *Kernel) SetKeyStore(store *keys.KeyStore) {
 	kern.keyStore = store
 }
 
-// Generates an in-memory Tendermint PrivValidator (suitable for passing to LoadTendermintFromConfig)
+// Generates a Tendermint PrivValidator (suitable for passing to LoadTendermintFromConfig) that keeps its double-sign
+// protection in memory, or in the keys service if the kernel is configured to sign consensus messages there
 func (kern *Kernel) PrivValidator(validator crypto.Address) (tmTypes.PrivValidator, error) {
 	val, err := keys.AddressableSigner(kern.keyClient, validator)
 	if err != nil {
 		return nil, fmt.Errorf("could not get validator addressable from keys client: %v", err)
 	}
+	if kern.signConsensus {
+		consensusSigner, ok := kern.keyClient.(keys.ConsensusSigner)
+		if !ok {
+			return nil, fmt.Errorf("SignConsensus is set but the keys client cannot sign consensus messages")
+		}
+		return tendermint.NewPrivValidatorKeys(val, consensusSigner), nil
+	}
 	signer, err := keys.AddressableSigner(kern.keyClient, val.GetAddress())
 	if err != nil {
 		return nil, err
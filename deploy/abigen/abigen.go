@@ -0,0 +1,382 @@
+// Package abigen generates typed Go bindings to Solidity contracts from their ABIs. The bindings are built on the
+// runtime support in deploy/bind.
+package abigen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"go/token"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/hyperledger/burrow/deploy/compile"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/iancoleman/strcase"
+	hex "github.com/tmthrgd/go-hex"
+)
+
+// Contract is the compiled form of a contract to generate bindings for
+type Contract struct {
+	// Name of the Go type for the binding
+	Name string
+	// ABI JSON
+	Abi []byte
+	// Creation bytecode, without it no deploy function is generated
+	Bytecode []byte
+}
+
+// LoadContract reads a contract from a .bin file as written by burrow deploy or from a file containing only its ABI.
+// The contract is named after the file.
+func LoadContract(file string) (*Contract, error) {
+	bs, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	name := strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
+	contract := &Contract{Name: strcase.ToCamel(name)}
+
+	bin := new(compile.SolidityContract)
+	if json.Unmarshal(bs, bin) != nil || len(bin.Abi) == 0 {
+		// Not a .bin file so take it to be a bare ABI
+		contract.Abi = bs
+		return contract, nil
+	}
+	contract.Abi = bin.Abi
+	if bin.Evm.Bytecode.Object != "" {
+		if strings.Contains(bin.Evm.Bytecode.Object, "_") {
+			return nil, fmt.Errorf("bytecode of %s has unlinked libraries", file)
+		}
+		contract.Bytecode, err = hex.DecodeString(bin.Evm.Bytecode.Object)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode bytecode of %s: %v", file, err)
+		}
+	}
+	return contract, nil
+}
+
+// Generate returns formatted Go source declaring the package pkg with bindings for each contract
+func Generate(pkg string, contracts ...*Contract) ([]byte, error) {
+	data := &packageData{Package: pkg}
+	for _, contract := range contracts {
+		cd, err := newContractData(contract)
+		if err != nil {
+			return nil, fmt.Errorf("could not generate bindings for %s: %v", contract.Name, err)
+		}
+		data.UsesBig = data.UsesBig || cd.usesBig
+		data.UsesHex = data.UsesHex || cd.Bytecode != ""
+		data.Contracts = append(data.Contracts, cd)
+	}
+	buf := new(bytes.Buffer)
+	err := bindingsTemplate.Execute(buf, data)
+	if err != nil {
+		return nil, err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("generated invalid Go: %v", err)
+	}
+	return src, nil
+}
+
+type packageData struct {
+	Package   string
+	UsesBig   bool
+	UsesHex   bool
+	Contracts []*contractData
+}
+
+type contractData struct {
+	Name        string
+	Abi         string
+	Bytecode    string
+	Constructor *functionData
+	Functions   []*functionData
+	Events      []*eventData
+	usesBig     bool
+}
+
+type functionData struct {
+	Name    string
+	GoName  string
+	Inputs  []*argData
+	Outputs []*argData
+}
+
+type eventData struct {
+	Name   string
+	GoName string
+	Inputs []*argData
+}
+
+type argData struct {
+	// Name of a parameter, local variable or field holding the argument
+	Name string
+	Type string
+}
+
+// Declares a variable for an output to be unpacked into
+func (arg *argData) Declare() string {
+	if arg.Type == bigIntType {
+		return fmt.Sprintf("%s := new(big.Int)", arg.Name)
+	}
+	return fmt.Sprintf("var %s %s", arg.Name, arg.Type)
+}
+
+// A pointer to the output for unpacking
+func (arg *argData) Ref() string {
+	if arg.Type == bigIntType {
+		return arg.Name
+	}
+	return "&" + arg.Name
+}
+
+const bigIntType = "*big.Int"
+
+func newContractData(contract *Contract) (*contractData, error) {
+	spec, err := abi.ReadAbiSpec(contract.Abi)
+	if err != nil {
+		return nil, err
+	}
+	if strings.Contains(string(contract.Abi), "`") {
+		return nil, fmt.Errorf("ABI contains a backtick")
+	}
+	cd := &contractData{
+		Name:     contract.Name,
+		Abi:      string(contract.Abi),
+		Bytecode: hex.EncodeToString(contract.Bytecode),
+	}
+
+	cd.Constructor = &functionData{}
+	cd.Constructor.Inputs, err = cd.params(spec.Constructor.Inputs)
+	if err != nil {
+		return nil, fmt.Errorf("constructor: %v", err)
+	}
+
+	names := make([]string, 0, len(spec.Functions))
+	for name := range spec.Functions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fs := spec.Functions[name]
+		fd := &functionData{Name: name, GoName: strcase.ToCamel(name)}
+		fd.Inputs, err = cd.params(fs.Inputs)
+		if err != nil {
+			return nil, fmt.Errorf("function %s: %v", name, err)
+		}
+		for i, arg := range fs.Outputs {
+			typ, err := cd.goType(arg)
+			if err != nil {
+				return nil, fmt.Errorf("function %s: %v", name, err)
+			}
+			fd.Outputs = append(fd.Outputs, &argData{Name: fmt.Sprintf("ret%d", i), Type: typ})
+		}
+		cd.Functions = append(cd.Functions, fd)
+	}
+
+	names = names[:0]
+	for name := range spec.Events {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		ed := &eventData{Name: name, GoName: strcase.ToCamel(name)}
+		for i, arg := range spec.Events[name].Inputs {
+			typ, err := cd.goType(arg)
+			if err != nil {
+				return nil, fmt.Errorf("event %s: %v", name, err)
+			}
+			field := strcase.ToCamel(arg.Name)
+			if field == "" || field == "Log" || field == "Header" {
+				field = fmt.Sprintf("Arg%d", i)
+			}
+			ed.Inputs = append(ed.Inputs, &argData{Name: field, Type: typ})
+		}
+		cd.Events = append(cd.Events, ed)
+	}
+	return cd, nil
+}
+
+// Parameter names must not collide with keywords or the other parameters of generated functions
+var reservedParams = map[string]bool{
+	"c": true, "ctx": true, "opts": true, "transact": true, "events": true, "contract": true, "txe": true, "err": true,
+}
+
+func (cd *contractData) params(args []abi.Argument) ([]*argData, error) {
+	params := make([]*argData, len(args))
+	for i, arg := range args {
+		typ, err := cd.goType(arg)
+		if err != nil {
+			return nil, err
+		}
+		name := strcase.ToLowerCamel(arg.Name)
+		switch {
+		case name == "":
+			name = fmt.Sprintf("arg%d", i)
+		case token.Lookup(name).IsKeyword() || reservedParams[name] || strings.HasPrefix(name, "ret"):
+			name += "_"
+		}
+		params[i] = &argData{Name: name, Type: typ}
+	}
+	return params, nil
+}
+
+func (cd *contractData) goType(arg abi.Argument) (string, error) {
+	var typ string
+	switch evm := arg.EVM.(type) {
+	case abi.EVMBool:
+		typ = "bool"
+	case abi.EVMUint:
+		typ = sizedInt("uint", evm.M)
+	case abi.EVMInt:
+		typ = sizedInt("int", evm.M)
+	case abi.EVMAddress:
+		typ = "crypto.Address"
+	case abi.EVMBytes:
+		typ = "[]byte"
+	case abi.EVMString:
+		typ = "string"
+	case abi.EVMArray:
+		elem, err := cd.goType(abi.Argument{Name: arg.Name, EVM: evm.Element})
+		if err != nil {
+			return "", err
+		}
+		typ = "[]" + elem
+	default:
+		return "", fmt.Errorf("type %s of %s is not supported", arg.EVM.GetSignature(), arg.Name)
+	}
+	if typ == bigIntType {
+		cd.usesBig = true
+	}
+	if arg.IsArray {
+		typ = "[]" + typ
+	}
+	return typ, nil
+}
+
+func sizedInt(prefix string, m uint64) string {
+	switch m {
+	case 8, 16, 32, 64:
+		return fmt.Sprintf("%s%d", prefix, m)
+	}
+	return bigIntType
+}
+
+var bindingsTemplate = template.Must(template.New("bindings").Parse(`// Code generated by burrow abigen. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"context"
+{{- if .UsesBig}}
+	"math/big"
+{{- end}}
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/deploy/bind"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/rpc/rpcevents"
+	"github.com/hyperledger/burrow/rpc/rpctransact"
+{{- if .UsesHex}}
+	hex "github.com/tmthrgd/go-hex"
+{{- end}}
+)
+{{range .Contracts}}{{$contract := .}}
+var Abi_{{.Name}} = []byte(` + "`{{.Abi}}`" + `)
+{{- if .Bytecode}}
+var Bytecode_{{.Name}} = hex.MustDecodeString("{{.Bytecode}}")
+{{- end}}
+
+// {{.Name}} is a binding to an instance of the {{.Name}} contract
+type {{.Name}} struct {
+	*bind.Contract
+}
+
+// New{{.Name}} binds to an instance of {{.Name}} deployed at address
+func New{{.Name}}(address crypto.Address, transact rpctransact.TransactClient,
+	events rpcevents.ExecutionEventsClient) (*{{.Name}}, error) {
+	contract, err := bind.NewContract(address, Abi_{{.Name}}, transact, events)
+	if err != nil {
+		return nil, err
+	}
+	return &{{.Name}}{contract}, nil
+}
+{{- if .Bytecode}}
+
+// Deploy{{.Name}} deploys a new instance of {{.Name}} and binds to it
+func Deploy{{.Name}}(ctx context.Context, opts *bind.TransactOpts, transact rpctransact.TransactClient,
+	events rpcevents.ExecutionEventsClient{{range .Constructor.Inputs}}, {{.Name}} {{.Type}}{{end}}) (*{{.Name}}, *exec.TxExecution, error) {
+	contract, txe, err := bind.Deploy(ctx, opts, Abi_{{.Name}}, Bytecode_{{.Name}}, transact, events
+	{{- range .Constructor.Inputs}}, {{.Name}}{{end}})
+	if err != nil {
+		return nil, txe, err
+	}
+	return &{{.Name}}{contract}, txe, nil
+}
+{{- end}}
+{{range .Functions}}
+// {{.GoName}} sends a transaction calling {{.Name}}
+func (c *{{$contract.Name}}) {{.GoName}}(ctx context.Context, opts *bind.TransactOpts
+	{{- range .Inputs}}, {{.Name}} {{.Type}}{{end}}) ({{range .Outputs}}{{.Type}}, {{end}}*exec.TxExecution, error) {
+	{{- range .Outputs}}
+	{{.Declare}}
+	{{- end}}
+	txe, err := c.Contract.Call(ctx, opts, "{{.Name}}", []interface{}{ {{- range $i, $in := .Inputs}}{{if $i}}, {{end}}{{$in.Name}}{{end -}} }
+	{{- range .Outputs}}, {{.Ref}}{{end}})
+	return {{range .Outputs}}{{.Name}}, {{end}}txe, err
+}
+
+// Simulate{{.GoName}} calls {{.Name}} against the latest state without sending a transaction
+func (c *{{$contract.Name}}) Simulate{{.GoName}}(ctx context.Context, opts *bind.TransactOpts
+	{{- range .Inputs}}, {{.Name}} {{.Type}}{{end}}) ({{range .Outputs}}{{.Type}}, {{end}}*exec.TxExecution, error) {
+	{{- range .Outputs}}
+	{{.Declare}}
+	{{- end}}
+	txe, err := c.Contract.Simulate(ctx, opts, "{{.Name}}", []interface{}{ {{- range $i, $in := .Inputs}}{{if $i}}, {{end}}{{$in.Name}}{{end -}} }
+	{{- range .Outputs}}, {{.Ref}}{{end}})
+	return {{range .Outputs}}{{.Name}}, {{end}}txe, err
+}
+{{end}}
+{{- range .Events}}
+// {{$contract.Name}}{{.GoName}} is a {{.Name}} event emitted by {{$contract.Name}}
+type {{$contract.Name}}{{.GoName}} struct {
+	{{- range .Inputs}}
+	{{.Name}} {{.Type}}
+	{{- end}}
+	Log    *exec.LogEvent
+	Header *exec.Header
+}
+
+// Unpack{{.GoName}} decodes a {{.Name}} event from a log emitted by the contract
+func (c *{{$contract.Name}}) Unpack{{.GoName}}(log *exec.LogEvent) (*{{$contract.Name}}{{.GoName}}, error) {
+	ev := &{{$contract.Name}}{{.GoName}}{Log: log}
+	{{- range .Inputs}}{{if eq .Type "*big.Int"}}
+	ev.{{.Name}} = new(big.Int)
+	{{- end}}{{end}}
+	err := c.Contract.UnpackEvent("{{.Name}}", log {{- range .Inputs}}, {{if ne .Type "*big.Int"}}&{{end}}ev.{{.Name}}{{end}})
+	if err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
+
+// Filter{{.GoName}} calls handle with each {{.Name}} event emitted by the contract within blockRange until the range
+// is exhausted or handle returns an error (io.EOF to stop without error)
+func (c *{{$contract.Name}}) Filter{{.GoName}}(ctx context.Context, blockRange *rpcevents.BlockRange,
+	handle func(*{{$contract.Name}}{{.GoName}}) error) error {
+	return c.Contract.FilterEvents(ctx, "{{.Name}}", blockRange, func(log *exec.LogEvent, header *exec.Header) error {
+		ev, err := c.Unpack{{.GoName}}(log)
+		if err != nil {
+			return err
+		}
+		ev.Header = header
+		return handle(ev)
+	})
+}
+{{end}}
+{{- end}}`))
@@ -0,0 +1,73 @@
+package abigen
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperledger/burrow/deploy/compile"
+	"github.com/hyperledger/burrow/execution/solidity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hex "github.com/tmthrgd/go-hex"
+)
+
+// The bindings exercised by the integration tests
+const bindingsFile = "../../integration/deploy/bindings/bindings.go"
+
+func TestGenerate(t *testing.T) {
+	src, err := Generate("bindings",
+		&Contract{Name: "ZeroReset", Abi: solidity.Abi_ZeroReset, Bytecode: solidity.Bytecode_ZeroReset},
+		&Contract{Name: "EventEmitter", Abi: solidity.Abi_EventEmitter, Bytecode: solidity.Bytecode_EventEmitter})
+	require.NoError(t, err)
+	expected, err := ioutil.ReadFile(bindingsFile)
+	require.NoError(t, err)
+	assert.Equal(t, string(expected), string(src), "%s is out of date", bindingsFile)
+}
+
+func TestGenerateParams(t *testing.T) {
+	src, err := Generate("bindings", &Contract{Name: "Params", Abi: []byte(`[
+		{"inputs":[{"name":"type","type":"address"},{"name":"","type":"bytes32[]"}],"type":"constructor"},
+		{"constant":false,"inputs":[{"name":"ctx","type":"int8"},{"name":"ret0","type":"uint16[2]"}],"name":"set",
+		"outputs":[{"name":"","type":"int256[]"}],"payable":false,"type":"function"}]`), Bytecode: []byte{1}})
+	require.NoError(t, err)
+	assert.Contains(t, string(src), "func DeployParams(ctx context.Context, opts *bind.TransactOpts, "+
+		"transact rpctransact.TransactClient,\n\tevents rpcevents.ExecutionEventsClient, type_ crypto.Address, "+
+		"arg1 [][]byte)")
+	assert.Contains(t, string(src), "func (c *Params) Set(ctx context.Context, opts *bind.TransactOpts, ctx_ int8, "+
+		"ret0_ []uint16) ([]*big.Int, *exec.TxExecution, error)")
+
+	src, err = Generate("bindings", &Contract{Name: "Nested", Abi: []byte(`[{"constant":true,"inputs":[],
+		"name":"grid","outputs":[{"name":"","type":"uint8[2][]"}],"payable":false,"type":"function"}]`)})
+	require.NoError(t, err)
+	assert.Contains(t, string(src), "func (c *Nested) Grid(ctx context.Context, opts *bind.TransactOpts) "+
+		"([][]uint8, *exec.TxExecution, error)")
+
+	_, err = Generate("bindings", &Contract{Name: "Fixed", Abi: []byte(`[{"constant":false,
+		"inputs":[{"name":"x","type":"fixed128x8"}],"name":"set","outputs":[],"payable":false,"type":"function"}]`)})
+	assert.Error(t, err)
+}
+
+func TestLoadContract(t *testing.T) {
+	dir, err := ioutil.TempDir("", "abigen")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	bin := new(compile.SolidityContract)
+	bin.Abi = solidity.Abi_ZeroReset
+	bin.Evm.Bytecode.Object = hex.EncodeUpperToString(solidity.Bytecode_ZeroReset)
+	require.NoError(t, bin.Save(dir, "zero_reset.bin"))
+	contract, err := LoadContract(filepath.Join(dir, "zero_reset.bin"))
+	require.NoError(t, err)
+	assert.Equal(t, "ZeroReset", contract.Name)
+	assert.Equal(t, solidity.Bytecode_ZeroReset, contract.Bytecode)
+
+	file := filepath.Join(dir, "EventEmitter.abi")
+	require.NoError(t, ioutil.WriteFile(file, solidity.Abi_EventEmitter, 0644))
+	contract, err = LoadContract(file)
+	require.NoError(t, err)
+	assert.Equal(t, "EventEmitter", contract.Name)
+	assert.Equal(t, solidity.Abi_EventEmitter, contract.Abi)
+	assert.Nil(t, contract.Bytecode)
+}
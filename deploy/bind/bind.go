@@ -0,0 +1,234 @@
+// Package bind provides the runtime support for the Go contract bindings generated by burrow abigen. Bindings send
+// transactions through a node's Transact service (which signs them with keys held by the node) and read events
+// through its ExecutionEvents service.
+package bind
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/event"
+	"github.com/hyperledger/burrow/event/query"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/rpc/rpcevents"
+	"github.com/hyperledger/burrow/rpc/rpctransact"
+	"github.com/hyperledger/burrow/txs/payload"
+	hex "github.com/tmthrgd/go-hex"
+)
+
+// DefaultGasLimit is used for transactions whose TransactOpts do not set one
+const DefaultGasLimit = 1000000
+
+// TransactOpts are the parameters of a transaction sent by a binding
+type TransactOpts struct {
+	// The account to send from, the node must hold its key
+	Input crypto.Address
+	// Amount of native token to send to the contract
+	Amount uint64
+	// Fee to offer validators
+	Fee uint64
+	// Gas limit for execution, DefaultGasLimit if zero
+	GasLimit uint64
+}
+
+// Contract is a deployed contract together with the clients used to transact with it and watch its events
+type Contract struct {
+	Address  crypto.Address
+	Spec     *abi.AbiSpec
+	Transact rpctransact.TransactClient
+	Events   rpcevents.ExecutionEventsClient
+}
+
+// NewContract binds to a contract already deployed at address
+func NewContract(address crypto.Address, abiJSON []byte, transact rpctransact.TransactClient,
+	events rpcevents.ExecutionEventsClient) (*Contract, error) {
+	spec, err := abi.ReadAbiSpec(abiJSON)
+	if err != nil {
+		return nil, fmt.Errorf("could not read contract ABI: %v", err)
+	}
+	return &Contract{
+		Address:  address,
+		Spec:     spec,
+		Transact: transact,
+		Events:   events,
+	}, nil
+}
+
+// Deploy creates a contract from its bytecode passing args to its constructor and binds to it
+func Deploy(ctx context.Context, opts *TransactOpts, abiJSON, bytecode []byte, transact rpctransact.TransactClient,
+	events rpcevents.ExecutionEventsClient, args ...interface{}) (*Contract, *exec.TxExecution, error) {
+	contract, err := NewContract(crypto.ZeroAddress, abiJSON, transact, events)
+	if err != nil {
+		return nil, nil, err
+	}
+	data := bytecode
+	if contract.Spec.Constructor.Inputs != nil || len(args) > 0 {
+		packed, _, err := contract.Spec.Pack("", args...)
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not pack constructor arguments: %v", err)
+		}
+		data = append(append([]byte{}, bytecode...), packed...)
+	}
+	txe, err := transact.CallTxSync(ctx, callTx(opts, nil, data))
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := exception(txe); err != nil {
+		return nil, txe, err
+	}
+	if txe.Receipt == nil || !txe.Receipt.CreatesContract {
+		return nil, txe, fmt.Errorf("deploy transaction %v did not create a contract", txe.TxHash)
+	}
+	contract.Address = txe.Receipt.ContractAddress
+	return contract, txe, nil
+}
+
+// Call sends a transaction calling function and unpacks its return values into results
+func (c *Contract) Call(ctx context.Context, opts *TransactOpts, function string, args []interface{},
+	results ...interface{}) (*exec.TxExecution, error) {
+	tx, err := c.callTx(opts, function, args)
+	if err != nil {
+		return nil, err
+	}
+	txe, err := c.Transact.CallTxSync(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	return txe, c.unpackReturn(txe, function, results)
+}
+
+// Simulate runs a call to function against the latest state without committing a transaction and unpacks its
+// return values into results
+func (c *Contract) Simulate(ctx context.Context, opts *TransactOpts, function string, args []interface{},
+	results ...interface{}) (*exec.TxExecution, error) {
+	tx, err := c.callTx(opts, function, args)
+	if err != nil {
+		return nil, err
+	}
+	txe, err := c.Transact.CallTxSim(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	return txe, c.unpackReturn(txe, function, results)
+}
+
+// FilterEvents calls handle with each event of the given name emitted by the contract within blockRange, or
+// indefinitely if blockRange ends with a stream bound. It returns when the range is exhausted or handle returns an
+// error, which is returned unless it is io.EOF.
+func (c *Contract) FilterEvents(ctx context.Context, eventName string, blockRange *rpcevents.BlockRange,
+	handle func(log *exec.LogEvent, header *exec.Header) error) error {
+	spec, ok := c.Spec.Events[eventName]
+	if !ok {
+		return fmt.Errorf("contract ABI has no event %s", eventName)
+	}
+	qb := query.NewBuilder().
+		AndEquals(event.EventTypeKey, exec.TypeLog.String()).
+		AndEquals(event.AddressKey, c.Address)
+	if !spec.Anonymous {
+		qb = qb.AndEquals(exec.LogNKey(0), hex.EncodeUpperToString(spec.EventID.Bytes()))
+	}
+	stream, err := c.Events.Events(ctx, &rpcevents.BlocksRequest{
+		BlockRange: blockRange,
+		Query:      qb.String(),
+	})
+	if err != nil {
+		return err
+	}
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		for _, ev := range resp.Events {
+			if ev.Log == nil {
+				continue
+			}
+			err = handle(ev.Log, ev.Header)
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// UnpackEvent unpacks the arguments of a log emitted by the named event into args
+func (c *Contract) UnpackEvent(eventName string, log *exec.LogEvent, args ...interface{}) error {
+	spec, ok := c.Spec.Events[eventName]
+	if !ok {
+		return fmt.Errorf("contract ABI has no event %s", eventName)
+	}
+	if !spec.Anonymous && log.GetTopic(0) != binary.Word256(spec.EventID) {
+		return fmt.Errorf("log is not a %s event", eventName)
+	}
+	return abi.UnpackEvent(&spec, log.Topics, log.Data, args...)
+}
+
+func (c *Contract) callTx(opts *TransactOpts, function string, args []interface{}) (*payload.CallTx, error) {
+	if _, ok := c.Spec.Functions[function]; !ok {
+		return nil, fmt.Errorf("contract ABI has no function %s", function)
+	}
+	data, _, err := c.Spec.Pack(function, args...)
+	if err != nil {
+		return nil, fmt.Errorf("could not pack arguments to %s: %v", function, err)
+	}
+	return callTx(opts, &c.Address, data), nil
+}
+
+func (c *Contract) unpackReturn(txe *exec.TxExecution, function string, results []interface{}) error {
+	if err := exception(txe); err != nil {
+		return err
+	}
+	if len(results) == 0 {
+		return nil
+	}
+	err := abi.Unpack(c.Spec.Functions[function].Outputs, txe.GetResult().GetReturn(), results...)
+	if err != nil {
+		return fmt.Errorf("could not unpack return values of %s: %v", function, err)
+	}
+	return nil
+}
+
+func callTx(opts *TransactOpts, address *crypto.Address, data []byte) *payload.CallTx {
+	if opts == nil {
+		opts = new(TransactOpts)
+	}
+	gasLimit := opts.GasLimit
+	if gasLimit == 0 {
+		gasLimit = DefaultGasLimit
+	}
+	return &payload.CallTx{
+		Input: &payload.TxInput{
+			Address: opts.Input,
+			Amount:  opts.Amount,
+		},
+		Address:  address,
+		GasLimit: gasLimit,
+		Fee:      opts.Fee,
+		Data:     data,
+	}
+}
+
+// exception returns an error if a transaction failed, including the reason for any revert
+func exception(txe *exec.TxExecution) error {
+	if txe.Exception == nil {
+		return nil
+	}
+	if txe.Exception.ErrorCode() == errors.ErrorCodeExecutionReverted {
+		reason, err := abi.UnpackRevert(txe.GetResult().GetReturn())
+		if err == nil && reason != nil {
+			return fmt.Errorf("transaction %v reverted: %s", txe.TxHash, *reason)
+		}
+	}
+	return txe.Exception.AsError()
+}
@@ -0,0 +1,95 @@
+package compile
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto/sha3"
+	hex "github.com/tmthrgd/go-hex"
+)
+
+// An output of solc cached against the standard JSON input that produced it and the sources it read
+type cacheEntry struct {
+	// Keccak256 hash of each source read by solc, including imports, by path
+	SourceHashes map[string]binary.HexBytes
+	Output       json.RawMessage
+}
+
+// Outputs are keyed by the solc version, the directory it runs in and its input, which names the source compiled
+// along with the settings it is compiled with
+func cacheKey(solc *Solc, workDir string, input []byte) string {
+	hasher := sha256.New()
+	for _, part := range [][]byte{[]byte(solc.Version), []byte(workDir), input} {
+		hasher.Write(part)
+		hasher.Write([]byte{0})
+	}
+	return hex.EncodeToString(hasher.Sum(nil))
+}
+
+// Returns the cached output if none of the sources it was compiled from have changed since
+func readCache(cacheDir, key, workDir string) []byte {
+	bs, err := ioutil.ReadFile(filepath.Join(cacheDir, key+".json"))
+	if err != nil {
+		return nil
+	}
+	entry := new(cacheEntry)
+	err = json.Unmarshal(bs, entry)
+	if err != nil {
+		return nil
+	}
+	for path, hash := range entry.SourceHashes {
+		source, err := ioutil.ReadFile(sourceFile(workDir, path))
+		if err != nil || !bytes.Equal(sha3.Sha3(source), hash) {
+			return nil
+		}
+	}
+	return entry.Output
+}
+
+// Caches output, which should only be called with an output that has no errors
+func writeCache(cacheDir, key, workDir string, output []byte, sources []string) error {
+	entry := &cacheEntry{
+		SourceHashes: make(map[string]binary.HexBytes),
+		Output:       output,
+	}
+	for _, path := range sources {
+		source, err := ioutil.ReadFile(sourceFile(workDir, path))
+		if err != nil {
+			return err
+		}
+		entry.SourceHashes[path] = sha3.Sha3(source)
+	}
+	bs, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(cacheDir, 0775)
+	if err != nil {
+		return err
+	}
+	// Concurrent compilations of the same source may write the same entry
+	f, err := ioutil.TempFile(cacheDir, key+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	_, err = f.Write(bs)
+	f.Close()
+	if err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), filepath.Join(cacheDir, key+".json"))
+}
+
+// Sources are named by their path relative to the directory solc runs in, unless absolute
+func sourceFile(workDir, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(workDir, path)
+}
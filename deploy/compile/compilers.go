@@ -22,7 +22,9 @@ type SolidityInput struct {
 		Libraries map[string]map[string]string `json:"libraries"`
 		Optimizer struct {
 			Enabled bool `json:"enabled"`
+			Runs    int  `json:"runs,omitempty"`
 		} `json:"optimizer"`
+		Remappings      []string `json:"remappings,omitempty"`
 		OutputSelection struct {
 			File struct {
 				OutputType []string `json:"*"`
@@ -38,7 +40,11 @@ type SolidityInputSource struct {
 
 type SolidityOutput struct {
 	Contracts map[string]map[string]SolidityContract
-	Errors    []struct {
+	// Every source read, including those imported, by path
+	Sources map[string]struct {
+		ID int `json:"id"`
+	}
+	Errors []struct {
 		Component        string
 		FormattedMessage string
 		Message          string
@@ -55,10 +61,17 @@ type SolidityContract struct {
 			Opcodes        string
 			LinkReferences json.RawMessage
 		}
+		// The code the contract runs once deployed
+		DeployedBytecode struct {
+			Object         string
+			LinkReferences json.RawMessage
+		}
 	}
 	Devdoc   json.RawMessage
 	Userdoc  json.RawMessage
 	Metadata string
+	// Only output by solc 0.5.13 onwards
+	StorageLayout *StorageLayout `json:",omitempty"`
 }
 
 type Response struct {
@@ -110,49 +123,93 @@ func (contract *SolidityContract) Save(dir, file string) error {
 }
 
 func (contract *SolidityContract) Link(libraries map[string]string) error {
-	bin := contract.Evm.Bytecode.Object
+	bin, err := link(contract.Evm.Bytecode.Object, contract.Evm.Bytecode.LinkReferences, libraries)
+	if err != nil {
+		return err
+	}
+	contract.Evm.Bytecode.Object = bin
+	return nil
+}
+
+// LinkDeployed links the libraries referenced by the contract's deployed code
+func (contract *SolidityContract) LinkDeployed(libraries map[string]string) error {
+	bin, err := link(contract.Evm.DeployedBytecode.Object, contract.Evm.DeployedBytecode.LinkReferences, libraries)
+	if err != nil {
+		return err
+	}
+	contract.Evm.DeployedBytecode.Object = bin
+	return nil
+}
+
+func link(bin string, linkReferences json.RawMessage, libraries map[string]string) (string, error) {
 	if !strings.Contains(bin, "_") {
-		return nil
+		return bin, nil
 	}
 	var links map[string]map[string][]struct{ Start, Length int }
-	err := json.Unmarshal(contract.Evm.Bytecode.LinkReferences, &links)
+	err := json.Unmarshal(linkReferences, &links)
 	if err != nil {
-		return err
+		return "", err
 	}
 	for _, f := range links {
 		for name, relos := range f {
 			addr, ok := libraries[name]
 			if !ok {
-				return fmt.Errorf("library %s is not defined", name)
+				return "", fmt.Errorf("library %s is not defined", name)
 			}
 			for _, relo := range relos {
 				if relo.Length != crypto.AddressLength {
-					return fmt.Errorf("linkReference should be %d bytes long, not %d", crypto.AddressLength, relo.Length)
+					return "", fmt.Errorf("linkReference should be %d bytes long, not %d", crypto.AddressLength, relo.Length)
 				}
 				if len(addr) != crypto.AddressHexLength {
-					return fmt.Errorf("address %s should be %d character long, not %d", addr, crypto.AddressHexLength, len(addr))
+					return "", fmt.Errorf("address %s should be %d character long, not %d", addr, crypto.AddressHexLength, len(addr))
 				}
 				start := relo.Start * 2
 				end := relo.Start*2 + crypto.AddressHexLength
 				if bin[start+1] != '_' || bin[end-1] != '_' {
-					return fmt.Errorf("relocation dummy not found at %d in %s ", relo.Start, bin)
+					return "", fmt.Errorf("relocation dummy not found at %d in %s ", relo.Start, bin)
 				}
 				bin = bin[:start] + addr + bin[end:]
 			}
 		}
 	}
+	return bin, nil
+}
 
-	contract.Evm.Bytecode.Object = bin
-
-	return nil
+// Compiler compiles Solidity with solc
+type Compiler struct {
+	// Version of solc to use such as 0.5.4, or a range such as ^0.5.0, in addition to the version pragmas of the
+	// source compiled
+	Version  string
+	Optimize bool
+	// How many times the optimizer expects the code to be run, solc's default of 200 if zero
+	OptimizerRuns int
+	// Import remappings of the form prefix=path
+	Remappings []string
+	// Directory holding solc binaries named for their version such as solc-0.5.4, as well as solc on the path
+	SolcDir string
+	// Directory to cache the outputs of solc in, none are cached if empty
+	CacheDir string
 }
 
+// Compile compiles file with solc on the path
 func Compile(file string, optimize bool, workDir string, libraries map[string]string, logger *logging.Logger) (*Response, error) {
+	compiler := &Compiler{Optimize: optimize}
+	return compiler.Compile(file, workDir, libraries, logger)
+}
+
+// Compile compiles file relative to workDir, returning the output of an earlier compilation instead if nothing has
+// changed since
+func (compiler *Compiler) Compile(file string, workDir string, libraries map[string]string, logger *logging.Logger) (*Response, error) {
 	input := SolidityInput{Language: "Solidity", Sources: make(map[string]SolidityInputSource)}
 
 	input.Sources[file] = SolidityInputSource{Urls: []string{file}}
-	input.Settings.Optimizer.Enabled = optimize
-	input.Settings.OutputSelection.File.OutputType = []string{"abi", "evm.bytecode.linkReferences", "metadata", "bin", "devdoc"}
+	input.Settings.Optimizer.Enabled = compiler.Optimize
+	if compiler.Optimize {
+		input.Settings.Optimizer.Runs = compiler.OptimizerRuns
+	}
+	input.Settings.Remappings = compiler.Remappings
+	input.Settings.OutputSelection.File.OutputType = []string{"abi", "evm.bytecode.linkReferences", "metadata", "bin", "devdoc",
+		"storageLayout", "evm.deployedBytecode.object", "evm.deployedBytecode.linkReferences"}
 	input.Settings.Libraries = make(map[string]map[string]string)
 	input.Settings.Libraries[""] = make(map[string]string)
 
@@ -167,15 +224,34 @@ func Compile(file string, optimize bool, workDir string, libraries map[string]st
 		return nil, err
 	}
 
-	logger.TraceMsg("Command Input", "command", string(command))
-	result, err := runSolidity(string(command), workDir)
+	solc, err := compiler.solc(file, workDir)
+	if err != nil {
+		return nil, err
+	}
+	dir, err := filepath.Abs(workDir)
 	if err != nil {
 		return nil, err
 	}
-	logger.TraceMsg("Command Output", "result", result)
+	key := cacheKey(solc, dir, command)
+
+	var result []byte
+	if compiler.CacheDir != "" {
+		result = readCache(compiler.CacheDir, key, dir)
+	}
+	cached := result != nil
+	if cached {
+		logger.TraceMsg("Using cached compiler output", "file", file, "solc", solc.Version)
+	} else {
+		logger.TraceMsg("Command Input", "command", string(command), "solc", solc.Path)
+		result, err = runSolidity(solc.Path, command, workDir)
+		if err != nil {
+			return nil, err
+		}
+		logger.TraceMsg("Command Output", "result", string(result))
+	}
 
 	output := SolidityOutput{}
-	err = json.Unmarshal([]byte(result), &output)
+	err = json.Unmarshal(result, &output)
 	if err != nil {
 		return nil, err
 	}
@@ -213,9 +289,21 @@ func Compile(file string, optimize bool, workDir string, libraries map[string]st
 	resp := Response{
 		Objects: respItemArray,
 		Warning: warnings,
+		Version: solc.Version,
 		Error:   errors,
 	}
 
+	if compiler.CacheDir != "" && !cached && errors == "" {
+		sources := make([]string, 0, len(output.Sources))
+		for path := range output.Sources {
+			sources = append(sources, path)
+		}
+		err = writeCache(compiler.CacheDir, key, dir, result, sources)
+		if err != nil {
+			logger.InfoMsg("Could not cache compiler output", "file", file, "error", err)
+		}
+	}
+
 	return &resp, nil
 }
 
@@ -227,16 +315,27 @@ func objectName(contract string) string {
 	return parts[len(parts)-1]
 }
 
-func runSolidity(jsonCmd string, workDir string) (string, error) {
-	buf := bytes.NewBufferString(jsonCmd)
-	shellCmd := exec.Command("solc", "--standard-json", "--allow-paths", "/")
+// Returns the solc to compile file with, which must satisfy the pragmas of file as well as any version configured
+func (compiler *Compiler) solc(file string, workDir string) (*Solc, error) {
+	var constraints []string
+	if compiler.Version != "" {
+		constraints = append(constraints, compiler.Version)
+	}
+	// solc reports a missing file itself
+	source, err := ioutil.ReadFile(sourceFile(workDir, file))
+	if err == nil {
+		constraints = append(constraints, pragmaConstraints(source)...)
+	}
+	return FindSolc(compiler.SolcDir, constraints...)
+}
+
+func runSolidity(solc string, jsonCmd []byte, workDir string) ([]byte, error) {
+	shellCmd := exec.Command(solc, "--standard-json", "--allow-paths", "/")
 	if workDir != "" {
 		shellCmd.Dir = workDir
 	}
-	shellCmd.Stdin = buf
-	output, err := shellCmd.CombinedOutput()
-	s := string(output)
-	return s, err
+	shellCmd.Stdin = bytes.NewBuffer(jsonCmd)
+	return shellCmd.CombinedOutput()
 }
 
 func PrintResponse(resp Response, cli bool, logger *logging.Logger) {
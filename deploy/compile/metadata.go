@@ -0,0 +1,56 @@
+package compile
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// SolidityMetadata is the description solc gives of how it compiled a contract
+type SolidityMetadata struct {
+	Compiler struct {
+		Version string `json:"version"`
+	} `json:"compiler"`
+	Language string `json:"language"`
+	Settings struct {
+		// The file compiled and the name of the contract within it
+		CompilationTarget map[string]string `json:"compilationTarget"`
+		EVMVersion        string            `json:"evmVersion,omitempty"`
+		Libraries         map[string]string `json:"libraries,omitempty"`
+		Optimizer         struct {
+			Enabled bool `json:"enabled"`
+			Runs    int  `json:"runs"`
+		} `json:"optimizer"`
+		Remappings []string `json:"remappings,omitempty"`
+	} `json:"settings"`
+	// Every source compiled, including those imported, by path
+	Sources map[string]struct {
+		Keccak256 string `json:"keccak256"`
+	} `json:"sources"`
+}
+
+// ParseMetadata returns the metadata output by solc for the contract
+func (contract *SolidityContract) ParseMetadata() (*SolidityMetadata, error) {
+	if contract.Metadata == "" {
+		return nil, fmt.Errorf("contract has no metadata")
+	}
+	metadata := new(SolidityMetadata)
+	err := json.Unmarshal([]byte(contract.Metadata), metadata)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse contract metadata: %v", err)
+	}
+	return metadata, nil
+}
+
+// StripMetadataHash removes the CBOR encoded hash of the contract's metadata that solc appends to its code, the
+// length of which is given by the last two bytes. Code compiled from sources that differ only in comments or
+// whitespace, or that were compiled from different paths, differs only in this hash.
+func StripMetadataHash(code []byte) []byte {
+	if len(code) < 2 {
+		return code
+	}
+	length := int(code[len(code)-2])<<8 | int(code[len(code)-1])
+	if length+2 > len(code) {
+		return code
+	}
+	return code[:len(code)-length-2]
+}
@@ -0,0 +1,41 @@
+package compile
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/execution/solidity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hex "github.com/tmthrgd/go-hex"
+)
+
+func TestParseMetadata(t *testing.T) {
+	contract := &SolidityContract{Metadata: `{"compiler":{"version":"0.5.4+commit.9549d8ff"},"language":"Solidity",
+		"settings":{"compilationTarget":{"zero_reset.sol":"ZeroReset"},"evmVersion":"byzantium","libraries":{},
+		"optimizer":{"enabled":false,"runs":200},"remappings":[]},
+		"sources":{"zero_reset.sol":{"keccak256":"0x7d1e","urls":["bzzr://1b2c"]}},"version":1}`}
+	metadata, err := contract.ParseMetadata()
+	require.NoError(t, err)
+	assert.Equal(t, "0.5.4+commit.9549d8ff", metadata.Compiler.Version)
+	assert.Equal(t, map[string]string{"zero_reset.sol": "ZeroReset"}, metadata.Settings.CompilationTarget)
+	assert.Equal(t, 200, metadata.Settings.Optimizer.Runs)
+	assert.Equal(t, "0x7d1e", metadata.Sources["zero_reset.sol"].Keccak256)
+
+	_, err = new(SolidityContract).ParseMetadata()
+	assert.Error(t, err)
+}
+
+func TestStripMetadataHash(t *testing.T) {
+	code := solidity.Bytecode_ZeroReset
+	stripped := StripMetadataHash(code)
+	assert.Len(t, stripped, len(code)-43)
+	assert.Equal(t, "56fe", hex.EncodeToString(stripped[len(stripped)-2:]))
+	assert.Equal(t, []byte{1}, StripMetadataHash([]byte{1}))
+}
+
+func TestSolcVersionRegex(t *testing.T) {
+	match := solcVersionRegex.FindSubmatch([]byte("solc, the solidity compiler commandline interface\n" +
+		"Version: 0.5.12+commit.7709ece9.Linux.g++\n"))
+	require.NotNil(t, match)
+	assert.Equal(t, "0.5.12+commit.7709ece9", string(match[1]))
+}
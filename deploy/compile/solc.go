@@ -0,0 +1,131 @@
+package compile
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/cep21/xdgbasedir"
+)
+
+// Solc is a solc binary
+type Solc struct {
+	Path string
+	// The version solc reports in the form it appears in metadata, such as 0.5.4+commit.9549d8ff
+	Version string
+	release solcRelease
+}
+
+// DefaultSolcDir is where solc binaries are looked for by default, $XDG_DATA_HOME/burrow/solc
+func DefaultSolcDir() string {
+	dir, err := xdgbasedir.DataHomeDirectory()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "burrow", "solc")
+}
+
+// DefaultCacheDir is where compiler outputs are cached by default, $XDG_CACHE_HOME/burrow/solc
+func DefaultCacheDir() string {
+	dir, err := xdgbasedir.CacheDirectory()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "burrow", "solc")
+}
+
+// Matches the names of the binaries solc releases such as solc-0.5.4, solc-v0.5.4+commit.9549d8ff or
+// solc-linux-amd64-v0.5.4+commit.9549d8ff
+var solcFileRegex = regexp.MustCompile(`^solc-(.*-)?v?([0-9]+\.[0-9]+\.[0-9]+)(\+commit\.[[:xdigit:]]+)?(\.exe)?$`)
+
+// FindSolc returns the latest release of solc satisfying every version constraint from those in dir, named for their
+// version, and solc on the path
+func FindSolc(dir string, constraints ...string) (*Solc, error) {
+	var vcs []versionConstraint
+	for _, c := range constraints {
+		vc, err := parseConstraint(c)
+		if err != nil {
+			return nil, err
+		}
+		vcs = append(vcs, vc)
+	}
+	var candidates []*Solc
+	if path, err := exec.LookPath("solc"); err == nil {
+		solc, err := newSolc(path)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, solc)
+	}
+	if dir != "" {
+		files, err := ioutil.ReadDir(dir)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, fmt.Errorf("could not read solc directory %s: %v", dir, err)
+		}
+		for _, file := range files {
+			match := solcFileRegex.FindStringSubmatch(file.Name())
+			if match == nil || file.IsDir() {
+				continue
+			}
+			release, _, _ := parseRelease(match[2])
+			candidates = append(candidates, &Solc{Path: filepath.Join(dir, file.Name()), release: release})
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("could not find solc on the path or in %s", dir)
+	}
+	// Latest first, preferring solc on the path
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[j].release.less(candidates[i].release)
+	})
+candidates:
+	for _, solc := range candidates {
+		for _, vc := range vcs {
+			if !vc.matches(solc.release) {
+				continue candidates
+			}
+		}
+		if solc.Version == "" {
+			return newSolc(solc.Path)
+		}
+		return solc, nil
+	}
+	var installed []string
+	for _, solc := range candidates {
+		installed = append(installed, solc.release.String())
+	}
+	return nil, fmt.Errorf("no solc installed satisfies %s, found %s; add solc-<version> to %s",
+		strings.Join(constraints, " and "), strings.Join(installed, ", "), dir)
+}
+
+func newSolc(path string) (*Solc, error) {
+	version, err := solcVersion(path)
+	if err != nil {
+		return nil, err
+	}
+	release, _, err := parseRelease(version)
+	if err != nil {
+		return nil, err
+	}
+	return &Solc{Path: path, Version: version, release: release}, nil
+}
+
+var solcVersionRegex = regexp.MustCompile(`Version: ([0-9]+\.[0-9]+\.[0-9]+(-[[:alnum:].]+)?\+commit\.[[:xdigit:]]+)`)
+
+// Returns the version solc reports
+func solcVersion(path string) (string, error) {
+	output, err := exec.Command(path, "--version").CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("could not run %s --version: %v", path, err)
+	}
+	match := solcVersionRegex.FindSubmatch(output)
+	if match == nil {
+		return "", fmt.Errorf("could not find version in output of %s --version: %s", path, output)
+	}
+	return string(match[1]), nil
+}
@@ -0,0 +1,155 @@
+package compile
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Output of the fake solc, which reads the source compiled and one it imports
+const fakeOutput = `{"contracts":{"storage.sol":{"Storage":{"abi":[],"evm":{"bytecode":{"object":"6080"}}}}},` +
+	`"sources":{"storage.sol":{"id":0},"lib/lib.sol":{"id":1}}}`
+
+// Writes a fake solc reporting version to dir that records its input and each time it runs
+func writeFakeSolc(t *testing.T, dir, name, version string) {
+	script := fmt.Sprintf(`#!/bin/sh
+if [ "$1" = "--version" ]; then
+  echo "solc, the solidity compiler commandline interface"
+  echo "Version: %[1]s.Linux.g++"
+  exit 0
+fi
+: > %[2]s/input
+while IFS= read -r line || [ -n "$line" ]; do printf '%%s' "$line" >> %[2]s/input; done
+echo %[1]s >> %[2]s/runs
+echo '%[3]s'
+`, version, dir, fakeOutput)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), []byte(script), 0755))
+}
+
+// Hides any solc on the path
+func emptyPath(t *testing.T) func() {
+	dir, err := ioutil.TempDir("", "path")
+	require.NoError(t, err)
+	path := os.Getenv("PATH")
+	require.NoError(t, os.Setenv("PATH", dir))
+	return func() {
+		os.Setenv("PATH", path)
+		os.RemoveAll(dir)
+	}
+}
+
+func TestFindSolc(t *testing.T) {
+	defer emptyPath(t)()
+	dir, err := ioutil.TempDir("", "solc")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	_, err = FindSolc(dir)
+	require.Error(t, err)
+
+	writeFakeSolc(t, dir, "solc-0.4.25", "0.4.25+commit.59dbf8f1")
+	writeFakeSolc(t, dir, "solc-v0.5.4+commit.9549d8ff", "0.5.4+commit.9549d8ff")
+	writeFakeSolc(t, dir, "solc-linux-amd64-v0.5.12+commit.7709ece9", "0.5.12+commit.7709ece9")
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "README"), nil, 0644))
+
+	for constraints, version := range map[string]string{
+		"":                          "0.5.12+commit.7709ece9",
+		"^0.5.0":                    "0.5.12+commit.7709ece9",
+		">=0.4.22 <0.5.0":           "0.4.25+commit.59dbf8f1",
+		"^0.5.0,0.5.4":              "0.5.4+commit.9549d8ff",
+		"0.5.4+commit.9549d8ff":     "0.5.4+commit.9549d8ff",
+		"^0.4.24 || ^0.5.0,<0.5.10": "0.5.4+commit.9549d8ff",
+	} {
+		var cs []string
+		if constraints != "" {
+			cs = strings.Split(constraints, ",")
+		}
+		solc, err := FindSolc(dir, cs...)
+		require.NoError(t, err, constraints)
+		assert.Equal(t, version, solc.Version, constraints)
+	}
+
+	_, err = FindSolc(dir, "^0.6.0")
+	assert.EqualError(t, err, "no solc installed satisfies ^0.6.0, found 0.5.12, 0.5.4, 0.4.25; add "+
+		"solc-<version> to "+dir)
+}
+
+func TestCompiler_Compile(t *testing.T) {
+	defer emptyPath(t)()
+	dir, err := ioutil.TempDir("", "compile")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	solcDir := filepath.Join(dir, "solc")
+	workDir := filepath.Join(dir, "work")
+	require.NoError(t, os.MkdirAll(solcDir, 0775))
+	require.NoError(t, os.MkdirAll(filepath.Join(workDir, "lib"), 0775))
+	writeFakeSolc(t, solcDir, "solc-0.4.25", "0.4.25+commit.59dbf8f1")
+	writeFakeSolc(t, solcDir, "solc-0.5.4", "0.5.4+commit.9549d8ff")
+	require.NoError(t, ioutil.WriteFile(filepath.Join(workDir, "storage.sol"),
+		[]byte("pragma solidity ^0.4.24;\nimport \"lib/lib.sol\";\ncontract Storage {}\n"), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(workDir, "lib", "lib.sol"),
+		[]byte("library Lib {}\n"), 0644))
+
+	compiler := &Compiler{
+		Optimize:      true,
+		OptimizerRuns: 1000,
+		Remappings:    []string{"lib/=lib/"},
+		SolcDir:       solcDir,
+		CacheDir:      filepath.Join(dir, "cache"),
+	}
+	logger := logging.NewNoopLogger()
+	runs := func() []string {
+		bs, err := ioutil.ReadFile(filepath.Join(solcDir, "runs"))
+		require.NoError(t, err)
+		return strings.Fields(string(bs))
+	}
+
+	// The pragma picks the compiler
+	resp, err := compiler.Compile("storage.sol", workDir, nil, logger)
+	require.NoError(t, err)
+	assert.Equal(t, "0.4.25+commit.59dbf8f1", resp.Version)
+	require.Len(t, resp.Objects, 1)
+	assert.Equal(t, "6080", resp.Objects[0].Contract.Evm.Bytecode.Object)
+	assert.Equal(t, []string{"0.4.25+commit.59dbf8f1"}, runs())
+	input, err := ioutil.ReadFile(filepath.Join(solcDir, "input"))
+	require.NoError(t, err)
+	assert.Contains(t, string(input), `"optimizer":{"enabled":true,"runs":1000}`)
+	assert.Contains(t, string(input), `"remappings":["lib/=lib/"]`)
+
+	// Compiling again uses the cached output
+	resp, err = compiler.Compile("storage.sol", workDir, nil, logger)
+	require.NoError(t, err)
+	assert.Equal(t, "6080", resp.Objects[0].Contract.Evm.Bytecode.Object)
+	assert.Len(t, runs(), 1)
+
+	// until an import changes
+	require.NoError(t, ioutil.WriteFile(filepath.Join(workDir, "lib", "lib.sol"),
+		[]byte("library Lib { }\n"), 0644))
+	_, err = compiler.Compile("storage.sol", workDir, nil, logger)
+	require.NoError(t, err)
+	assert.Len(t, runs(), 2)
+
+	// or the settings do
+	compiler.OptimizerRuns = 200
+	_, err = compiler.Compile("storage.sol", workDir, nil, logger)
+	require.NoError(t, err)
+	assert.Len(t, runs(), 3)
+
+	// or the compiler does
+	compiler.Version = "^0.5.0"
+	_, err = compiler.Compile("storage.sol", workDir, nil, logger)
+	assert.Error(t, err)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(workDir, "storage.sol"),
+		[]byte("pragma solidity >=0.4.24;\nimport \"lib/lib.sol\";\ncontract Storage {}\n"), 0644))
+	resp, err = compiler.Compile("storage.sol", workDir, nil, logger)
+	require.NoError(t, err)
+	assert.Equal(t, "0.5.4+commit.9549d8ff", resp.Version)
+	assert.Len(t, runs(), 4)
+}
@@ -0,0 +1,116 @@
+package compile
+
+import (
+	"fmt"
+	"strings"
+)
+
+// StorageLayout is where a contract's state variables live in storage as output by solc (0.5.13 onwards)
+type StorageLayout struct {
+	Storage []StorageVariable       `json:"storage"`
+	Types   map[string]*StorageType `json:"types"`
+}
+
+type StorageVariable struct {
+	Contract string `json:"contract,omitempty"`
+	Label    string `json:"label"`
+	Offset   int    `json:"offset"`
+	Slot     string `json:"slot"`
+	// Key into the Types of the layout
+	Type string `json:"type"`
+}
+
+type StorageType struct {
+	// One of inplace, mapping, dynamic_array or bytes
+	Encoding      string `json:"encoding"`
+	Label         string `json:"label"`
+	NumberOfBytes string `json:"numberOfBytes"`
+	// The element type of arrays
+	Base string `json:"base,omitempty"`
+	// The key and value types of mappings
+	Key   string `json:"key,omitempty"`
+	Value string `json:"value,omitempty"`
+	// The fields of structs
+	Members []StorageVariable `json:"members,omitempty"`
+}
+
+// CheckUpgrade returns an error if code with the next layout would misread the storage written by code with this
+// layout. Every variable must keep its slot, offset and type, though it may be renamed. New variables can only be
+// added after the existing ones, and new fields only to the end of structs held in mappings.
+func (layout *StorageLayout) CheckUpgrade(next *StorageLayout) error {
+	return checkVariables("", layout.Storage, layout, next.Storage, next)
+}
+
+func checkVariables(path string, prevVars []StorageVariable, prev *StorageLayout, nextVars []StorageVariable,
+	next *StorageLayout) error {
+	for i, pv := range prevVars {
+		name := path + pv.Label
+		if i >= len(nextVars) {
+			return fmt.Errorf("%s has been removed", name)
+		}
+		nv := nextVars[i]
+		if pv.Slot != nv.Slot || pv.Offset != nv.Offset {
+			return fmt.Errorf("%s at slot %s offset %d has been replaced by %s at slot %s offset %d",
+				name, pv.Slot, pv.Offset, path+nv.Label, nv.Slot, nv.Offset)
+		}
+		err := checkType(name, pv.Type, prev, nv.Type, next, false)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Checks that a value of the next type can be read from storage written as the previous type. Values that grow
+// occupy slots of their own and so can take up more space than before.
+func checkType(name, prevType string, prev *StorageLayout, nextType string, next *StorageLayout, grows bool) error {
+	pt, ok := prev.Types[prevType]
+	if !ok {
+		return fmt.Errorf("type %s of %s is missing from the storage layout", prevType, name)
+	}
+	nt, ok := next.Types[nextType]
+	if !ok {
+		return fmt.Errorf("type %s of %s is missing from the storage layout", nextType, name)
+	}
+	changed := fmt.Errorf("%s has changed type from %s to %s", name, pt.Label, nt.Label)
+	if pt.Encoding != nt.Encoding {
+		return changed
+	}
+	if pt.NumberOfBytes != nt.NumberOfBytes && !(grows && pt.Members != nil) {
+		return changed
+	}
+	switch {
+	case pt.Members != nil:
+		if nt.Members == nil {
+			return changed
+		}
+		if !grows && len(nt.Members) != len(pt.Members) {
+			return fmt.Errorf("%s cannot change its number of fields because it is not held in a mapping", name)
+		}
+		return checkVariables(name+".", pt.Members, prev, nt.Members, next)
+	case pt.Encoding == "mapping":
+		if pk, nk := valueLabel(prev.Types[pt.Key]), valueLabel(next.Types[nt.Key]); pk != nk {
+			return fmt.Errorf("%s has changed key type from %s to %s", name, pk, nk)
+		}
+		return checkType(name+"[]", pt.Value, prev, nt.Value, next, true)
+	case pt.Base != "":
+		if nt.Base == "" {
+			return changed
+		}
+		return checkType(name+"[]", pt.Base, prev, nt.Base, next, false)
+	case valueLabel(pt) != valueLabel(nt):
+		return changed
+	}
+	return nil
+}
+
+// Contracts are stored as addresses
+func valueLabel(typ *StorageType) string {
+	if typ == nil {
+		return ""
+	}
+	if strings.HasPrefix(typ.Label, "contract ") || typ.Label == "address payable" {
+		return "address"
+	}
+	return typ.Label
+}
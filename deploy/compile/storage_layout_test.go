@@ -0,0 +1,103 @@
+package compile
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Layout of:
+//
+//	contract Registry {
+//	    struct Entry { address owner; uint64 expiry; }
+//	    uint256 count;
+//	    mapping(bytes32 => Entry) entries;
+//	    Registry[] children;
+//	}
+const registryLayout = `{
+  "storage": [
+    {"label": "count", "offset": 0, "slot": "0", "type": "t_uint256"},
+    {"label": "entries", "offset": 0, "slot": "1", "type": "t_mapping(t_bytes32,t_struct(Entry)6_storage)"},
+    {"label": "children", "offset": 0, "slot": "2", "type": "t_array(t_contract(Registry)20)dyn_storage"}
+  ],
+  "types": {
+    "t_address": {"encoding": "inplace", "label": "address", "numberOfBytes": "20"},
+    "t_bytes32": {"encoding": "inplace", "label": "bytes32", "numberOfBytes": "32"},
+    "t_uint64": {"encoding": "inplace", "label": "uint64", "numberOfBytes": "8"},
+    "t_uint256": {"encoding": "inplace", "label": "uint256", "numberOfBytes": "32"},
+    "t_contract(Registry)20": {"encoding": "inplace", "label": "contract Registry", "numberOfBytes": "20"},
+    "t_array(t_contract(Registry)20)dyn_storage": {"encoding": "dynamic_array", "label": "contract Registry[]",
+      "numberOfBytes": "32", "base": "t_contract(Registry)20"},
+    "t_mapping(t_bytes32,t_struct(Entry)6_storage)": {"encoding": "mapping",
+      "label": "mapping(bytes32 => struct Registry.Entry)", "numberOfBytes": "32", "key": "t_bytes32",
+      "value": "t_struct(Entry)6_storage"},
+    "t_struct(Entry)6_storage": {"encoding": "inplace", "label": "struct Registry.Entry", "numberOfBytes": "32",
+      "members": [
+        {"label": "owner", "offset": 0, "slot": "0", "type": "t_address"},
+        {"label": "expiry", "offset": 20, "slot": "0", "type": "t_uint64"}
+      ]}
+  }
+}`
+
+func TestStorageLayout_CheckUpgrade(t *testing.T) {
+	prev := readLayout(t, registryLayout)
+	require.NoError(t, prev.CheckUpgrade(readLayout(t, registryLayout)))
+
+	// Variables can be appended and renamed
+	next := readLayout(t, registryLayout)
+	next.Storage = append(next.Storage, StorageVariable{Label: "paused", Slot: "3", Type: "t_uint64"})
+	next.Storage[0].Label = "total"
+	require.NoError(t, prev.CheckUpgrade(next))
+
+	// Structs held in mappings can gain fields
+	next = readLayout(t, registryLayout)
+	entry := next.Types["t_struct(Entry)6_storage"]
+	entry.Members = append(entry.Members, StorageVariable{Label: "price", Slot: "1", Type: "t_uint256"})
+	entry.NumberOfBytes = "64"
+	require.NoError(t, prev.CheckUpgrade(next))
+
+	// Contracts are addresses
+	next = readLayout(t, registryLayout)
+	next.Types["t_array(t_contract(Registry)20)dyn_storage"].Base = "t_address"
+	require.NoError(t, prev.CheckUpgrade(next))
+
+	// But variables cannot be removed
+	next = readLayout(t, registryLayout)
+	next.Storage = next.Storage[:2]
+	assert.EqualError(t, prev.CheckUpgrade(next), "children has been removed")
+
+	// or inserted before others
+	next = readLayout(t, registryLayout)
+	next.Storage = append([]StorageVariable{{Label: "owner", Slot: "0", Type: "t_address"}}, next.Storage...)
+	for i := range next.Storage[1:] {
+		next.Storage[i+1].Slot = strconv.Itoa(i + 1)
+	}
+	assert.EqualError(t, prev.CheckUpgrade(next), "count has changed type from uint256 to address")
+
+	// or moved
+	next = readLayout(t, registryLayout)
+	next.Types["t_struct(Entry)6_storage"].Members[1].Type = "t_uint256"
+	next.Types["t_struct(Entry)6_storage"].Members[1].Slot = "1"
+	next.Types["t_struct(Entry)6_storage"].Members[1].Offset = 0
+	assert.EqualError(t, prev.CheckUpgrade(next),
+		"entries[].expiry at slot 0 offset 20 has been replaced by entries[].expiry at slot 1 offset 0")
+
+	// or change type
+
+	next = readLayout(t, registryLayout)
+	next.Types["t_mapping(t_bytes32,t_struct(Entry)6_storage)"].Key = "t_uint256"
+	assert.EqualError(t, prev.CheckUpgrade(next), "entries has changed key type from bytes32 to uint256")
+
+	next = readLayout(t, registryLayout)
+	next.Storage[0].Type = "t_uint64"
+	assert.EqualError(t, prev.CheckUpgrade(next), "count has changed type from uint256 to uint64")
+}
+
+func readLayout(t *testing.T, layoutJSON string) *StorageLayout {
+	layout := new(StorageLayout)
+	require.NoError(t, json.Unmarshal([]byte(layoutJSON), layout))
+	return layout
+}
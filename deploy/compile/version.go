@@ -0,0 +1,178 @@
+package compile
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// A solc release such as 0.5.4
+type solcRelease [3]int
+
+func (r solcRelease) String() string {
+	return fmt.Sprintf("%d.%d.%d", r[0], r[1], r[2])
+}
+
+func (r solcRelease) less(o solcRelease) bool {
+	for i := range r {
+		if r[i] != o[i] {
+			return r[i] < o[i]
+		}
+	}
+	return false
+}
+
+var releaseRegex = regexp.MustCompile(`^v?([0-9]+)(\.([0-9]+|[xX*]))?(\.([0-9]+|[xX*]))?([-+].*)?$`)
+
+// Parses a release, returning how many of its parts were given so that 0.5 can be read as any 0.5.x. Any
+// prerelease or build suffix, such as +commit.9549d8ff, is ignored.
+func parseRelease(version string) (solcRelease, int, error) {
+	var release solcRelease
+	match := releaseRegex.FindStringSubmatch(version)
+	if match == nil {
+		return release, 0, fmt.Errorf("could not parse solc version '%s'", version)
+	}
+	parts := 0
+	for i, part := range []string{match[1], match[3], match[5]} {
+		if part == "" || part == "x" || part == "X" || part == "*" {
+			break
+		}
+		release[i], _ = strconv.Atoi(part)
+		parts++
+	}
+	return release, parts, nil
+}
+
+type comparator struct {
+	op      string
+	release solcRelease
+}
+
+func (c comparator) matches(r solcRelease) bool {
+	switch c.op {
+	case ">=":
+		return !r.less(c.release)
+	case ">":
+		return c.release.less(r)
+	case "<=":
+		return !c.release.less(r)
+	case "<":
+		return r.less(c.release)
+	}
+	return r == c.release
+}
+
+// versionConstraint is a range of solc releases in the syntax of Solidity's version pragma, such as ^0.5.0 or
+// >=0.4.22 <0.6.0. Alternatives are separated by ||.
+type versionConstraint [][]comparator
+
+var operatorSpaceRegex = regexp.MustCompile(`(\^|~|>=|<=|>|<|=)\s+`)
+
+func parseConstraint(constraint string) (versionConstraint, error) {
+	var vc versionConstraint
+	for _, alternative := range strings.Split(constraint, "||") {
+		var comparators []comparator
+		fields := strings.Fields(operatorSpaceRegex.ReplaceAllString(alternative, "$1"))
+		if len(fields) == 3 && fields[1] == "-" {
+			// A hyphen range
+			lower, _, err := parseRelease(fields[0])
+			if err != nil {
+				return nil, err
+			}
+			upper, _, err := parseRelease(fields[2])
+			if err != nil {
+				return nil, err
+			}
+			vc = append(vc, []comparator{{">=", lower}, {"<=", upper}})
+			continue
+		}
+		for _, field := range fields {
+			cs, err := parseComparator(field)
+			if err != nil {
+				return nil, fmt.Errorf("could not parse version constraint '%s': %v", constraint, err)
+			}
+			comparators = append(comparators, cs...)
+		}
+		vc = append(vc, comparators)
+	}
+	return vc, nil
+}
+
+var comparatorRegex = regexp.MustCompile(`^(\^|~|>=|<=|>|<|=)?(.*)$`)
+
+func parseComparator(field string) ([]comparator, error) {
+	match := comparatorRegex.FindStringSubmatch(field)
+	op := match[1]
+	release, parts, err := parseRelease(match[2])
+	if err != nil {
+		return nil, err
+	}
+	// The first release after those matching the parts given
+	next := func(part int) solcRelease {
+		n := release
+		n[part]++
+		for i := part + 1; i < len(n); i++ {
+			n[i] = 0
+		}
+		return n
+	}
+	switch op {
+	case "^":
+		// Allow changes that do not modify the left-most non-zero part
+		part := 0
+		for part < parts-1 && release[part] == 0 {
+			part++
+		}
+		return []comparator{{">=", release}, {"<", next(part)}}, nil
+	case "~":
+		if parts < 2 {
+			return []comparator{{">=", release}, {"<", next(0)}}, nil
+		}
+		return []comparator{{">=", release}, {"<", next(1)}}, nil
+	case "", "=":
+		if parts == 0 {
+			return nil, nil
+		}
+		if parts < 3 {
+			return []comparator{{">=", release}, {"<", next(parts - 1)}}, nil
+		}
+		return []comparator{{"=", release}}, nil
+	case ">", "<=":
+		if parts > 0 && parts < 3 {
+			// >0.5 means >=0.6.0 and <=0.5 means <0.6.0
+			if op == ">" {
+				return []comparator{{">=", next(parts - 1)}}, nil
+			}
+			return []comparator{{"<", next(parts - 1)}}, nil
+		}
+	}
+	return []comparator{{op, release}}, nil
+}
+
+func (vc versionConstraint) matches(r solcRelease) bool {
+	for _, comparators := range vc {
+		ok := true
+		for _, c := range comparators {
+			if !c.matches(r) {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			return true
+		}
+	}
+	return false
+}
+
+var pragmaRegex = regexp.MustCompile(`(?m)^\s*pragma\s+solidity\s+([^;]+);`)
+
+// Returns the version constraints of the solidity pragmas in source
+func pragmaConstraints(source []byte) []string {
+	var constraints []string
+	for _, match := range pragmaRegex.FindAllSubmatch(source, -1) {
+		constraints = append(constraints, strings.TrimSpace(string(match[1])))
+	}
+	return constraints
+}
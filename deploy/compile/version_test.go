@@ -0,0 +1,47 @@
+package compile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseConstraint(t *testing.T) {
+	for constraint, releases := range map[string]map[string]bool{
+		"^0.5.0":                 {"0.5.0": true, "0.5.12": true, "0.4.26": false, "0.6.0": false},
+		"^0.0.3":                 {"0.0.3": true, "0.0.4": false},
+		"~0.4.24":                {"0.4.24": true, "0.4.26": true, "0.4.23": false, "0.5.0": false},
+		">=0.4.22 <0.6.0":        {"0.4.22": true, "0.5.12": true, "0.4.21": false, "0.6.0": false},
+		">= 0.4.22 < 0.6.0":      {"0.4.22": true, "0.6.0": false},
+		"0.5.4":                  {"0.5.4": true, "0.5.5": false},
+		"=0.5.4+commit.9549d8ff": {"0.5.4": true, "0.5.3": false},
+		"0.5":                    {"0.5.0": true, "0.5.9": true, "0.6.0": false},
+		"0.5.x":                  {"0.5.9": true, "0.4.0": false},
+		">0.5":                   {"0.5.9": false, "0.6.0": true},
+		"<=0.5":                  {"0.5.9": true, "0.6.0": false},
+		"^0.4.24 || ^0.5.2":      {"0.4.25": true, "0.5.1": false, "0.5.2": true, "0.6.0": false},
+		"0.4.22 - 0.5.4":         {"0.4.22": true, "0.5.4": true, "0.5.5": false},
+	} {
+		vc, err := parseConstraint(constraint)
+		require.NoError(t, err, constraint)
+		for version, matches := range releases {
+			release, _, err := parseRelease(version)
+			require.NoError(t, err)
+			assert.Equal(t, matches, vc.matches(release), "%s matches %s", constraint, version)
+		}
+	}
+
+	_, err := parseConstraint("^foo")
+	assert.Error(t, err)
+}
+
+func TestPragmaConstraints(t *testing.T) {
+	source := []byte(`pragma solidity >=0.4.22 <0.6.0;
+pragma experimental ABIEncoderV2;
+import "./lib.sol";
+
+contract Storage {}`)
+	assert.Equal(t, []string{">=0.4.22 <0.6.0"}, pragmaConstraints(source))
+	assert.Nil(t, pragmaConstraints([]byte("contract Storage {}")))
+}
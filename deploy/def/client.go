@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"sync"
 	"time"
 
 	"reflect"
@@ -12,6 +13,7 @@ import (
 	hex "github.com/tmthrgd/go-hex"
 
 	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/acmstate"
 	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/evm/abi"
@@ -34,6 +36,8 @@ type Client struct {
 	MempoolSigning    bool
 	ChainAddress      string
 	KeysClientAddress string
+	// Execute transactions against an in-memory fork of the chain's state rather than broadcasting them
+	DryRun bool
 	// Memoised clients and info
 	chainID               string
 	timeout               time.Duration
@@ -41,7 +45,11 @@ type Client struct {
 	queryClient           rpcquery.QueryClient
 	executionEventsClient rpcevents.ExecutionEventsClient
 	keyClient             keys.KeyClient
-	AllSpecs              *abi.AbiSpec
+	simulator             *Simulator
+	// Protects the fields below, which may be updated by jobs running concurrently
+	mtx      sync.Mutex
+	receipts map[payload.Payload]*txs.Receipt
+	AllSpecs *abi.AbiSpec
 }
 
 func NewClient(chain, keysClientAddress string, mempoolSigning bool, timeout time.Duration) *Client {
@@ -84,10 +92,34 @@ func (c *Client) dial(logger *logging.Logger) error {
 			return err
 		}
 		c.chainID = stat.ChainID
+
+		if c.DryRun {
+			c.simulator, err = NewSimulator(c.queryClient, c.timeout, logger)
+			if err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
 
+// Plan returns the changes the transactions sent so far would make to the chain, it is only available for a dry run
+func (c *Client) Plan() (*Plan, error) {
+	if c.simulator == nil {
+		return nil, fmt.Errorf("no plan available since client is not performing a dry run")
+	}
+	return c.simulator.Plan()
+}
+
+// ResetPlan starts a new plan from the chain's current state, discarding the changes of the transactions sent so far,
+// it does nothing unless the client is performing a dry run
+func (c *Client) ResetPlan() error {
+	if c.simulator == nil {
+		return nil
+	}
+	return c.simulator.Fork()
+}
+
 func (c *Client) Transact(logger *logging.Logger) (rpctransact.TransactClient, error) {
 	err := c.dial(logger)
 	if err != nil {
@@ -135,12 +167,23 @@ func (c *Client) GetKeyAddress(key string, logger *logging.Logger) (crypto.Addre
 }
 
 func (c *Client) GetAccount(address crypto.Address) (*acm.Account, error) {
+	if c.simulator != nil {
+		acc, err := c.simulator.GetAccount(address)
+		// Match the query server which returns an empty account if none exists
+		if acc == nil && err == nil {
+			acc = &acm.Account{}
+		}
+		return acc, err
+	}
 	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
 	defer cancel()
 	return c.queryClient.GetAccount(ctx, &rpcquery.GetAccountParam{Address: address})
 }
 
 func (c *Client) GetStorage(address crypto.Address, key binary.Word256) (binary.Word256, error) {
+	if c.simulator != nil {
+		return c.simulator.GetStorage(address, key)
+	}
 	val, err := c.queryClient.GetStorage(context.Background(), &rpcquery.GetStorageParam{Address: address, Key: key})
 	if err != nil {
 		return binary.Word256{}, err
@@ -153,6 +196,13 @@ func (c *Client) GetName(name string, logger *logging.Logger) (*names.Entry, err
 	if err != nil {
 		return nil, err
 	}
+	if c.simulator != nil {
+		entry, err := c.simulator.GetName(name)
+		if entry == nil && err == nil {
+			err = fmt.Errorf("name %s not found", name)
+		}
+		return entry, err
+	}
 	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
 	defer cancel()
 	return c.queryClient.GetName(ctx, &rpcquery.GetNameParam{Name: name})
@@ -163,6 +213,9 @@ func (c *Client) GetValidatorSet(logger *logging.Logger) (*rpcquery.ValidatorSet
 	if err != nil {
 		return nil, err
 	}
+	if c.simulator != nil {
+		return &rpcquery.ValidatorSet{Set: c.simulator.Validators()}, nil
+	}
 	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
 	defer cancel()
 	return c.queryClient.GetValidatorSet(ctx, &rpcquery.GetValidatorSetParam{})
@@ -173,6 +226,13 @@ func (c *Client) GetProposal(hash []byte, logger *logging.Logger) (*payload.Ball
 	if err != nil {
 		return nil, err
 	}
+	if c.simulator != nil {
+		ballot, err := c.simulator.GetProposal(hash)
+		if ballot == nil && err == nil {
+			err = fmt.Errorf("proposal %x not found", hash)
+		}
+		return ballot, err
+	}
 	return c.queryClient.GetProposal(context.Background(), &rpcquery.GetProposalParam{Hash: hash})
 }
 
@@ -215,19 +275,51 @@ func (c *Client) SignTx(tx payload.Payload, logger *logging.Logger) (*txs.Envelo
 		return nil, err
 	}
 	txEnv := txs.Enclose(c.chainID, tx)
+	if c.DryRun {
+		logger.InfoMsg("Not signing transaction for dry run")
+		return txEnv, nil
+	}
 	if c.MempoolSigning {
 		logger.InfoMsg("Using mempool signing")
 		return txEnv, nil
 	}
-	inputs := tx.GetInputs()
-	signers := make([]acm.AddressableSigner, len(inputs))
-	for i, input := range inputs {
-		signers[i], err = keys.AddressableSigner(c.keyClient, input.Address)
+	var signers []acm.AddressableSigner
+	multisigAccounts := acmstate.NewMemoryState()
+	for _, input := range tx.GetInputs() {
+		acc, err := c.GetAccount(input.Address)
 		if err != nil {
 			return nil, err
 		}
+		if acc != nil && acc.Multisig != nil {
+			err = multisigAccounts.UpdateAccount(acc)
+			if err != nil {
+				return nil, err
+			}
+			// Sign with whichever of the multisig's keys we hold
+			for _, publicKey := range acc.Multisig.PublicKeys {
+				signer, err := keys.AddressableSigner(c.keyClient, publicKey.GetAddress())
+				if err == nil {
+					signers = append(signers, signer)
+				}
+			}
+			continue
+		}
+		if acc != nil && acc.PublicKey.IsSet() && acc.PublicKey.GetAddress() != input.Address {
+			// The account's key has been rotated
+			signer, err := keys.AddressableSigner(c.keyClient, acc.PublicKey.GetAddress())
+			if err != nil {
+				return nil, err
+			}
+			signers = append(signers, acm.RotatedSigner(input.Address, signer))
+			continue
+		}
+		signer, err := keys.AddressableSigner(c.keyClient, input.Address)
+		if err != nil {
+			return nil, err
+		}
+		signers = append(signers, signer)
 	}
-	err = txEnv.Sign(signers...)
+	err = txEnv.SignMultisig(multisigAccounts, signers...)
 	if err != nil {
 		return nil, err
 	}
@@ -264,9 +356,19 @@ func (c *Client) Broadcast(tx payload.Payload, logger *logging.Logger) (*exec.Tx
 	if err != nil {
 		return nil, err
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
-	defer cancel()
-	return c.transactClient.BroadcastTxSync(ctx, &rpctransact.TxEnvelopeParam{Payload: tx.Any()})
+	var txe *exec.TxExecution
+	if c.simulator != nil {
+		txe, err = c.simulator.Execute(txs.Enclose(c.chainID, tx))
+	} else {
+		ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+		defer cancel()
+		txe, err = c.transactClient.BroadcastTxSync(ctx, &rpctransact.TxEnvelopeParam{Payload: tx.Any()})
+	}
+	if err != nil {
+		return nil, err
+	}
+	c.record(tx, txe)
+	return txe, nil
 }
 
 // Broadcast envelope - can be locally signed or remote signing will be attempted
@@ -275,10 +377,80 @@ func (c *Client) BroadcastEnvelope(txEnv *txs.Envelope, logger *logging.Logger)
 	if err != nil {
 		return nil, err
 	}
+	var txe *exec.TxExecution
+	if c.simulator != nil {
+		txe, err = c.simulator.Execute(txEnv)
+	} else {
+		ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+		defer cancel()
+		txe, err = c.transactClient.BroadcastTxSync(ctx, &rpctransact.TxEnvelopeParam{Envelope: txEnv})
+	}
+	if err != nil {
+		return nil, err
+	}
+	c.record(txEnv.Tx.Payload, txe)
+	return txe, nil
+}
+
+// TakeReceipt returns the receipt of a transaction broadcast by this client, or nil if it has not been, and forgets it
+// so that receipts are only held until whoever sent the transaction has taken them
+func (c *Client) TakeReceipt(tx payload.Payload) *txs.Receipt {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	receipt := c.receipts[tx]
+	delete(c.receipts, tx)
+	return receipt
+}
+
+func (c *Client) record(tx payload.Payload, txe *exec.TxExecution) {
+	if txe.Receipt == nil {
+		return
+	}
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if c.receipts == nil {
+		c.receipts = make(map[payload.Payload]*txs.Receipt)
+	}
+	c.receipts[tx] = txe.Receipt
+}
+
+// GetTx fetches the execution of a committed transaction by its hash
+func (c *Client) GetTx(txHash binary.HexBytes, logger *logging.Logger) (*exec.TxExecution, error) {
+	err := c.dial(logger)
+	if err != nil {
+		return nil, err
+	}
+	if c.simulator != nil {
+		// Transactions executed by a dry run are not on chain
+		if txe := c.simulator.Tx(txHash); txe != nil {
+			return txe, nil
+		}
+	}
 	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
 	defer cancel()
+	return c.executionEventsClient.Tx(ctx, &rpcevents.TxRequest{TxHash: txHash})
+}
+
+// MergeAbiSpec adds a contract's ABI to AllSpecs so that its events can be decoded
+func (c *Client) MergeAbiSpec(spec *abi.AbiSpec) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if c.AllSpecs == nil {
+		c.AllSpecs = spec
+		return
+	}
+	c.AllSpecs = abi.MergeAbiSpec([]*abi.AbiSpec{c.AllSpecs, spec})
+}
 
-	return c.transactClient.BroadcastTxSync(ctx, &rpctransact.TxEnvelopeParam{Envelope: txEnv})
+// EventSpec looks up an event in AllSpecs
+func (c *Client) EventSpec(eventID abi.EventID) (abi.EventSpec, bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if c.AllSpecs == nil {
+		return abi.EventSpec{}, false
+	}
+	evAbi, ok := c.AllSpecs.EventsById[eventID]
+	return evAbi, ok
 }
 
 func (c *Client) ParseUint64(amount string) (uint64, error) {
@@ -306,6 +478,9 @@ func (c *Client) QueryContract(arg *QueryArg, logger *logging.Logger) (*exec.TxE
 	if err != nil {
 		return nil, err
 	}
+	if c.simulator != nil {
+		return c.simulator.CallSim(tx)
+	}
 	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
 	defer cancel()
 	return c.transactClient.CallTxSim(ctx, tx)
@@ -322,6 +497,9 @@ type GovArg struct {
 	Roles       []string
 	Address     string
 	PublicKey   string
+	Signers     []string
+	Threshold   string
+	RotateKey   string
 }
 
 func (c *Client) UpdateAccount(arg *GovArg, logger *logging.Logger) (*payload.GovTx, error) {
@@ -338,9 +516,37 @@ func (c *Client) UpdateAccount(arg *GovArg, logger *logging.Logger) (*payload.Go
 		Permissions: arg.Permissions,
 		Roles:       arg.Permissions,
 	}
-	err = c.getIdentity(update, arg.Address, arg.PublicKey, logger)
-	if err != nil {
-		return nil, err
+	if len(arg.Signers) > 0 {
+		update.Multisig, err = c.multisig(arg.Signers, arg.Threshold, logger)
+		if err != nil {
+			return nil, err
+		}
+		if arg.Address == "" && arg.PublicKey == "" {
+			arg.Address = update.Multisig.Address().String()
+		}
+	}
+	if arg.RotateKey != "" {
+		if len(arg.Signers) > 0 || arg.Native != "" || arg.Power != "" || len(arg.Permissions) > 0 ||
+			len(arg.Roles) > 0 {
+			return nil, fmt.Errorf("an account's key cannot be rotated in the same update as other changes")
+		}
+		address, err := c.GetKeyAddress(arg.Address, logger)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse address of account whose key to rotate: %v", err)
+		}
+		publicKey, err := c.publicKey(arg.RotateKey, logger)
+		if err != nil {
+			return nil, fmt.Errorf("could not get public key to rotate to: %v", err)
+		}
+		update.Address = &address
+		update.PublicKey = &publicKey
+		update.RotateKey = true
+		arg.PublicKey = publicKey.String()
+	} else {
+		err = c.getIdentity(update, arg.Address, arg.PublicKey, logger)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	_, err = permission.PermFlagFromStringList(arg.Permissions)
@@ -403,6 +609,38 @@ func (c *Client) getIdentity(account *spec.TemplateAccount, address, publicKey s
 	return nil
 }
 
+// Returns the multisig of signers, given as key names, addresses or public keys
+func (c *Client) multisig(signers []string, thresholdString string, logger *logging.Logger) (*acm.Multisig, error) {
+	threshold, err := c.ParseUint64(thresholdString)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse multisig threshold: %v", err)
+	}
+	publicKeys := make([]crypto.PublicKey, len(signers))
+	for i, signer := range signers {
+		publicKeys[i], err = c.publicKey(signer, logger)
+		if err != nil {
+			return nil, fmt.Errorf("could not get public key of multisig signer: %v", err)
+		}
+	}
+	return acm.NewMultisig(threshold, publicKeys...)
+}
+
+// Returns the public key of key, given as a key name, address or public key
+func (c *Client) publicKey(key string, logger *logging.Logger) (crypto.PublicKey, error) {
+	if len(key) > crypto.AddressHexLength {
+		return publicKeyFromString(key)
+	}
+	address, err := c.GetKeyAddress(key, logger)
+	if err != nil {
+		return crypto.PublicKey{}, fmt.Errorf("could not get address of key %s: %v", key, err)
+	}
+	if c.keyClient == nil {
+		return crypto.PublicKey{}, fmt.Errorf("could not get public key of %s since no keys service is "+
+			"attached, pass --keys flag or give its public key", key)
+	}
+	return c.keyClient.PublicKey(address)
+}
+
 func publicKeyFromString(publicKey string) (crypto.PublicKey, error) {
 	bs, err := hex.DecodeString(publicKey)
 	if err != nil {
@@ -427,6 +665,8 @@ type CallArg struct {
 	Fee      string
 	Gas      string
 	Data     string
+	// Metadata to store alongside the code when creating a contract
+	ContractMeta string
 }
 
 func (c *Client) Call(arg *CallArg, logger *logging.Logger) (*payload.CallTx, error) {
@@ -461,11 +701,12 @@ func (c *Client) Call(arg *CallArg, logger *logging.Logger) (*payload.CallTx, er
 		return nil, err
 	}
 	tx := &payload.CallTx{
-		Input:    input,
-		Address:  contractAddress,
-		Data:     code,
-		Fee:      fee,
-		GasLimit: gas,
+		Input:        input,
+		Address:      contractAddress,
+		Data:         code,
+		Fee:          fee,
+		GasLimit:     gas,
+		ContractMeta: arg.ContractMeta,
 	}
 	return tx, nil
 }
@@ -526,13 +767,15 @@ func (c *Client) Name(arg *NameArg, logger *logging.Logger) (*payload.NameTx, er
 }
 
 type PermArg struct {
-	Input      string
-	Sequence   string
-	Action     string
-	Target     string
-	Permission string
-	Value      string
-	Role       string
+	Input        string
+	Sequence     string
+	Action       string
+	Target       string
+	Permission   string
+	Value        string
+	Role         string
+	ExpiryHeight string
+	ExpiryTime   string
 }
 
 func (c *Client) Permissions(arg *PermArg, logger *logging.Logger) (*payload.PermsTx, error) {
@@ -579,6 +822,22 @@ func (c *Client) Permissions(arg *PermArg, logger *logging.Logger) (*payload.Per
 		permArgs.Role = &arg.Role
 	}
 
+	if arg.ExpiryHeight != "" {
+		expiryHeight, err := strconv.ParseUint(arg.ExpiryHeight, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse expiry height %s: %v", arg.ExpiryHeight, err)
+		}
+		permArgs.ExpiryHeight = &expiryHeight
+	}
+	if arg.ExpiryTime != "" {
+		expiryTime, err := time.Parse(time.RFC3339, arg.ExpiryTime)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse expiry time %s, use RFC3339 format: %v", arg.ExpiryTime, err)
+		}
+		expiryUnix := expiryTime.Unix()
+		permArgs.ExpiryTime = &expiryUnix
+	}
+
 	tx := &payload.PermsTx{
 		Input:    input,
 		PermArgs: permArgs,
@@ -617,14 +876,13 @@ func (c *Client) getSequence(sequence string, inputAddress crypto.Address, mempo
 		return 0, err
 	}
 	if sequence == "" {
-		if mempoolSigning {
+		// A dry run never reaches a mempool so always takes sequence numbers from (simulated) state
+		if mempoolSigning && !c.DryRun {
 			// Perform mempool signing
 			return 0, nil
 		}
 		// Get from chain
-		ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
-		defer cancel()
-		acc, err := c.queryClient.GetAccount(ctx, &rpcquery.GetAccountParam{Address: inputAddress})
+		acc, err := c.GetAccount(inputAddress)
 		if err != nil {
 			return 0, err
 		}
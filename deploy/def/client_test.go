@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -13,5 +16,17 @@ func TestArgMap(t *testing.T) {
 	})
 	fmt.Println(mp)
 	assert.Equal(t, "fooo", mp["Address"])
-	assert.Len(t, mp, 7)
+	assert.Len(t, mp, 8)
+}
+
+func TestClient_TakeReceipt(t *testing.T) {
+	client := new(Client)
+	tx := payload.NewSendTx()
+	receipt := &txs.Receipt{TxHash: []byte{1}}
+	client.record(tx, &exec.TxExecution{Receipt: receipt})
+
+	assert.Nil(t, client.TakeReceipt(payload.NewSendTx()))
+	assert.Equal(t, receipt, client.TakeReceipt(tx))
+	assert.Nil(t, client.TakeReceipt(tx), "receipt should only be held until it is taken")
+	assert.Empty(t, client.receipts)
 }
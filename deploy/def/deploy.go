@@ -1,6 +1,9 @@
 package def
 
 import (
+	"fmt"
+	"regexp"
+
 	validation "github.com/go-ozzo/ozzo-validation"
 	"github.com/hyperledger/burrow/deploy/def/rule"
 )
@@ -27,6 +30,15 @@ type DeployArgs struct {
 	ProposeVerify bool     `mapstructure:"," json:"," yaml:"," toml:","`
 	ProposeVote   bool     `mapstructure:"," json:"," yaml:"," toml:","`
 	ProposeCreate bool     `mapstructure:"," json:"," yaml:"," toml:","`
+	// Vote against or abstain on the proposal rather than voting for it, or withdraw an earlier vote
+	ProposeAgainst  bool   `mapstructure:"," json:"," yaml:"," toml:","`
+	ProposeAbstain  bool   `mapstructure:"," json:"," yaml:"," toml:","`
+	ProposeWithdraw bool   `mapstructure:"," json:"," yaml:"," toml:","`
+	DryRun          bool   `mapstructure:"," json:"," yaml:"," toml:","`
+	Force           bool   `mapstructure:"," json:"," yaml:"," toml:","`
+	ParallelJobs    int    `mapstructure:"," json:"," yaml:"," toml:","`
+	SolcDir         string `mapstructure:"," json:"," yaml:"," toml:","`
+	CompileCache    string `mapstructure:"," json:"," yaml:"," toml:","`
 }
 
 func (args *DeployArgs) Validate() error {
@@ -40,6 +52,8 @@ func (args *DeployArgs) Validate() error {
 type Playbook struct {
 	Filename string
 	Account  string
+	// (Optional) how to compile the playbook's Solidity contracts
+	Compiler *Compiler `mapstructure:"compiler,omitempty" json:"compiler,omitempty" yaml:"compiler,omitempty" toml:"compiler"`
 	Jobs     []*Job
 	Path     string `mapstructure:"-" json:"-" yaml:"-" toml:"-"`
 	BinPath  string `mapstructure:"-" json:"-" yaml:"-" toml:"-"`
@@ -49,6 +63,35 @@ type Playbook struct {
 
 func (pkg *Playbook) Validate() error {
 	return validation.ValidateStruct(pkg,
+		validation.Field(&pkg.Compiler),
 		validation.Field(&pkg.Jobs),
 	)
 }
+
+type Compiler struct {
+	// (Optional) version of solc to use such as 0.5.4 or a range such as ^0.5.0, by default the latest installed that
+	// satisfies the version pragma of each contract
+	Version string `mapstructure:"version" json:"version" yaml:"version" toml:"version"`
+	// (Optional) whether to run the optimizer
+	Optimize bool `mapstructure:"optimize" json:"optimize" yaml:"optimize" toml:"optimize"`
+	// (Optional) how many times the optimizer should expect the code to be run, 200 by default
+	OptimizeRuns int `mapstructure:"optimize-runs" json:"optimize-runs" yaml:"optimize-runs" toml:"optimize-runs"`
+	// (Optional) import remappings of the form prefix=path
+	Remappings []string `mapstructure:"remappings" json:"remappings" yaml:"remappings" toml:"remappings"`
+}
+
+func (compiler *Compiler) Validate() error {
+	return validation.ValidateStruct(compiler,
+		validation.Field(&compiler.OptimizeRuns, validation.Min(0)),
+		validation.Field(&compiler.Remappings, validation.By(func(value interface{}) error {
+			for _, remapping := range compiler.Remappings {
+				if !remappingRegex.MatchString(remapping) {
+					return fmt.Errorf("remapping '%s' should be of the form [context:]prefix=path", remapping)
+				}
+			}
+			return nil
+		})),
+	)
+}
+
+var remappingRegex = regexp.MustCompile(`^([^:=]+:)?[^:=]+=`)
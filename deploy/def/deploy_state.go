@@ -0,0 +1,97 @@
+package def
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+)
+
+// DeployState records the jobs of a playbook that have completed against a chain so that running the playbook again
+// can skip them
+type DeployState struct {
+	ChainID string
+	// Keyed by job name, qualified by the names of any enclosing meta jobs
+	Jobs map[string]*JobState
+	// How the contracts deployed by the playbook were compiled, by address
+	Contracts map[crypto.Address]*ContractState `json:",omitempty"`
+}
+
+type JobState struct {
+	// Hash of the transactions formulated by the job (less their sequence numbers) that changes if any of its
+	// inputs do
+	InputsHash binary.HexBytes
+	// Hashes of the bytecode of the contracts deployed by a deploy job
+	CodeHashes []binary.HexBytes `json:",omitempty"`
+	// Addresses of any contracts created
+	Addresses []crypto.Address `json:",omitempty"`
+	TxHashes  []binary.HexBytes
+	Result    string          `json:",omitempty"`
+	Variables []*abi.Variable `json:",omitempty"`
+}
+
+// ContractState records how a contract was compiled from the metadata solc output so that its code can be verified
+// against its sources
+type ContractState struct {
+	// Name of the job that deployed it
+	Job string
+	// The Solidity file compiled and the contract within it
+	Source   string
+	Contract string
+	// Version of solc
+	Compiler      string
+	Optimize      bool
+	OptimizerRuns int               `json:",omitempty"`
+	EVMVersion    string            `json:",omitempty"`
+	Remappings    []string          `json:",omitempty"`
+	Libraries     map[string]string `json:",omitempty"`
+	// Keccak256 hash of each source compiled, including those it imports, by path
+	SourceHashes map[string]binary.HexBytes
+}
+
+func NewDeployState(chainID string) *DeployState {
+	return &DeployState{
+		ChainID:   chainID,
+		Jobs:      make(map[string]*JobState),
+		Contracts: make(map[crypto.Address]*ContractState),
+	}
+}
+
+// LoadDeployState reads a state file, returning nil if it does not exist
+func LoadDeployState(file string) (*DeployState, error) {
+	bs, err := ioutil.ReadFile(file)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	state := new(DeployState)
+	err = json.Unmarshal(bs, state)
+	if err != nil {
+		return nil, err
+	}
+	if state.Jobs == nil {
+		state.Jobs = make(map[string]*JobState)
+	}
+	if state.Contracts == nil {
+		state.Contracts = make(map[crypto.Address]*ContractState)
+	}
+	return state, nil
+}
+
+// Save writes the state via a temporary file so that an interrupted write does not lose what came before
+func (state *DeployState) Save(file string) error {
+	bs, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	err = ioutil.WriteFile(file+".tmp", bs, 0644)
+	if err != nil {
+		return err
+	}
+	return os.Rename(file+".tmp", file)
+}
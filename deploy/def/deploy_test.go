@@ -51,3 +51,21 @@ func TestPackage_Validate(t *testing.T) {
 	err = pkgs.Validate()
 	require.NoError(t, err)
 }
+
+func TestCompiler_Validate(t *testing.T) {
+	compiler := &Compiler{
+		Version:      "^0.5.0",
+		Optimize:     true,
+		OptimizeRuns: 1000,
+		Remappings:   []string{"openzeppelin/=node_modules/openzeppelin/", "lib:math/=vendor/math/"},
+	}
+	pkgs := &Playbook{Compiler: compiler}
+	require.NoError(t, pkgs.Validate())
+
+	compiler.OptimizeRuns = -1
+	require.Error(t, pkgs.Validate())
+
+	compiler.OptimizeRuns = 0
+	compiler.Remappings = []string{"node_modules/openzeppelin/"}
+	require.Error(t, pkgs.Validate())
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/deploy/def/rule"
 	"github.com/hyperledger/burrow/execution/evm/abi"
 )
@@ -23,18 +24,26 @@ type Job struct {
 	Result interface{} `json:"-" yaml:"-" toml:"-"`
 	// For multiple values
 	Variables []*abi.Variable `json:"-" yaml:"-" toml:"-"`
+	// Hashes of any transactions sent
+	TxHashes []binary.HexBytes `json:"-" yaml:"-" toml:"-"`
 	// Create proposal or vote for one
 	Proposal *Proposal `mapstructure:"proposal,omitempty" json:"proposal,omitempty" yaml:"proposal,omitempty" toml:"proposal"`
 	// Sets/Resets the primary account to use
 	Account *Account `mapstructure:"account,omitempty" json:"account,omitempty" yaml:"account,omitempty" toml:"account"`
 	// Set an arbitrary value
 	Set *Set `mapstructure:"set,omitempty" json:"set,omitempty" yaml:"set,omitempty" toml:"set"`
+	// Run jobs if a condition holds
+	If *If `mapstructure:"if,omitempty" json:"if,omitempty" yaml:"if,omitempty" toml:"if"`
+	// Run jobs once for each of a list of items
+	Foreach *Foreach `mapstructure:"foreach,omitempty" json:"foreach,omitempty" yaml:"foreach,omitempty" toml:"foreach"`
 	// Run a sequence of other deploy.yamls
 	Meta *Meta `mapstructure:"meta,omitempty" json:"meta,omitempty" yaml:"meta,omitempty" toml:"meta"`
 	// Issue a governance transaction
 	UpdateAccount *UpdateAccount `mapstructure:"update-account,omitempty" json:"update-account,omitempty" yaml:"update-account,omitempty" toml:"update-account"`
 	// Contract compile and send to the chain functions
 	Deploy *Deploy `mapstructure:"deploy,omitempty" json:"deploy,omitempty" yaml:"deploy,omitempty" toml:"deploy"`
+	// Deploy a new implementation behind a proxy created by a deploy job
+	Upgrade *Upgrade `mapstructure:"upgrade,omitempty" json:"upgrade,omitempty" yaml:"upgrade,omitempty" toml:"upgrade"`
 	// Contract compile/build
 	Build *Build `mapstructure:"build,omitempty" json:"build,omitempty" yaml:"build,omitempty" toml:"build"`
 	// Send tokens from one account to another
@@ -60,6 +69,12 @@ type Job struct {
 	QueryVals *QueryVals `mapstructure:"query-vals,omitempty" json:"query-vals,omitempty" yaml:"query-vals,omitempty" toml:"query-vals"`
 	// Makes and assertion (useful for testing purposes)
 	Assert *Assert `mapstructure:"assert,omitempty" json:"assert,omitempty" yaml:"assert,omitempty" toml:"assert"`
+	// Sends a call that is expected to revert
+	AssertRevert *AssertRevert `mapstructure:"assert-revert,omitempty" json:"assert-revert,omitempty" yaml:"assert-revert,omitempty" toml:"assert-revert"`
+	// Asserts that the transactions of an earlier job emitted particular events
+	AssertEvents *AssertEvents `mapstructure:"assert-events,omitempty" json:"assert-events,omitempty" yaml:"assert-events,omitempty" toml:"assert-events"`
+	// Asserts the change in an account's balance
+	AssertBalance *AssertBalance `mapstructure:"assert-balance,omitempty" json:"assert-balance,omitempty" yaml:"assert-balance,omitempty" toml:"assert-balance"`
 }
 
 type Payload interface {
@@ -76,6 +91,7 @@ func (job *Job) Validate() error {
 			Error("must contain word characters; alphanumeric plus underscores/hyphens")),
 		validation.Field(&job.Result, rule.New(rule.IsOmitted, "internally reserved and should be removed")),
 		validation.Field(&job.Variables, rule.New(rule.IsOmitted, "internally reserved and should be removed")),
+		validation.Field(&job.TxHashes, rule.New(rule.IsOmitted, "internally reserved and should be removed")),
 		validation.Field(payloadField.Addr().Interface()),
 	)
 }
@@ -112,3 +128,56 @@ func (job *Job) PayloadField() (reflect.Value, error) {
 
 	return rv.Field(payloadIndex), nil
 }
+
+// Copy returns a deep copy of the job so that it can be run more than once, as the jobs of a foreach job are. Fields
+// that are not marshalled (such as results and intermediate compiler work) are copied shallowly.
+func (job *Job) Copy() *Job {
+	return deepCopy(reflect.ValueOf(job)).Interface().(*Job)
+}
+
+func deepCopy(rv reflect.Value) reflect.Value {
+	switch rv.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if rv.IsNil() {
+			return rv
+		}
+		cp := reflect.New(rv.Type()).Elem()
+		if rv.Kind() == reflect.Ptr {
+			cp.Set(reflect.New(rv.Type().Elem()))
+			cp.Elem().Set(deepCopy(rv.Elem()))
+		} else {
+			cp.Set(deepCopy(rv.Elem()))
+		}
+		return cp
+	case reflect.Slice:
+		if rv.IsNil() {
+			return rv
+		}
+		cp := reflect.MakeSlice(rv.Type(), rv.Len(), rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			cp.Index(i).Set(deepCopy(rv.Index(i)))
+		}
+		return cp
+	case reflect.Map:
+		if rv.IsNil() {
+			return rv
+		}
+		cp := reflect.MakeMapWithSize(rv.Type(), rv.Len())
+		for _, key := range rv.MapKeys() {
+			cp.SetMapIndex(key, deepCopy(rv.MapIndex(key)))
+		}
+		return cp
+	case reflect.Struct:
+		cp := reflect.New(rv.Type()).Elem()
+		cp.Set(rv)
+		for i := 0; i < rv.NumField(); i++ {
+			field := rv.Type().Field(i)
+			if field.PkgPath == "" && field.Tag.Get("json") != "-" {
+				cp.Field(i).Set(deepCopy(rv.Field(i)))
+			}
+		}
+		return cp
+	default:
+		return rv
+	}
+}
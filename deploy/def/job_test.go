@@ -41,3 +41,24 @@ func TestJob_Validate(t *testing.T) {
 	err = job.Validate()
 	require.NoError(t, err)
 }
+
+func TestJob_Copy(t *testing.T) {
+	intermediate := new(struct{})
+	job := &Job{
+		Name:         "call",
+		Intermediate: intermediate,
+		Call: &Call{
+			Destination: "$deploy",
+			Function:    "set",
+			Data:        []interface{}{"$item", map[string]interface{}{"a": "$item"}},
+		},
+	}
+	cp := job.Copy()
+	assert.Equal(t, job, cp)
+	cp.Call.Destination = "1234"
+	cp.Call.Data.([]interface{})[1].(map[string]interface{})["a"] = "5"
+	assert.Equal(t, "$deploy", job.Call.Destination)
+	assert.Equal(t, "$item", job.Call.Data.([]interface{})[1].(map[string]interface{})["a"])
+	// Fields that are not marshalled are shared
+	assert.True(t, intermediate == cp.Intermediate)
+}
@@ -1,6 +1,7 @@
 package def
 
 import (
+	"fmt"
 	"regexp"
 
 	validation "github.com/go-ozzo/ozzo-validation"
@@ -31,12 +32,18 @@ type Proposal struct {
 	Description string `mapstructure:"description" json:"description" yaml:"description" toml:"description"`
 	// (Required) the file path of the sub yaml to run
 	Jobs []*Job `mapstructure:"jobs" json:"jobs" yaml:"jobs" toml:"jobs"`
+	// (Optional) the last block height at which the proposal can be voted on and executed
+	ExpiryHeight string `mapstructure:"expiryheight" json:"expiryheight" yaml:"expiryheight" toml:"expiryheight"`
+	// (Optional) the number of accounts that must vote for, against or abstain before the proposal can execute
+	Quorum string `mapstructure:"quorum" json:"quorum" yaml:"quorum" toml:"quorum"`
 }
 
 func (job *Proposal) Validate() error {
 	return validation.ValidateStruct(job,
 		validation.Field(&job.Sequence, rule.Uint64OrPlaceholder),
 		validation.Field(&job.VotingPower, rule.Uint64OrPlaceholder),
+		validation.Field(&job.ExpiryHeight, rule.Uint64OrPlaceholder),
+		validation.Field(&job.Quorum, rule.Uint64OrPlaceholder),
 		validation.Field(&job.Name, validation.Required),
 		validation.Field(&job.Description, validation.Required),
 		validation.Field(&job.Jobs, validation.Required),
@@ -74,6 +81,94 @@ func (job *Meta) Validate() error {
 	)
 }
 
+// ------------------------------------------------------------------------
+// Control Jobs
+// ------------------------------------------------------------------------
+
+// If runs its jobs when a condition over the results of earlier jobs holds and its else jobs (if any) otherwise
+type If struct {
+	// (Required) left hand side of the condition. Generally it will be a variable expansion of an earlier job's
+	// result
+	Key string `mapstructure:"key" json:"key" yaml:"key" toml:"key"`
+	// (Required) must be one of the relations available to assert jobs
+	Relation string `mapstructure:"relation" json:"relation" yaml:"relation" toml:"relation"`
+	// (Required) right hand side of the condition
+	Value string `mapstructure:"val" json:"val" yaml:"val" toml:"val"`
+	// (Required) the jobs to run when the condition holds
+	Jobs []*Job `mapstructure:"jobs" json:"jobs" yaml:"jobs" toml:"jobs"`
+	// (Optional) the jobs to run when the condition does not hold
+	Else []*Job `mapstructure:"else" json:"else,omitempty" yaml:"else,omitempty" toml:"else"`
+}
+
+func (job *If) Validate() error {
+	return validation.ValidateStruct(job,
+		validation.Field(&job.Relation, validation.Required, rule.Relation),
+		validation.Field(&job.Jobs, validation.Required, validation.By(nestedJobs)),
+		validation.Field(&job.Else, validation.By(nestedJobs)),
+	)
+}
+
+// DefaultForeachVariable is the name by which the jobs of a foreach job refer to the current item if not otherwise
+// given
+const DefaultForeachVariable = "item"
+
+// Foreach runs its jobs once for each of a list of items
+type Foreach struct {
+	// (Required) the items to iterate over, either as a list or as a string listing them between brackets separated
+	// by commas (which is how the result of a query returning an array is rendered)
+	Items interface{} `mapstructure:"items" json:"items" yaml:"items" toml:"items"`
+	// (Optional) the variable name by which the jobs refer to the current item, "item" by default. Where the items
+	// are mappings their values are available as $item.key
+	As string `mapstructure:"as" json:"as,omitempty" yaml:"as,omitempty" toml:"as"`
+	// (Required) the jobs to run for each item
+	Jobs []*Job `mapstructure:"jobs" json:"jobs" yaml:"jobs" toml:"jobs"`
+}
+
+func (job *Foreach) Validate() error {
+	return validation.ValidateStruct(job,
+		validation.Field(&job.Items, validation.Required),
+		validation.Field(&job.As, validation.Match(regexp.MustCompile(`^[[:word:]]+$`)),
+			validation.By(job.variableNotShadowed)),
+		validation.Field(&job.Jobs, validation.Required, validation.By(nestedJobs)),
+	)
+}
+
+func (job *Foreach) Variable() string {
+	if job.As == "" {
+		return DefaultForeachVariable
+	}
+	return job.As
+}
+
+func (job *Foreach) variableNotShadowed(value interface{}) error {
+	for _, j := range job.Jobs {
+		if j.Name == job.Variable() {
+			return fmt.Errorf("job %s has the same name as the foreach variable", j.Name)
+		}
+	}
+	return nil
+}
+
+// Jobs nested in if and foreach jobs must have distinct names and cannot be meta jobs, the playbooks of which are
+// only loaded for top level jobs
+func nestedJobs(value interface{}) error {
+	jobs, _ := value.([]*Job)
+	names := make(map[string]bool)
+	for _, job := range jobs {
+		if job == nil {
+			continue
+		}
+		if names[job.Name] {
+			return fmt.Errorf("job name %s is used more than once", job.Name)
+		}
+		names[job.Name] = true
+		if job.Meta != nil {
+			return fmt.Errorf("meta job %s cannot be nested in an if or foreach job", job.Name)
+		}
+	}
+	return nil
+}
+
 // ------------------------------------------------------------------------
 // Governance Jobs
 // ------------------------------------------------------------------------
@@ -102,18 +197,33 @@ type UpdateAccount struct {
 	Permissions []PermissionString `mapstructure:"permissions" json:"permissions" yaml:"permissions" toml:"permissions"`
 	// (Optional) the account permission roles to set for this account
 	Roles []string `mapstructure:"roles" json:"roles" yaml:"roles" toml:"roles"`
+	// (Optional) make the account a multisig account signed for by these keys, given as key names, addresses or
+	// public keys. If target is omitted a new multisig account is created at an address derived from the keys
+	Signers []string `mapstructure:"signers" json:"signers" yaml:"signers,omitempty" toml:"signers"`
+	// (Optional, required with signers) the number of signers that must sign transactions from the multisig account
+	Threshold string `mapstructure:"threshold" json:"threshold" yaml:"threshold" toml:"threshold"`
+	// (Optional) rotate the target account's key to this key, given as a key name, address or public key, keeping the
+	// account's address. An account may rotate its own key, otherwise the source needs Root permission
+	RotateKey string `mapstructure:"rotate-key" json:"rotate-key" yaml:"rotate-key" toml:"rotate-key"`
 	// (Optional, advanced only) sequence to use when burrow keys signs the transaction (do not use unless you
 	// know what you're doing)
 	Sequence string `mapstructure:"sequence" json:"sequence" yaml:"sequence" toml:"sequence"`
 }
 
 func (job *UpdateAccount) Validate() error {
+	targetRules := []validation.Rule{rule.Or(rule.Placeholder, is.Hexadecimal, validation.Match(NewKeyRegex))}
+	thresholdRules := []validation.Rule{rule.Uint64OrPlaceholder}
+	if len(job.Signers) == 0 {
+		targetRules = append(targetRules, validation.Required)
+	} else {
+		thresholdRules = append(thresholdRules, validation.Required)
+	}
 	return validation.ValidateStruct(job,
-		validation.Field(&job.Target, validation.Required, rule.Or(rule.Placeholder, is.Hexadecimal,
-			validation.Match(NewKeyRegex))),
+		validation.Field(&job.Target, targetRules...),
 		validation.Field(&job.Permissions),
 		validation.Field(&job.Power, rule.Uint64OrPlaceholder),
 		validation.Field(&job.Native, rule.Uint64OrPlaceholder),
+		validation.Field(&job.Threshold, thresholdRules...),
 		validation.Field(&job.Sequence, rule.Uint64OrPlaceholder),
 	)
 }
@@ -206,7 +316,7 @@ type Permission struct {
 	// (Optional, if account job or global account set) address of the account from which to send (the
 	// public key for the account must be available to burrow keys)
 	Source string `mapstructure:"source" json:"source" yaml:"source" toml:"source"`
-	// (Required) actions must be in the set ["set_base", "unset_base", "set_global", "add_role" "rm_role"]
+	// (Required) actions must be in the set ["set_base", "unset_base", "set_global", "add_role" "rm_role", "delegate"]
 	Action string `mapstructure:"action" json:"action" yaml:"action" toml:"action"`
 	// (Required, unless add_role or rm_role action selected) the name of the permission flag which is to
 	// be updated
@@ -217,6 +327,12 @@ type Permission struct {
 	Target string `mapstructure:"target" json:"target" yaml:"target" toml:"target"`
 	// (Required, if add_role or rm_role action selected) the role which should be given to the account
 	Role string `mapstructure:"role" json:"role" yaml:"role" toml:"role"`
+	// (Optional, if set_base action selected with a true value, required for delegate unless expiry_time is given)
+	// the block height from which the permission no longer applies
+	ExpiryHeight string `mapstructure:"expiry_height" json:"expiry_height" yaml:"expiry_height" toml:"expiry_height"`
+	// (Optional, if set_base action selected with a true value, required for delegate unless expiry_height is given)
+	// the time, in RFC3339 format, from which the permission no longer applies
+	ExpiryTime string `mapstructure:"expiry_time" json:"expiry_time" yaml:"expiry_time" toml:"expiry_time"`
 	// (Optional, advanced only) sequence to use when burrow keys signs the transaction (do not use unless you
 	// know what you're doing)
 	Sequence string `mapstructure:"sequence" json:"sequence" yaml:"sequence" toml:"sequence"`
@@ -225,6 +341,8 @@ type Permission struct {
 func (job *Permission) Validate() error {
 	return validation.ValidateStruct(job,
 		validation.Field(&job.Value, validation.In("true", "false", "")),
+		validation.Field(&job.ExpiryHeight, rule.Uint64OrPlaceholder),
+		validation.Field(&job.ExpiryTime, rule.TimeOrPlaceholder),
 		validation.Field(&job.Sequence, rule.Uint64OrPlaceholder),
 	)
 }
@@ -300,15 +418,65 @@ type Deploy struct {
 	Variables []*abi.Variable
 	// (Optional) Path to store an extra copy of the bin file
 	Store string `mapstructure:"store" json:"store" yaml:"store" toml:"store"`
+	// (Optional) store the contract's ABI on chain alongside its code so that it can be retrieved by clients (such as
+	// vent) that have not been given the ABI
+	StoreAbi bool `mapstructure:"store-abi" json:"store-abi" yaml:"store-abi" toml:"store-abi"`
+	// (Optional) deploy the contract as the implementation behind a proxy that the source account can later upgrade
+	// with an upgrade job. The result of the job is the address of the proxy and the address of the implementation
+	// is available as $job.implementation. The contract's constructor does not run against the proxy's storage so
+	// constructor arguments cannot be given; call an initialising function through the proxy instead
+	Proxy bool `mapstructure:"proxy" json:"proxy,omitempty" yaml:"proxy,omitempty" toml:"proxy"`
 }
 
 func (job *Deploy) Validate() error {
-	return validation.ValidateStruct(job,
+	fields := []*validation.FieldRules{
 		validation.Field(&job.Contract, validation.Required),
 		validation.Field(&job.Amount, rule.Uint64OrPlaceholder),
 		validation.Field(&job.Fee, rule.Uint64OrPlaceholder),
 		validation.Field(&job.Gas, rule.Uint64OrPlaceholder),
 		validation.Field(&job.Sequence, rule.Uint64OrPlaceholder),
+	}
+	if job.Proxy {
+		fields = append(fields,
+			validation.Field(&job.Instance, validation.NotIn("all").Error("a single contract must be deployed behind a proxy")),
+			validation.Field(&job.Data, rule.New(rule.IsOmitted,
+				"constructor arguments cannot be given when deploying behind a proxy")))
+	}
+	return validation.ValidateStruct(job, fields...)
+}
+
+type Upgrade struct {
+	// (Optional, if account job or global account set) address of the account from which to send, which must be
+	// the account that deployed the proxy
+	Source string `mapstructure:"source" json:"source" yaml:"source" toml:"source"`
+	// (Required) address of the proxy to upgrade, usually the result of the deploy job that created it
+	Proxy string `mapstructure:"proxy" json:"proxy" yaml:"proxy" toml:"proxy"`
+	// (Required) the filepath to the contract file of the new implementation, as for a deploy job
+	Contract string `mapstructure:"contract" json:"contract" yaml:"contract" toml:"contract"`
+	// (Optional) the name of the contract to deploy from the contract file, as for a deploy job
+	Instance string `mapstructure:"instance" json:"instance" yaml:"instance" toml:"instance"`
+	// (Optional) the file path for the linkReferences for contract
+	Libraries string `mapstructure:"libraries" json:"libraries" yaml:"libraries" toml:"libraries"`
+	// (Optional) validators' fee
+	Fee string `mapstructure:"fee" json:"fee" yaml:"fee" toml:"fee"`
+	// (Optional) amount of gas which should be sent along with each transaction
+	Gas string `mapstructure:"gas" json:"gas" yaml:"gas" toml:"gas"`
+	// (Optional, advanced only) sequence to use when burrow keys signs the transaction (do not use unless you
+	// know what you're doing)
+	Sequence string `mapstructure:"sequence" json:"sequence" yaml:"sequence" toml:"sequence"`
+	// (Optional) upgrade even if the storage layout of the new implementation is not compatible with that of the
+	// current one, or either is unknown because the contract was not compiled by solc 0.5.13 or later
+	SkipStorageCheck bool `mapstructure:"skip-storage-check" json:"skip-storage-check,omitempty" yaml:"skip-storage-check,omitempty" toml:"skip-storage-check"`
+}
+
+func (job *Upgrade) Validate() error {
+	return validation.ValidateStruct(job,
+		validation.Field(&job.Proxy, validation.Required, rule.AddressOrPlaceholder),
+		validation.Field(&job.Contract, validation.Required),
+		validation.Field(&job.Instance, validation.NotIn("all").Error("a single contract must be deployed")),
+		validation.Field(&job.Fee, rule.Uint64OrPlaceholder),
+		validation.Field(&job.Gas, rule.Uint64OrPlaceholder),
+		validation.Field(&job.Sequence, rule.Uint64OrPlaceholder),
 	)
 }
 
@@ -478,3 +646,83 @@ func (job *Assert) Validate() error {
 		validation.Field(&job.Relation, validation.Required, rule.Relation),
 	)
 }
+
+// Sends a call transaction that is expected to revert. The job fails if the call succeeds or reverts for a different
+// reason. Its result is the revert reason.
+type AssertRevert struct {
+	// (Optional, if account job or global account set) address of the account from which to send (the
+	// public key for the account must be available to burrow keys)
+	Source string `mapstructure:"source" json:"source" yaml:"source" toml:"source"`
+	// (Required) address of the contract which should be called
+	Destination string `mapstructure:"destination" json:"destination" yaml:"destination" toml:"destination"`
+	// (Required) function to call
+	Function string `mapstructure:"function" json:"function" yaml:"function" toml:"function"`
+	// (Optional) data to be used in the function arguments
+	Data interface{} `mapstructure:"data" json:"data" yaml:"data" toml:"data"`
+	// (Optional) amount of tokens to send to the contract
+	Amount string `mapstructure:"amount" json:"amount" yaml:"amount" toml:"amount"`
+	// (Optional) validators may use this to prioritise transactions
+	Fee string `mapstructure:"fee" json:"fee" yaml:"fee" toml:"fee"`
+	// (Optional) amount of gas which should be sent along with the call transaction
+	Gas string `mapstructure:"gas" json:"gas" yaml:"gas" toml:"gas"`
+	// (Optional) location of the bin file to use (can be relative path or in bin path)
+	Bin string `mapstructure:"bin" json:"bin" yaml:"bin" toml:"bin"`
+	// (Optional) the reason the call should revert with, if omitted any revert will do
+	Reason string `mapstructure:"reason" json:"reason" yaml:"reason" toml:"reason"`
+}
+
+func (job *AssertRevert) Validate() error {
+	return validation.ValidateStruct(job,
+		validation.Field(&job.Destination, validation.Required),
+		validation.Field(&job.Amount, rule.Uint64OrPlaceholder),
+		validation.Field(&job.Fee, rule.Uint64OrPlaceholder),
+		validation.Field(&job.Gas, rule.Uint64OrPlaceholder),
+	)
+}
+
+// Asserts that the transactions sent by an earlier job emitted particular Solidity events
+type AssertEvents struct {
+	// (Required) name of the job whose transactions should have emitted the events
+	Job string `mapstructure:"job" json:"job" yaml:"job" toml:"job"`
+	// (Required) the events expected, in the order they should have been emitted (other events may come between
+	// them)
+	Events []*ExpectedEvent `mapstructure:"events" json:"events" yaml:"events" toml:"events"`
+}
+
+type ExpectedEvent struct {
+	// (Required) name of the event
+	Name string `mapstructure:"name" json:"name" yaml:"name" toml:"name"`
+	// (Optional) values of the event's arguments by argument name, arguments not listed may take any value
+	Args map[string]interface{} `mapstructure:"args" json:"args" yaml:"args" toml:"args"`
+}
+
+func (job *AssertEvents) Validate() error {
+	return validation.ValidateStruct(job,
+		validation.Field(&job.Job, validation.Required),
+		validation.Field(&job.Events, validation.Required),
+	)
+}
+
+func (event *ExpectedEvent) Validate() error {
+	return validation.ValidateStruct(event,
+		validation.Field(&event.Name, validation.Required),
+	)
+}
+
+// Asserts that the balance of an account has changed by an amount since an earlier query-account job
+type AssertBalance struct {
+	// (Required) address of the account
+	Account string `mapstructure:"account" json:"account" yaml:"account" toml:"account"`
+	// (Required) the balance before, usually the result of an earlier query-account job for the balance field
+	Before string `mapstructure:"before" json:"before" yaml:"before" toml:"before"`
+	// (Required) the expected change in balance, negative for a decrease
+	Delta string `mapstructure:"delta" json:"delta" yaml:"delta" toml:"delta"`
+}
+
+func (job *AssertBalance) Validate() error {
+	return validation.ValidateStruct(job,
+		validation.Field(&job.Account, validation.Required),
+		validation.Field(&job.Before, validation.Required, rule.Uint64OrPlaceholder),
+		validation.Field(&job.Delta, validation.Required),
+	)
+}
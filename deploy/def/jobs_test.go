@@ -24,6 +24,21 @@ func TestGovernAccount_Validate(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestGovernAccount_ValidateMultisig(t *testing.T) {
+	job := &UpdateAccount{
+		Signers:   []string{"alice", "bob", "carol"},
+		Threshold: "2",
+		Native:    "1000",
+	}
+	require.NoError(t, job.Validate())
+
+	job.Threshold = ""
+	assert.Error(t, job.Validate())
+
+	job = &UpdateAccount{Native: "1000"}
+	assert.Error(t, job.Validate())
+}
+
 func TestKeyNameCurveType(t *testing.T) {
 	match := NewKeyRegex.FindStringSubmatch("new()")
 	keyName, curveType := KeyNameCurveType(match)
@@ -47,3 +62,95 @@ func TestKeyNameCurveType(t *testing.T) {
 
 	assert.False(t, NewKeyRegex.MatchString("new"))
 }
+
+func TestIf_Validate(t *testing.T) {
+	set := &Job{Name: "set", Set: &Set{Value: "1"}}
+	job := &If{Key: "$query", Relation: "gt", Value: "2", Jobs: []*Job{set}}
+	require.NoError(t, job.Validate())
+
+	job.Relation = "bigger"
+	assert.Error(t, job.Validate())
+
+	job.Relation = "eq"
+	job.Jobs = nil
+	assert.Error(t, job.Validate())
+
+	job.Jobs = []*Job{set}
+	job.Else = []*Job{set, set}
+	assert.Error(t, job.Validate(), "job names should be distinct")
+
+	job.Else = []*Job{{Name: "meta", Meta: &Meta{File: "other.yaml"}}}
+	assert.Error(t, job.Validate(), "meta jobs should not be nested")
+
+	job.Else = []*Job{{Name: "noPayload"}}
+	assert.Error(t, job.Validate(), "nested jobs should be validated")
+}
+
+func TestForeach_Validate(t *testing.T) {
+	call := &Job{Name: "register", Call: &Call{Destination: "$deploy", Function: "register",
+		Data: []interface{}{"$member"}}}
+	job := &Foreach{Items: []interface{}{"alice", "bob"}, As: "member", Jobs: []*Job{call}}
+	require.NoError(t, job.Validate())
+	assert.Equal(t, "member", job.Variable())
+
+	job.Items = "$queryMembers"
+	require.NoError(t, job.Validate())
+
+	job.Items = nil
+	assert.Error(t, job.Validate())
+
+	job.Items = "[alice]"
+	job.As = "a member"
+	assert.Error(t, job.Validate())
+
+	job.As = "register"
+	assert.Error(t, job.Validate(), "variable should not be shadowed by a job")
+
+	job.As = ""
+	assert.Equal(t, DefaultForeachVariable, job.Variable())
+	require.NoError(t, job.Validate())
+}
+
+func TestDeploy_ValidateProxy(t *testing.T) {
+	job := &Deploy{Contract: "registry.sol", Proxy: true}
+	require.NoError(t, job.Validate())
+
+	job.Instance = "all"
+	assert.Error(t, job.Validate())
+
+	job.Instance = ""
+	job.Data = []interface{}{"1"}
+	assert.Error(t, job.Validate(), "constructor arguments do not run against the proxy")
+
+	job.Proxy = false
+	require.NoError(t, job.Validate())
+}
+
+func TestUpgrade_Validate(t *testing.T) {
+	job := &Upgrade{Proxy: "$deployRegistry", Contract: "registry.sol"}
+	require.NoError(t, job.Validate())
+
+	job.Proxy = "registry"
+	assert.Error(t, job.Validate())
+
+	job.Proxy = "$deployRegistry"
+	job.Contract = ""
+	assert.Error(t, job.Validate())
+}
+
+func TestPermission_ValidateExpiry(t *testing.T) {
+	job := &Permission{Action: "set_base", Permission: "createContract", Value: "true"}
+	require.NoError(t, job.Validate())
+
+	job.ExpiryTime = "2019-09-30T17:00:00Z"
+	require.NoError(t, job.Validate())
+
+	job.ExpiryTime = "$deadline"
+	require.NoError(t, job.Validate())
+
+	job.ExpiryTime = "30/09/2019 17:00"
+	assert.Error(t, job.Validate())
+
+	job.ExpiryTime = "1569862800"
+	assert.Error(t, job.Validate(), "expiry times are given in RFC3339 format rather than as Unix times")
+}
@@ -0,0 +1,310 @@
+package def
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/names"
+	"github.com/hyperledger/burrow/permission"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+// Plan summarises the changes a playbook would make to a chain as found by a dry run
+type Plan struct {
+	ChainID      string
+	Height       uint64
+	Transactions []*exec.TxExecution
+	Accounts     []*AccountChange
+	Names        []*NameChange
+	Proposals    []*ProposalChange
+	Validators   []*ValidatorChange
+	GasUsed      uint64
+}
+
+type AccountChange struct {
+	Address crypto.Address
+	// Nil if the account would be created
+	Before *acm.Account
+	// Nil if the account would be removed
+	After *acm.Account
+	// Number of storage slots whose value would change
+	StorageChanged int
+}
+
+type NameChange struct {
+	Name string
+	// Nil if the name would be removed
+	Entry *names.Entry
+}
+
+type ProposalChange struct {
+	Hash   binary.HexBytes
+	Ballot *payload.Ballot
+}
+
+type ValidatorChange struct {
+	Address crypto.Address
+	Before  *big.Int
+	After   *big.Int
+}
+
+// Plan compares the forked state with the chain's to find what the transactions executed so far would change
+func (sim *Simulator) Plan() (*Plan, error) {
+	sim.Lock()
+	defer sim.Unlock()
+	plan := &Plan{
+		ChainID:      sim.chainID,
+		Height:       sim.blockchain.LastBlockHeight(),
+		Transactions: sim.txes,
+	}
+	for _, txe := range sim.txes {
+		plan.GasUsed += txe.GetResult().GetGasUsed()
+	}
+
+	rec := new(planRecorder)
+	err := sim.accounts.Sync(rec)
+	if err != nil {
+		return nil, err
+	}
+	for _, address := range rec.addresses {
+		before, err := sim.remote.GetAccount(address)
+		if err != nil {
+			return nil, err
+		}
+		change := &AccountChange{
+			Address: address,
+			Before:  before,
+			After:   rec.accounts[address],
+		}
+		for key, value := range rec.storage[address] {
+			var beforeValue binary.Word256
+			if before != nil {
+				beforeValue, err = sim.remote.GetStorage(address, key)
+				if err != nil {
+					return nil, err
+				}
+			}
+			if value != beforeValue {
+				change.StorageChanged++
+			}
+		}
+		if change.changed() {
+			plan.Accounts = append(plan.Accounts, change)
+		}
+	}
+
+	err = sim.nameReg.Sync(rec)
+	if err != nil {
+		return nil, err
+	}
+	plan.Names = rec.names
+
+	err = sim.proposals.Sync(rec)
+	if err != nil {
+		return nil, err
+	}
+	plan.Proposals = rec.proposals
+
+	err = sim.validators.Delta.IterateValidators(func(id crypto.Addressable, power *big.Int) error {
+		plan.Validators = append(plan.Validators, &ValidatorChange{
+			Address: id.GetAddress(),
+			Before:  sim.validators.Previous.GetPower(id.GetAddress()),
+			After:   power,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+func (plan *Plan) String() string {
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, "Dry run of %d transaction(s) against chain %s at height %d\n", len(plan.Transactions),
+		plan.ChainID, plan.Height)
+
+	fmt.Fprintf(buf, "\nTransactions:\n")
+	for _, txe := range plan.Transactions {
+		fmt.Fprintf(buf, "  %v %v gas used: %d", txe.TxType, txe.TxHash, txe.GetResult().GetGasUsed())
+		if txe.Exception != nil {
+			fmt.Fprintf(buf, " exception: %v", txe.Exception)
+		}
+		fmt.Fprintln(buf)
+	}
+	fmt.Fprintf(buf, "  total gas used: %d\n", plan.GasUsed)
+
+	if len(plan.Accounts) > 0 {
+		fmt.Fprintf(buf, "\nAccounts:\n")
+		for _, change := range plan.Accounts {
+			change.write(buf)
+		}
+	}
+
+	if len(plan.Names) > 0 {
+		fmt.Fprintf(buf, "\nNames:\n")
+		for _, change := range plan.Names {
+			if change.Entry == nil {
+				fmt.Fprintf(buf, "  %s removed\n", change.Name)
+			} else {
+				fmt.Fprintf(buf, "  %s = %q (owner %v, expires at height %d)\n", change.Name, change.Entry.Data,
+					change.Entry.Owner, change.Entry.Expires)
+			}
+		}
+	}
+
+	if len(plan.Proposals) > 0 {
+		fmt.Fprintf(buf, "\nProposals:\n")
+		for _, change := range plan.Proposals {
+			if change.Ballot == nil {
+				fmt.Fprintf(buf, "  %v removed\n", change.Hash)
+			} else {
+				fmt.Fprintf(buf, "  %v %s: %v with %d vote(s)\n", change.Hash, change.Ballot.Proposal.Name,
+					change.Ballot.ProposalState, len(change.Ballot.Votes))
+			}
+		}
+	}
+
+	if len(plan.Validators) > 0 {
+		fmt.Fprintf(buf, "\nValidators:\n")
+		for _, change := range plan.Validators {
+			fmt.Fprintf(buf, "  %v power: %v -> %v\n", change.Address, change.Before, change.After)
+		}
+	}
+	return buf.String()
+}
+
+func (change *AccountChange) changed() bool {
+	before, after := change.Before, change.After
+	if before == nil || after == nil {
+		return before != after
+	}
+	return change.StorageChanged > 0 ||
+		before.Balance != after.Balance ||
+		before.Sequence != after.Sequence ||
+		!bytes.Equal(before.Code, after.Code) ||
+		before.ContractMeta != after.ContractMeta ||
+		!baseEqual(before.Permissions.Base, after.Permissions.Base) ||
+		!stringsEqual(before.Permissions.Roles, after.Permissions.Roles)
+}
+
+func (change *AccountChange) write(buf *bytes.Buffer) {
+	before, after := change.Before, change.After
+	switch {
+	case after == nil:
+		fmt.Fprintf(buf, "  %v removed\n", change.Address)
+		return
+	case before == nil:
+		fmt.Fprintf(buf, "  %v created\n", change.Address)
+		before = new(acm.Account)
+	default:
+		fmt.Fprintf(buf, "  %v\n", change.Address)
+	}
+	if len(before.Code) == 0 && len(after.Code) > 0 {
+		fmt.Fprintf(buf, "    contract code: %d bytes\n", len(after.Code))
+	}
+	if before.ContractMeta != after.ContractMeta {
+		fmt.Fprintf(buf, "    contract metadata: %d bytes\n", len(after.ContractMeta))
+	}
+	if before.Balance != after.Balance {
+		fmt.Fprintf(buf, "    balance: %d -> %d\n", before.Balance, after.Balance)
+	}
+	if before.Sequence != after.Sequence {
+		fmt.Fprintf(buf, "    sequence: %d -> %d\n", before.Sequence, after.Sequence)
+	}
+	if !baseEqual(before.Permissions.Base, after.Permissions.Base) {
+		fmt.Fprintf(buf, "    permissions: [%s] -> [%s]\n",
+			permission.BasePermissionsString(before.Permissions.Base),
+			permission.BasePermissionsString(after.Permissions.Base))
+	}
+	if !stringsEqual(before.Permissions.Roles, after.Permissions.Roles) {
+		fmt.Fprintf(buf, "    roles: [%s] -> [%s]\n", strings.Join(before.Permissions.Roles, ", "),
+			strings.Join(after.Permissions.Roles, ", "))
+	}
+	if change.StorageChanged > 0 {
+		fmt.Fprintf(buf, "    storage: %d slot(s) changed\n", change.StorageChanged)
+	}
+}
+
+func baseEqual(a, b permission.BasePermissions) bool {
+	return a.Perms == b.Perms && a.SetBit == b.SetBit
+}
+
+func stringsEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Collects the changes synced from the simulator's caches
+type planRecorder struct {
+	addresses []crypto.Address
+	accounts  map[crypto.Address]*acm.Account
+	storage   map[crypto.Address]map[binary.Word256]binary.Word256
+	names     []*NameChange
+	proposals []*ProposalChange
+}
+
+func (rec *planRecorder) UpdateAccount(account *acm.Account) error {
+	rec.touch(account.Address)
+	rec.accounts[account.Address] = account
+	return nil
+}
+
+func (rec *planRecorder) RemoveAccount(address crypto.Address) error {
+	rec.touch(address)
+	rec.accounts[address] = nil
+	return nil
+}
+
+func (rec *planRecorder) SetStorage(address crypto.Address, key, value binary.Word256) error {
+	rec.touch(address)
+	if rec.storage[address] == nil {
+		rec.storage[address] = make(map[binary.Word256]binary.Word256)
+	}
+	rec.storage[address][key] = value
+	return nil
+}
+
+func (rec *planRecorder) UpdateName(entry *names.Entry) error {
+	rec.names = append(rec.names, &NameChange{Name: entry.Name, Entry: entry})
+	return nil
+}
+
+func (rec *planRecorder) RemoveName(name string) error {
+	rec.names = append(rec.names, &NameChange{Name: name})
+	return nil
+}
+
+func (rec *planRecorder) UpdateProposal(proposalHash []byte, ballot *payload.Ballot) error {
+	rec.proposals = append(rec.proposals, &ProposalChange{Hash: proposalHash, Ballot: ballot})
+	return nil
+}
+
+func (rec *planRecorder) RemoveProposal(proposalHash []byte) error {
+	rec.proposals = append(rec.proposals, &ProposalChange{Hash: proposalHash})
+	return nil
+}
+
+func (rec *planRecorder) touch(address crypto.Address) {
+	if rec.accounts == nil {
+		rec.accounts = make(map[crypto.Address]*acm.Account)
+		rec.storage = make(map[crypto.Address]map[binary.Word256]binary.Word256)
+	}
+	if _, ok := rec.accounts[address]; !ok {
+		rec.addresses = append(rec.addresses, address)
+		rec.accounts[address] = nil
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"regexp"
 	"strconv"
+	"time"
 
 	"strings"
 
@@ -118,6 +119,10 @@ var (
 
 	Uint64OrPlaceholder = Or(Placeholder, Uint64)
 
+	TimeOrPlaceholder = Or(Placeholder, Time)
+
+	Time = validation.Date(time.RFC3339).Error("must be a time in RFC3339 format like '2019-09-30T17:00:00Z'")
+
 	Uint64 = validation.By(func(value interface{}) error {
 		str, err := validation.EnsureString(value)
 		if err != nil {
@@ -0,0 +1,390 @@
+package def
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/acm/validator"
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/event/query"
+	"github.com/hyperledger/burrow/execution/contexts"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/names"
+	"github.com/hyperledger/burrow/execution/proposal"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/permission"
+	"github.com/hyperledger/burrow/rpc/rpcquery"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+// Simulator executes transactions against an in-memory fork of a chain's state so that a playbook can be dry-run.
+// State is read from the chain as it is needed and all changes are held in caches that are never committed.
+type Simulator struct {
+	// Transactions may be executed concurrently by jobs running in parallel
+	sync.Mutex
+	chainID    string
+	remote     *remoteState
+	blockchain *remoteBlockchain
+	accounts   *acmstate.Cache
+	nameReg    *names.Cache
+	proposals  *proposal.Cache
+	validators *validator.Bucket
+	txes       []*exec.TxExecution
+	logger     *logging.Logger
+}
+
+// NewSimulator forks the state of the chain at its latest height
+func NewSimulator(queryClient rpcquery.QueryClient, timeout time.Duration, logger *logging.Logger) (*Simulator, error) {
+	sim := &Simulator{
+		remote: &remoteState{
+			queryClient: queryClient,
+			timeout:     timeout,
+		},
+		logger: logger,
+	}
+	err := sim.fork()
+	if err != nil {
+		return nil, err
+	}
+	return sim, nil
+}
+
+// Fork discards the changes made by the transactions executed so far and forks the state of the chain afresh at its
+// latest height, so that the next plan only covers the transactions executed from now on
+func (sim *Simulator) Fork() error {
+	sim.Lock()
+	defer sim.Unlock()
+	return sim.fork()
+}
+
+func (sim *Simulator) fork() error {
+	ctx, cancel := context.WithTimeout(context.Background(), sim.remote.timeout)
+	defer cancel()
+	stat, err := sim.remote.queryClient.Status(ctx, &rpcquery.StatusParam{})
+	if err != nil {
+		return err
+	}
+	vs, err := sim.remote.queryClient.GetValidatorSet(ctx, &rpcquery.GetValidatorSetParam{})
+	if err != nil {
+		return err
+	}
+	sim.logger.InfoMsg("Dry run: forking chain state", "chain_id", stat.ChainID,
+		"height", stat.SyncInfo.LatestBlockHeight)
+	sim.chainID = stat.ChainID
+	sim.blockchain = &remoteBlockchain{
+		remoteState: sim.remote,
+		SyncInfo:    stat.SyncInfo,
+	}
+	sim.accounts = acmstate.NewCache(sim.remote, acmstate.Named("DryRun"))
+	sim.nameReg = names.NewCache(sim.remote)
+	sim.proposals = proposal.NewCache(sim.remote)
+	sim.validators = validator.NewBucket(validator.UnpersistSet(vs.Set))
+	sim.txes = nil
+	return nil
+}
+
+func (sim *Simulator) GetAccount(address crypto.Address) (*acm.Account, error) {
+	sim.Lock()
+	defer sim.Unlock()
+	return sim.accounts.GetAccount(address)
+}
+
+func (sim *Simulator) GetStorage(address crypto.Address, key binary.Word256) (binary.Word256, error) {
+	sim.Lock()
+	defer sim.Unlock()
+	return sim.accounts.GetStorage(address, key)
+}
+
+func (sim *Simulator) GetName(name string) (*names.Entry, error) {
+	sim.Lock()
+	defer sim.Unlock()
+	return sim.nameReg.GetName(name)
+}
+
+func (sim *Simulator) GetProposal(proposalHash []byte) (*payload.Ballot, error) {
+	sim.Lock()
+	defer sim.Unlock()
+	return sim.proposals.GetProposal(proposalHash)
+}
+
+// Validators returns the validator set including any changes made by transactions executed so far
+func (sim *Simulator) Validators() []*validator.Validator {
+	sim.Lock()
+	defer sim.Unlock()
+	return sim.validators.Next.Validators()
+}
+
+// Execute runs a transaction against the forked state in the same way a node would when delivering it in the next
+// block, except that signatures are not checked. As with a node, a transaction that fails its checks is rejected
+// with an error and leaves the state untouched, whereas one that throws an exception during execution is charged
+// its fee and returned with the exception set.
+func (sim *Simulator) Execute(txEnv *txs.Envelope) (*exec.TxExecution, error) {
+	sim.Lock()
+	defer sim.Unlock()
+	accounts := acmstate.NewCache(sim.accounts)
+	nameReg := names.NewCache(sim.nameReg)
+	proposals := proposal.NewCache(sim.proposals)
+
+	baseContexts := map[payload.Type]contexts.Context{
+		payload.TypeSend: &contexts.SendContext{
+			Blockchain:  sim.blockchain,
+			StateWriter: accounts,
+			Logger:      sim.logger,
+		},
+		payload.TypeCall: &contexts.CallContext{
+			Blockchain:  sim.blockchain,
+			StateWriter: accounts,
+			RunCall:     true,
+			Logger:      sim.logger,
+		},
+		payload.TypeName: &contexts.NameContext{
+			Blockchain:  sim.blockchain,
+			StateWriter: accounts,
+			NameReg:     nameReg,
+			Logger:      sim.logger,
+		},
+		payload.TypePermissions: &contexts.PermissionsContext{
+			Blockchain:  sim.blockchain,
+			StateWriter: accounts,
+			Logger:      sim.logger,
+		},
+		payload.TypeGovernance: &contexts.GovernanceContext{
+			Blockchain:   sim.blockchain,
+			ValidatorSet: sim.validators,
+			StateWriter:  accounts,
+			Logger:       sim.logger,
+		},
+	}
+	txContexts := map[payload.Type]contexts.Context{
+		payload.TypeProposal: &contexts.ProposalContext{
+			ChainID:     sim.chainID,
+			Blockchain:  sim.blockchain,
+			StateWriter: accounts,
+			ProposalReg: proposals,
+			Logger:      sim.logger,
+			Contexts:    baseContexts,
+		},
+	}
+	for k, v := range baseContexts {
+		txContexts[k] = v
+	}
+
+	txExecutor, ok := txContexts[txEnv.Tx.Type()]
+	if !ok {
+		return nil, fmt.Errorf("cannot dry run transaction of type %v", txEnv.Tx.Type())
+	}
+	err := sim.checkInputs(accounts, txEnv.Tx)
+	if err != nil {
+		return nil, err
+	}
+	txe := exec.NewTxExecution(txEnv)
+	txe.Height = sim.blockchain.LastBlockHeight() + 1
+	err = txExecutor.Execute(txe, txEnv.Tx.Payload)
+	if err != nil {
+		return nil, err
+	}
+	for _, input := range txEnv.Tx.GetInputs() {
+		acc, err := accounts.GetAccount(input.Address)
+		if err != nil {
+			return nil, err
+		}
+		acc.Sequence++
+		err = accounts.UpdateAccount(acc)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = accounts.Sync(sim.accounts)
+	if err != nil {
+		return nil, err
+	}
+	err = nameReg.Sync(sim.nameReg)
+	if err != nil {
+		return nil, err
+	}
+	err = proposals.Sync(sim.proposals)
+	if err != nil {
+		return nil, err
+	}
+	sim.txes = append(sim.txes, txe)
+	return txe, nil
+}
+
+// Tx returns a transaction executed by the simulator or nil if there is none with the hash
+func (sim *Simulator) Tx(txHash []byte) *exec.TxExecution {
+	sim.Lock()
+	defer sim.Unlock()
+	for _, txe := range sim.txes {
+		if bytes.Equal(txe.TxHash, txHash) {
+			return txe
+		}
+	}
+	return nil
+}
+
+// CallSim runs a call against the forked state without retaining any changes it makes
+func (sim *Simulator) CallSim(tx *payload.CallTx) (*exec.TxExecution, error) {
+	sim.Lock()
+	defer sim.Unlock()
+	exe := contexts.CallContext{
+		RunCall:     true,
+		StateWriter: acmstate.NewCache(sim.accounts),
+		Blockchain:  sim.blockchain,
+		Logger:      sim.logger,
+	}
+	txe := exec.NewTxExecution(txs.Enclose(sim.chainID, &payload.CallTx{
+		Input: &payload.TxInput{
+			Address: tx.Input.Address,
+		},
+		Address:  tx.Address,
+		Data:     tx.Data,
+		GasLimit: contexts.GasLimit,
+	}))
+	err := exe.Execute(txe, txe.Envelope.Tx.Payload)
+	if err != nil {
+		return nil, err
+	}
+	return txe, nil
+}
+
+// The checks a node makes of a transaction's inputs before executing it (other than of signatures and sequence
+// numbers, which we set)
+func (sim *Simulator) checkInputs(getter acmstate.AccountGetter, tx *txs.Tx) error {
+	for _, in := range tx.GetInputs() {
+		acc, err := getter.GetAccount(in.Address)
+		if err != nil {
+			return err
+		}
+		if acc == nil {
+			return fmt.Errorf("input account %v does not exist", in.Address)
+		}
+		if acc.Balance < in.Amount {
+			return fmt.Errorf("input account %v has balance %d but transaction requires %d", in.Address,
+				acc.Balance, in.Amount)
+		}
+		if !contexts.HasPermission(getter, sim.blockchain, acc, permission.Input, sim.logger) {
+			return fmt.Errorf("input account %v does not have Input permission", in.Address)
+		}
+	}
+	return nil
+}
+
+// Reads state from the chain as of its latest block
+type remoteState struct {
+	queryClient rpcquery.QueryClient
+	timeout     time.Duration
+}
+
+func (rs *remoteState) GetAccount(address crypto.Address) (*acm.Account, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), rs.timeout)
+	defer cancel()
+	acc, err := rs.queryClient.GetAccount(ctx, &rpcquery.GetAccountParam{Address: address})
+	if err != nil {
+		return nil, err
+	}
+	// The query server returns an empty account rather than nil when one does not exist
+	if acc.Address != address {
+		return nil, nil
+	}
+	return acc, nil
+}
+
+func (rs *remoteState) GetStorage(address crypto.Address, key binary.Word256) (binary.Word256, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), rs.timeout)
+	defer cancel()
+	val, err := rs.queryClient.GetStorage(ctx, &rpcquery.GetStorageParam{Address: address, Key: key})
+	if err != nil {
+		return binary.Zero256, err
+	}
+	return val.Value, nil
+}
+
+// GetName uses ListNames rather than GetName since the latter does not distinguish a missing name from an error
+func (rs *remoteState) GetName(name string) (*names.Entry, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), rs.timeout)
+	defer cancel()
+	stream, err := rs.queryClient.ListNames(ctx, &rpcquery.ListNamesParam{
+		Query: query.NewBuilder().AndEquals("Name", name).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for {
+		entry, err := stream.Recv()
+		if err == io.EOF {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if entry.Name == name {
+			return entry, nil
+		}
+	}
+}
+
+// GetProposal uses ListProposals rather than GetProposal since the latter does not distinguish a missing proposal
+// from an error
+func (rs *remoteState) GetProposal(proposalHash []byte) (*payload.Ballot, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), rs.timeout)
+	defer cancel()
+	stream, err := rs.queryClient.ListProposals(ctx, &rpcquery.ListProposalsParam{})
+	if err != nil {
+		return nil, err
+	}
+	for {
+		result, err := stream.Recv()
+		if err == io.EOF {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if string(result.Hash) == string(proposalHash) {
+			return result.Ballot, nil
+		}
+	}
+}
+
+// Execution's view of a chain that does not advance beyond its latest block
+type remoteBlockchain struct {
+	*remoteState
+	*bcm.SyncInfo
+}
+
+var _ contexts.Blockchain = &remoteBlockchain{}
+
+func (rb *remoteBlockchain) BlockHash(height uint64) []byte {
+	if height == rb.LatestBlockHeight {
+		return rb.LatestBlockHash
+	}
+	if height > rb.LatestBlockHeight {
+		return nil
+	}
+	// The hash of a block is recorded in the header of its successor
+	ctx, cancel := context.WithTimeout(context.Background(), rb.timeout)
+	defer cancel()
+	header, err := rb.queryClient.GetBlockHeader(ctx, &rpcquery.GetBlockParam{Height: height + 1})
+	if err != nil {
+		return nil
+	}
+	return header.LastBlockId.Hash
+}
+
+func (rb *remoteBlockchain) LastBlockTime() time.Time {
+	return rb.LatestBlockTime
+}
+
+func (rb *remoteBlockchain) LastBlockHeight() uint64 {
+	return rb.LatestBlockHeight
+}
@@ -0,0 +1,109 @@
+package def
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/permission"
+	"github.com/hyperledger/burrow/rpc"
+	"github.com/hyperledger/burrow/rpc/rpcquery"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func TestSimulator_Fork(t *testing.T) {
+	sender := acm.GeneratePrivateAccountFromSecret("sender")
+	recipient := acm.GeneratePrivateAccountFromSecret("recipient")
+	chain := &mockQueryClient{
+		accounts: map[crypto.Address]*acm.Account{
+			acm.GlobalPermissionsAddress: {
+				Address:     acm.GlobalPermissionsAddress,
+				Permissions: permission.DefaultAccountPermissions,
+			},
+			sender.GetAddress(): {
+				Address:     sender.GetAddress(),
+				PublicKey:   sender.GetPublicKey(),
+				Balance:     1000,
+				Permissions: permission.AllAccountPermissions,
+			},
+		},
+	}
+	sim, err := NewSimulator(chain, time.Second, logging.NewNoopLogger())
+	require.NoError(t, err)
+
+	send := func(amount uint64) {
+		tx := payload.NewSendTx()
+		require.NoError(t, tx.AddInput(sim, sender.GetPublicKey(), amount))
+		require.NoError(t, tx.AddOutput(recipient.GetAddress(), amount))
+		txe, err := sim.Execute(txs.Enclose(chain.ChainID(), tx))
+		require.NoError(t, err)
+		require.Nil(t, txe.Exception)
+	}
+
+	send(10)
+	plan, err := sim.Plan()
+	require.NoError(t, err)
+	assert.Len(t, plan.Transactions, 1)
+	assert.Len(t, plan.Accounts, 2)
+
+	// A new fork forgets the changes made so far, so its plan only covers what is executed afterwards
+	require.NoError(t, sim.Fork())
+	plan, err = sim.Plan()
+	require.NoError(t, err)
+	assert.Empty(t, plan.Transactions)
+	assert.Empty(t, plan.Accounts)
+
+	send(20)
+	plan, err = sim.Plan()
+	require.NoError(t, err)
+	require.Len(t, plan.Transactions, 1)
+	require.Len(t, plan.Accounts, 2)
+	for _, change := range plan.Accounts {
+		if change.Address == sender.GetAddress() {
+			assert.Equal(t, uint64(1000), change.Before.Balance)
+			assert.Equal(t, uint64(980), change.After.Balance)
+		} else {
+			assert.Nil(t, change.Before)
+			assert.Equal(t, uint64(20), change.After.Balance)
+		}
+	}
+}
+
+// Serves the state of a chain that has no blocks beyond its genesis
+type mockQueryClient struct {
+	rpcquery.QueryClient
+	accounts map[crypto.Address]*acm.Account
+}
+
+func (qc *mockQueryClient) ChainID() string {
+	return "SimulatorTestChain"
+}
+
+func (qc *mockQueryClient) Status(ctx context.Context, in *rpcquery.StatusParam,
+	opts ...grpc.CallOption) (*rpc.ResultStatus, error) {
+	return &rpc.ResultStatus{
+		ChainID:  qc.ChainID(),
+		SyncInfo: &bcm.SyncInfo{},
+	}, nil
+}
+
+func (qc *mockQueryClient) GetValidatorSet(ctx context.Context, in *rpcquery.GetValidatorSetParam,
+	opts ...grpc.CallOption) (*rpcquery.ValidatorSet, error) {
+	return &rpcquery.ValidatorSet{}, nil
+}
+
+func (qc *mockQueryClient) GetAccount(ctx context.Context, in *rpcquery.GetAccountParam,
+	opts ...grpc.CallOption) (*acm.Account, error) {
+	if acc, ok := qc.accounts[in.Address]; ok {
+		return acc.Copy(), nil
+	}
+	return new(acm.Account), nil
+}
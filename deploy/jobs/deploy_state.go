@@ -0,0 +1,206 @@
+package jobs
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	compilers "github.com/hyperledger/burrow/deploy/compile"
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+	hex "github.com/tmthrgd/go-hex"
+)
+
+// Tracks which of the transacting jobs of a playbook have already completed against a chain so that running it again
+// resumes from the first job that has not rather than repeating them all
+type deployState struct {
+	*def.DeployState
+	// Shared with the states of meta jobs since jobs may complete concurrently
+	mtx  *sync.Mutex
+	file string
+	// Run every job regardless of the state
+	force bool
+	// Consult the state but do not update it
+	dryRun bool
+	// Qualifies the names of jobs run by a meta job
+	prefix string
+}
+
+// The state file lives alongside the playbook
+func deployStateFile(playbook *def.Playbook) string {
+	return strings.TrimSuffix(playbook.Filename, filepath.Ext(playbook.Filename)) + ".state.json"
+}
+
+func loadDeployState(args *def.DeployArgs, playbook *def.Playbook, client *def.Client,
+	logger *logging.Logger) (*deployState, error) {
+	stat, err := client.Status(logger)
+	if err != nil {
+		return nil, err
+	}
+	ds := &deployState{
+		mtx:    new(sync.Mutex),
+		file:   deployStateFile(playbook),
+		force:  args.Force,
+		dryRun: args.DryRun,
+	}
+	ds.DeployState, err = def.LoadDeployState(ds.file)
+	if err != nil {
+		return nil, fmt.Errorf("could not load deployment state from %s: %v", ds.file, err)
+	}
+	switch {
+	case ds.DeployState == nil:
+		ds.DeployState = def.NewDeployState(stat.ChainID)
+	case ds.ChainID != stat.ChainID:
+		if !ds.force {
+			return nil, fmt.Errorf("deployment state %s was recorded against chain %s but connected to chain %s, "+
+				"pass --force to deploy afresh", ds.file, ds.ChainID, stat.ChainID)
+		}
+		ds.DeployState = def.NewDeployState(stat.ChainID)
+	default:
+		logger.InfoMsg("Resuming from deployment state", "file", ds.file, "jobs_completed", len(ds.Jobs))
+	}
+	return ds, nil
+}
+
+func (ds *deployState) meta(jobName string) *deployState {
+	meta := *ds
+	meta.prefix = ds.prefix + jobName + "/"
+	return &meta
+}
+
+// check returns the hash of the inputs to a job and whether the job completed with those same inputs on a previous
+// run, in which case its results are restored
+func (ds *deployState) check(job *def.Job, logger *logging.Logger, txes ...payload.Payload) (binary.HexBytes, bool, error) {
+	inputsHash, err := hashInputs(txes...)
+	if err != nil {
+		return nil, false, err
+	}
+	ds.mtx.Lock()
+	defer ds.mtx.Unlock()
+	state, ok := ds.Jobs[ds.prefix+job.Name]
+	if ds.force || !ok || !bytes.Equal(inputsHash, state.InputsHash) {
+		return inputsHash, false, nil
+	}
+	logger.InfoMsg("Skipping job completed with the same inputs on a previous run", "job", job.Name,
+		"result", state.Result)
+	if state.Result != "" {
+		job.Result = state.Result
+	}
+	job.Variables = state.Variables
+	job.TxHashes = state.TxHashes
+	return inputsHash, true, nil
+}
+
+// record notes that a job completed after broadcasting the transactions with the given receipts, and how the contracts
+// it deployed were compiled
+func (ds *deployState) record(job *def.Job, inputsHash binary.HexBytes, contracts []*compilers.ResponseItem,
+	libraries string, receipts []*txs.Receipt) error {
+	state := &def.JobState{
+		InputsHash: inputsHash,
+		Variables:  job.Variables,
+	}
+	if result, ok := job.Result.(string); ok {
+		state.Result = result
+	}
+	for _, contract := range contracts {
+		codeHash := sha256.Sum256([]byte(contract.Contract.Evm.Bytecode.Object))
+		state.CodeHashes = append(state.CodeHashes, codeHash[:])
+	}
+	for _, receipt := range receipts {
+		state.TxHashes = append(state.TxHashes, receipt.TxHash)
+		if receipt.CreatesContract {
+			state.Addresses = append(state.Addresses, receipt.ContractAddress)
+		}
+	}
+	// Contracts are created in the order they were compiled, any proxy for them coming after
+	contractStates := make(map[crypto.Address]*def.ContractState)
+	for i, address := range state.Addresses {
+		if i >= len(contracts) {
+			break
+		}
+		cs, err := contractState(ds.prefix+job.Name, &contracts[i].Contract, libraries)
+		if err != nil {
+			// Binaries need not have metadata
+			continue
+		}
+		contractStates[address] = cs
+	}
+	ds.mtx.Lock()
+	defer ds.mtx.Unlock()
+	ds.Jobs[ds.prefix+job.Name] = state
+	for address, cs := range contractStates {
+		ds.Contracts[address] = cs
+	}
+	if ds.dryRun {
+		return nil
+	}
+	err := ds.Save(ds.file)
+	if err != nil {
+		return fmt.Errorf("could not save deployment state to %s: %v", ds.file, err)
+	}
+	return nil
+}
+
+func contractState(jobName string, contract *compilers.SolidityContract, libraries string) (*def.ContractState, error) {
+	metadata, err := contract.ParseMetadata()
+	if err != nil {
+		return nil, err
+	}
+	cs := &def.ContractState{
+		Job:           jobName,
+		Compiler:      metadata.Compiler.Version,
+		Optimize:      metadata.Settings.Optimizer.Enabled,
+		OptimizerRuns: metadata.Settings.Optimizer.Runs,
+		EVMVersion:    metadata.Settings.EVMVersion,
+		Remappings:    metadata.Settings.Remappings,
+		SourceHashes:  make(map[string]binary.HexBytes),
+	}
+	for source, name := range metadata.Settings.CompilationTarget {
+		cs.Source = source
+		cs.Contract = name
+	}
+	cs.Libraries, err = parseLibraries(libraries)
+	if err != nil {
+		return nil, err
+	}
+	if len(cs.Libraries) == 0 {
+		cs.Libraries = nil
+	}
+	for path, source := range metadata.Sources {
+		cs.SourceHashes[path], err = hex.DecodeString(strings.TrimPrefix(source.Keccak256, "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("could not decode hash of source %s: %v", path, err)
+		}
+	}
+	return cs, nil
+}
+
+// Hashes transactions without their input sequence numbers, which change from run to run
+func hashInputs(txes ...payload.Payload) (binary.HexBytes, error) {
+	hasher := sha256.New()
+	for _, tx := range txes {
+		msg, ok := tx.(proto.Message)
+		if !ok {
+			return nil, fmt.Errorf("cannot hash transaction of type %v", tx.Type())
+		}
+		clone := proto.Clone(msg).(payload.Payload)
+		for _, input := range clone.GetInputs() {
+			input.Sequence = 0
+		}
+		bs, err := proto.Marshal(clone.(proto.Message))
+		if err != nil {
+			return nil, err
+		}
+		hasher.Write([]byte(tx.Type().String()))
+		hasher.Write(bs)
+	}
+	return hasher.Sum(nil), nil
+}
@@ -0,0 +1,115 @@
+package jobs
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	compilers "github.com/hyperledger/burrow/deploy/compile"
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHashInputs(t *testing.T) {
+	input := crypto.Address{1, 2, 3}
+	tx := &payload.CallTx{
+		Input:    &payload.TxInput{Address: input, Amount: 1, Sequence: 3},
+		GasLimit: 1000,
+		Data:     []byte{1, 2},
+	}
+	hash, err := hashInputs(tx)
+	require.NoError(t, err)
+
+	// Sequence numbers are ignored and left untouched
+	tx.Input.Sequence = 4
+	rehash, err := hashInputs(tx)
+	require.NoError(t, err)
+	assert.Equal(t, hash, rehash)
+	assert.Equal(t, uint64(4), tx.Input.Sequence)
+
+	tx.Data = []byte{1, 3}
+	rehash, err = hashInputs(tx)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, rehash)
+}
+
+func TestDeployState(t *testing.T) {
+	dir, err := ioutil.TempDir("", "deploy-state")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	logger := logging.NewNoopLogger()
+	file := deployStateFile(&def.Playbook{Filename: filepath.Join(dir, "deploy.yaml")})
+	assert.Equal(t, filepath.Join(dir, "deploy.state.json"), file)
+
+	tx := &payload.CallTx{
+		Input: &payload.TxInput{Address: crypto.Address{1}, Amount: 1},
+		Data:  []byte{1, 2},
+	}
+	ds := &deployState{DeployState: def.NewDeployState("TestChain"), mtx: new(sync.Mutex), file: file}
+	job := &def.Job{Name: "deployStorage"}
+	inputsHash, skip, err := ds.check(job, logger, tx)
+	require.NoError(t, err)
+	assert.False(t, skip)
+
+	contractAddress := crypto.Address{4, 5}
+	job.Result = contractAddress.String()
+	job.Variables = []*abi.Variable{{Name: "foo", Value: "bar"}}
+	contract := &compilers.ResponseItem{Objectname: "Storage"}
+	contract.Contract.Metadata = `{"compiler":{"version":"0.5.4+commit.9549d8ff"},"language":"Solidity",` +
+		`"settings":{"compilationTarget":{"storage.sol":"Storage"},"optimizer":{"enabled":true,"runs":200}},` +
+		`"sources":{"storage.sol":{"keccak256":"0x0102"}}}`
+	err = ds.record(job, inputsHash, []*compilers.ResponseItem{contract}, "Lib:0405", []*txs.Receipt{{
+		TxHash:          []byte{9},
+		CreatesContract: true,
+		ContractAddress: contractAddress,
+	}})
+	require.NoError(t, err)
+
+	// A later run restores the result of a job with unchanged inputs
+	state, err := def.LoadDeployState(file)
+	require.NoError(t, err)
+	require.Contains(t, state.Jobs, "deployStorage")
+	assert.Equal(t, []crypto.Address{contractAddress}, state.Jobs["deployStorage"].Addresses)
+	// along with how its contracts were compiled
+	assert.Equal(t, &def.ContractState{
+		Job:           "deployStorage",
+		Source:        "storage.sol",
+		Contract:      "Storage",
+		Compiler:      "0.5.4+commit.9549d8ff",
+		Optimize:      true,
+		OptimizerRuns: 200,
+		Libraries:     map[string]string{"Lib": "0405"},
+		SourceHashes:  map[string]binary.HexBytes{"storage.sol": {1, 2}},
+	}, state.Contracts[contractAddress])
+	ds = &deployState{DeployState: state, mtx: new(sync.Mutex), file: file}
+	job = &def.Job{Name: "deployStorage"}
+	_, skip, err = ds.check(job, logger, tx)
+	require.NoError(t, err)
+	assert.True(t, skip)
+	assert.Equal(t, contractAddress.String(), job.Result)
+	assert.Equal(t, "bar", job.Variables[0].Value)
+
+	// But not when they change, when forced, or when the job is run by a meta job
+	tx.Data = []byte{1, 3}
+	_, skip, err = ds.check(job, logger, tx)
+	require.NoError(t, err)
+	assert.False(t, skip)
+	tx.Data = []byte{1, 2}
+	_, skip, err = ds.meta("meta").check(job, logger, tx)
+	require.NoError(t, err)
+	assert.False(t, skip)
+	ds.force = true
+	_, skip, err = ds.check(job, logger, tx)
+	require.NoError(t, err)
+	assert.False(t, skip)
+}
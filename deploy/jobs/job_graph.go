@@ -0,0 +1,213 @@
+package jobs
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/deploy/def/rule"
+	"github.com/hyperledger/burrow/logging"
+)
+
+// jobDependencies returns, for each job of a playbook, the indices of the earlier jobs that must complete before it
+// can start. A job depends on any job whose result it references as a $variable and on the previous call to the same
+// contract, so that calls to a contract are made in order. It also depends on the last barrier job before it. Barrier
+// jobs read or change state that other jobs do not reference explicitly (such as the default account, balances,
+// permissions or compiled binaries) so run after every job before them and before every job after them.
+func jobDependencies(jobs []*def.Job) ([][]int, error) {
+	deps := make([][]int, len(jobs))
+	// The latest index of each job name
+	names := make(map[string]int)
+	// The latest index of a call to each destination
+	destinations := make(map[string]int)
+	barrier := -1
+	for i, job := range jobs {
+		payload, err := job.Payload()
+		if err != nil {
+			return nil, err
+		}
+		after := make(map[int]bool)
+		if isBarrier(payload) {
+			for j := barrier; j < i; j++ {
+				if j >= 0 {
+					after[j] = true
+				}
+			}
+		} else if barrier >= 0 {
+			after[barrier] = true
+		}
+
+		bs, err := json.Marshal(payload)
+		if err != nil {
+			return nil, err
+		}
+		for _, pm := range rule.MatchPlaceholders(string(bs)) {
+			if j, ok := names[pm.JobName]; ok {
+				after[j] = true
+			}
+		}
+
+		var destination string
+		switch p := payload.(type) {
+		case *def.Call:
+			destination = p.Destination
+		case *def.AssertRevert:
+			destination = p.Destination
+		case *def.Upgrade:
+			// Calls to the proxy either side of an upgrade run the implementation they were written for
+			destination = p.Proxy
+		case *def.AssertEvents:
+			// Refers to the job by name rather than as a $variable
+			if j, ok := names[p.Job]; ok {
+				after[j] = true
+			}
+		}
+		if destination != "" {
+			if j, ok := destinations[destination]; ok {
+				after[j] = true
+			}
+			destinations[destination] = i
+		}
+
+		for j := range after {
+			deps[i] = append(deps[i], j)
+		}
+		sort.Ints(deps[i])
+		names[job.Name] = i
+		if isBarrier(payload) {
+			barrier = i
+		}
+	}
+	return deps, nil
+}
+
+func isBarrier(payload def.Payload) bool {
+	switch payload.(type) {
+	case *def.Account, *def.Meta, *def.If, *def.Foreach, *def.Proposal, *def.Build, *def.Send, *def.Permission, *def.UpdateAccount,
+		*def.RestoreState, *def.DumpState, *def.QueryAccount, *def.QueryContract, *def.QueryName, *def.QueryVals,
+		*def.AssertBalance:
+		return true
+	}
+	return false
+}
+
+type jobResult struct {
+	index int
+	err   error
+}
+
+// runJobs runs jobs with up to concurrency at once, starting each once the jobs it depends on have completed. Where
+// more than one job is ready the earliest is started first, so with a concurrency of one jobs run in order. After a
+// job fails no more are started and the first error is returned once those running have finished.
+func runJobs(deps [][]int, concurrency int, run func(index int) error) error {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	waiting := make([]int, len(deps))
+	dependents := make([][]int, len(deps))
+	var ready []int
+	for i, ds := range deps {
+		waiting[i] = len(ds)
+		for _, j := range ds {
+			dependents[j] = append(dependents[j], i)
+		}
+		if len(ds) == 0 {
+			ready = append(ready, i)
+		}
+	}
+
+	results := make(chan jobResult)
+	running := 0
+	var err error
+	for {
+		for err == nil && running < concurrency && len(ready) > 0 {
+			index := ready[0]
+			ready = ready[1:]
+			running++
+			go func() {
+				results <- jobResult{index: index, err: run(index)}
+			}()
+		}
+		if running == 0 {
+			return err
+		}
+		result := <-results
+		running--
+		if result.err != nil {
+			if err == nil {
+				err = result.err
+			}
+			continue
+		}
+		for _, i := range dependents[result.index] {
+			waiting[i]--
+			if waiting[i] == 0 {
+				ready = append(ready, i)
+			}
+		}
+		sort.Ints(ready)
+	}
+}
+
+// Serialises jobs sending transactions from the same account when they are signed locally. Each takes its sequence
+// number from the chain when it is formulated so must not start until the last has been committed. With mempool
+// signing the node allocates sequence numbers so no locking is needed.
+type accountLocks struct {
+	sync.Mutex
+	locks map[crypto.Address]*sync.Mutex
+}
+
+func newAccountLocks() *accountLocks {
+	return &accountLocks{locks: make(map[crypto.Address]*sync.Mutex)}
+}
+
+func (al *accountLocks) lock(account crypto.Address) (unlock func()) {
+	al.Lock()
+	mtx, ok := al.locks[account]
+	if !ok {
+		mtx = new(sync.Mutex)
+		al.locks[account] = mtx
+	}
+	al.Unlock()
+	mtx.Lock()
+	return mtx.Unlock
+}
+
+// The address of the account from which a job sends transactions, if it does. Jobs are locked on the address rather
+// than the source given so that a key name, its address and a job defaulting to the playbook's account share a lock.
+func jobSource(payload def.Payload, playbook *def.Playbook, client *def.Client,
+	logger *logging.Logger) (crypto.Address, bool, error) {
+	var source string
+	switch p := payload.(type) {
+	case *def.Deploy:
+		source = p.Source
+	case *def.Call:
+		source = p.Source
+	case *def.AssertRevert:
+		source = p.Source
+	case *def.Upgrade:
+		source = p.Source
+	case *def.Send:
+		source = p.Source
+	case *def.RegisterName:
+		source = p.Source
+	case *def.Permission:
+		source = p.Source
+	case *def.UpdateAccount:
+		source = p.Source
+	default:
+		return crypto.Address{}, false, nil
+	}
+	source = FirstOf(source, playbook.Account)
+	if source == "" {
+		return crypto.Address{}, false, nil
+	}
+	address, err := client.GetKeyAddress(source, logger)
+	if err != nil {
+		return crypto.Address{}, false, fmt.Errorf("could not get address of source account %s: %v", source, err)
+	}
+	return address, true, nil
+}
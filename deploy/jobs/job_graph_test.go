@@ -0,0 +1,119 @@
+package jobs
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobDependencies(t *testing.T) {
+	jobs := []*def.Job{
+		{Name: "setStorageBase", Set: &def.Set{Value: "5"}},
+		{Name: "deployStorage", Deploy: &def.Deploy{Contract: "storage.sol"}},
+		{Name: "deployOther", Deploy: &def.Deploy{Contract: "other.sol"}},
+		{Name: "setStorage", Call: &def.Call{Destination: "$deployStorage", Function: "set",
+			Data: []interface{}{"$setStorageBase"}}},
+		{Name: "setOther", Call: &def.Call{Destination: "$deployOther", Function: "set"}},
+		{Name: "setStorageAgain", Call: &def.Call{Destination: "$deployStorage", Function: "set"}},
+		{Name: "queryStorage", QueryContract: &def.QueryContract{Destination: "$deployStorage", Function: "get"}},
+		{Name: "assertStorage", Assert: &def.Assert{Key: "$queryStorage", Relation: "eq", Value: "5"}},
+		{Name: "deployLater", Deploy: &def.Deploy{Contract: "later.sol"}},
+		{Name: "assertSetStorageEvents", AssertEvents: &def.AssertEvents{Job: "setStorage"}},
+		{Name: "assertStorageReverts", AssertRevert: &def.AssertRevert{Destination: "$deployStorage", Function: "set"}},
+	}
+	deps, err := jobDependencies(jobs)
+	require.NoError(t, err)
+	assert.Equal(t, [][]int{
+		nil,
+		nil,
+		nil,
+		{0, 1},
+		{2},
+		{1, 3},
+		// Queries wait for everything before them
+		{0, 1, 2, 3, 4, 5},
+		{6},
+		// and everything after waits for them
+		{6},
+		// Events are asserted after the job that emits them
+		{3, 6},
+		// and reverts are expected in order with other calls
+		{1, 5, 6},
+	}, deps)
+}
+
+func TestRunJobs(t *testing.T) {
+	deps := [][]int{nil, nil, {0}, {1, 2}, nil}
+
+	// Jobs run in order with a concurrency of one
+	var order []int
+	err := runJobs(deps, 1, func(index int) error {
+		order = append(order, index)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, order)
+
+	// Jobs never start before those they depend on have finished
+	var mtx sync.Mutex
+	finished := make(map[int]bool)
+	err = runJobs(deps, 3, func(index int) error {
+		mtx.Lock()
+		defer mtx.Unlock()
+		for _, j := range deps[index] {
+			if !finished[j] {
+				return fmt.Errorf("job %d started before job %d it depends on finished", index, j)
+			}
+		}
+		finished[index] = true
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Len(t, finished, len(deps))
+
+	// No more jobs start after one fails
+	order = nil
+	err = runJobs(deps, 1, func(index int) error {
+		order = append(order, index)
+		if index == 1 {
+			return fmt.Errorf("job failed")
+		}
+		return nil
+	})
+	assert.Error(t, err)
+	assert.Equal(t, []int{0, 1}, order)
+}
+
+func TestJobSource(t *testing.T) {
+	account := crypto.Address{1, 2, 3}
+	playbook := &def.Playbook{Account: account.String()}
+	client := def.NewClient("", "", false, 0)
+	logger := logging.NewNoopLogger()
+
+	// Jobs defaulting to the playbook's account lock on the same address as those naming it
+	for _, payload := range []def.Payload{
+		&def.Send{Destination: "dest"},
+		&def.Call{Source: account.String(), Destination: "dest"},
+		&def.Deploy{Source: strings.ToLower(account.String()), Contract: "c.sol"},
+	} {
+		source, ok, err := jobSource(payload, playbook, client, logger)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, account, source)
+	}
+
+	_, ok, err := jobSource(&def.Set{Value: "1"}, playbook, client, logger)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	_, ok, err = jobSource(&def.Send{Destination: "dest"}, &def.Playbook{}, client, logger)
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
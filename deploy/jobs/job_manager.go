@@ -5,10 +5,13 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
 	compilers "github.com/hyperledger/burrow/deploy/compile"
 	"github.com/hyperledger/burrow/deploy/def"
 	"github.com/hyperledger/burrow/deploy/util"
 	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/txs"
 	pbpayload "github.com/hyperledger/burrow/txs/payload"
 )
 
@@ -31,19 +34,34 @@ type intermediateJob struct {
 	done         chan struct{}
 }
 
-func intermediateJobRunner(jobs chan *intermediateJob, logger *logging.Logger) {
+func intermediateJobRunner(jobs chan *intermediateJob, compiler *compilers.Compiler, logger *logging.Logger) {
 	for {
 		intermediate, ok := <-jobs
 		if !ok {
 			break
 		}
-		resp, err := compilers.Compile(intermediate.work.contractName, false, intermediate.work.workDir, nil, logger)
+		resp, err := compiler.Compile(intermediate.work.contractName, intermediate.work.workDir, nil, logger)
 		(*intermediate).compilerResp = resp
 		(*intermediate).err = err
 		close(intermediate.done)
 	}
 }
 
+// Configures the compiler from the playbook and the solc binaries and cache given on the command line
+func newCompiler(args *def.DeployArgs, playbook *def.Playbook) *compilers.Compiler {
+	compiler := &compilers.Compiler{
+		SolcDir:  args.SolcDir,
+		CacheDir: args.CompileCache,
+	}
+	if playbook.Compiler != nil {
+		compiler.Version = playbook.Compiler.Version
+		compiler.Optimize = playbook.Compiler.Optimize
+		compiler.OptimizerRuns = playbook.Compiler.OptimizeRuns
+		compiler.Remappings = playbook.Compiler.Remappings
+	}
+	return compiler
+}
+
 func queueCompilerWork(job *def.Job, playbook *def.Playbook, jobs chan *intermediateJob) error {
 	payload, err := job.Payload()
 	if err != nil {
@@ -74,6 +92,18 @@ func queueCompilerWork(job *def.Job, playbook *def.Playbook, jobs chan *intermed
 			job.Intermediate = &intermediate
 			jobs <- &intermediate
 		}
+	case *def.Upgrade:
+		if filepath.Ext(job.Upgrade.Contract) == ".sol" {
+			intermediate := intermediateJob{
+				done: make(chan struct{}),
+				work: solidityCompilerWork{
+					contractName: job.Upgrade.Contract,
+					workDir:      playbook.Path,
+				},
+			}
+			job.Intermediate = &intermediate
+			jobs <- &intermediate
+		}
 	case *def.Proposal:
 		for _, job := range job.Proposal.Jobs {
 			err = queueCompilerWork(job, playbook, jobs)
@@ -88,6 +118,23 @@ func queueCompilerWork(job *def.Job, playbook *def.Playbook, jobs chan *intermed
 				return err
 			}
 		}
+	case *def.If:
+		for _, branch := range [][]*def.Job{job.If.Jobs, job.If.Else} {
+			for _, job := range branch {
+				err = queueCompilerWork(job, playbook, jobs)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	case *def.Foreach:
+		// The copies of the jobs made for each item share the compiler work queued for them here
+		for _, job := range job.Foreach.Jobs {
+			err = queueCompilerWork(job, playbook, jobs)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
@@ -103,145 +150,292 @@ func getCompilerWork(intermediate interface{}) (*compilers.Response, error) {
 	return nil, fmt.Errorf("internal error: no compiler work queued")
 }
 
-func doJobs(playbook *def.Playbook, args *def.DeployArgs, client *def.Client, logger *logging.Logger) error {
-	for _, job := range playbook.Jobs {
-		payload, err := job.Payload()
+// doJobs runs the jobs of a playbook, running up to args.ParallelJobs at once where they do not depend on one another
+func doJobs(playbook *def.Playbook, args *def.DeployArgs, client *def.Client, state *deployState,
+	locks *accountLocks, logger *logging.Logger) error {
+	deps, err := jobDependencies(playbook.Jobs)
+	if err != nil {
+		return err
+	}
+	return runJobs(deps, args.ParallelJobs, func(index int) error {
+		return doJob(playbook.Jobs[index], playbook, args, client, state, locks, logger)
+	})
+}
+
+func doJob(job *def.Job, playbook *def.Playbook, args *def.DeployArgs, client *def.Client, state *deployState,
+	locks *accountLocks, logger *logging.Logger) error {
+	// Set by jobs that transact so that they can be skipped on a later run if their inputs are unchanged
+	var txes []pbpayload.Payload
+	var inputsHash binary.HexBytes
+	var contracts []*compilers.ResponseItem
+	// Linked into the contracts deployed
+	var libraries string
+	var skip bool
+
+	payload, err := job.Payload()
+	if err != nil {
+		return fmt.Errorf("could not get Job payload: %v", payload)
+	}
+
+	err = util.PreProcessFields(payload, args, playbook, client, logger)
+	if err != nil {
+		return err
+	}
+	// Revalidate with possible replacements
+	err = payload.Validate()
+	if err != nil {
+		return fmt.Errorf("error validating job %s after pre-processing variables: %v", job.Name, err)
+	}
+
+	if !client.MempoolSigning && !client.DryRun {
+		source, ok, err := jobSource(payload, playbook, client, logger)
 		if err != nil {
-			return fmt.Errorf("could not get Job payload: %v", payload)
+			return fmt.Errorf("error locking source account of job %s: %v", job.Name, err)
 		}
+		if ok {
+			defer locks.lock(source)()
+		}
+	}
 
-		err = util.PreProcessFields(payload, args, playbook, client, logger)
+	switch payload.(type) {
+	case *def.Proposal:
+		announce(job.Name, "Proposal", logger)
+		job.Result, err = ProposalJob(job.Proposal, args, playbook, client, logger)
+
+	// Meta Job
+	case *def.Meta:
+		announce(job.Name, "Meta", logger)
+		metaPlaybook := job.Meta.Playbook
+		if metaPlaybook.Account == "" {
+			metaPlaybook.Account = playbook.Account
+		}
+		err = doJobs(metaPlaybook, args, client, state.meta(job.Name), locks, logger)
+
+	// Control jobs
+	case *def.If:
+		announce(job.Name, "If", logger)
+		job.Result, job.Variables, err = IfJob(job.Name, job.If, args, playbook, client, state, locks, logger)
+	case *def.Foreach:
+		announce(job.Name, "Foreach", logger)
+		job.Result, job.Variables, err = ForeachJob(job.Name, job.Foreach, args, playbook, client, state, locks,
+			logger)
+
+	// Governance
+	case *def.UpdateAccount:
+		announce(job.Name, "UpdateAccount", logger)
+		var tx *pbpayload.GovTx
+		tx, job.Variables, err = FormulateUpdateAccountJob(job.UpdateAccount, playbook.Account, client, logger)
+		if err != nil {
+			return err
+		}
+		txes = []pbpayload.Payload{tx}
+		inputsHash, skip, err = state.check(job, logger, txes...)
+		if err != nil || skip {
+			break
+		}
+		err = UpdateAccountJob(job.UpdateAccount, playbook.Account, tx, client, logger)
+
+	// Util jobs
+	case *def.Account:
+		announce(job.Name, "Account", logger)
+		job.Result, err = SetAccountJob(job.Account, args, playbook, logger)
+	case *def.Set:
+		announce(job.Name, "Set", logger)
+		job.Result, err = SetValJob(job.Set, args, logger)
+
+	// Transaction jobs
+	case *def.Send:
+		announce(job.Name, "Send", logger)
+		var tx *pbpayload.SendTx
+		tx, err = FormulateSendJob(job.Send, playbook.Account, client, logger)
 		if err != nil {
 			return err
 		}
-		// Revalidate with possible replacements
-		err = payload.Validate()
+		txes = []pbpayload.Payload{tx}
+		inputsHash, skip, err = state.check(job, logger, txes...)
+		if err != nil || skip {
+			break
+		}
+		job.Result, err = SendJob(job.Send, tx, playbook.Account, client, logger)
+	case *def.RegisterName:
+		announce(job.Name, "RegisterName", logger)
+		var txs []*pbpayload.NameTx
+		txs, err = FormulateRegisterNameJob(job.RegisterName, args, playbook, client, logger)
 		if err != nil {
-			return fmt.Errorf("error validating job %s after pre-processing variables: %v", job.Name, err)
+			return err
 		}
+		for _, tx := range txs {
+			txes = append(txes, tx)
+		}
+		inputsHash, skip, err = state.check(job, logger, txes...)
+		if err != nil || skip {
+			break
+		}
+		job.Result, err = RegisterNameJob(job.RegisterName, args, playbook, txs, client, logger)
+	case *def.Permission:
+		announce(job.Name, "Permission", logger)
+		var tx *pbpayload.PermsTx
+		tx, err = FormulatePermissionJob(job.Permission, playbook.Account, client, logger)
+		if err != nil {
+			return err
+		}
+		txes = []pbpayload.Payload{tx}
+		inputsHash, skip, err = state.check(job, logger, txes...)
+		if err != nil || skip {
+			break
+		}
+		job.Result, err = PermissionJob(job.Permission, playbook.Account, tx, client, logger)
 
-		switch payload.(type) {
-		case *def.Proposal:
-			announce(job.Name, "Proposal", logger)
-			job.Result, err = ProposalJob(job.Proposal, args, playbook, client, logger)
-
-		// Meta Job
-		case *def.Meta:
-			announce(job.Name, "Meta", logger)
-			metaPlaybook := job.Meta.Playbook
-			if metaPlaybook.Account == "" {
-				metaPlaybook.Account = playbook.Account
+	// Contracts jobs
+	case *def.Deploy:
+		announce(job.Name, "Deploy", logger)
+		var txs []*pbpayload.CallTx
+		txs, contracts, err = FormulateDeployJob(job.Deploy, args, playbook, client, job.Intermediate, logger)
+		if err != nil {
+			return err
+		}
+		libraries = job.Deploy.Libraries
+		for _, tx := range txs {
+			txes = append(txes, tx)
+		}
+		inputsHash, skip, err = state.check(job, logger, txes...)
+		if err != nil || skip {
+			break
+		}
+		if job.Deploy.Proxy {
+			var proxyTx *pbpayload.CallTx
+			job.Result, job.Variables, proxyTx, err = DeployProxyJob(job.Deploy, args, playbook, client, txs,
+				contracts, logger)
+			if proxyTx != nil {
+				txes = append(txes, proxyTx)
 			}
-			err = doJobs(metaPlaybook, args, client, logger)
+			break
+		}
+		job.Result, err = DeployJob(job.Deploy, args, playbook, client, txs, contracts, logger)
+	case *def.Upgrade:
+		announce(job.Name, "Upgrade", logger)
+		var tx, upgradeTx *pbpayload.CallTx
+		var contract *compilers.ResponseItem
+		var proxyAddress crypto.Address
+		tx, contract, err = FormulateUpgradeJob(job.Upgrade, args, playbook, client, job.Intermediate, logger)
+		if err != nil {
+			return err
+		}
+		contracts = []*compilers.ResponseItem{contract}
+		libraries = job.Upgrade.Libraries
+		txes = []pbpayload.Payload{tx}
+		// The proxy is an input too so that upgrading another proxy to the same implementation is not skipped
+		proxyAddress, err = crypto.AddressFromHexString(job.Upgrade.Proxy)
+		if err != nil {
+			return err
+		}
+		inputsHash, skip, err = state.check(job, logger, tx, &pbpayload.CallTx{Input: tx.Input, Address: &proxyAddress})
+		if err != nil || skip {
+			break
+		}
+		job.Result, upgradeTx, err = UpgradeJob(job.Upgrade, args, playbook, client, tx, contract, logger)
+		if upgradeTx != nil {
+			txes = append(txes, upgradeTx)
+		}
 
-		// Governance
-		case *def.UpdateAccount:
-			announce(job.Name, "UpdateAccount", logger)
-			var tx *pbpayload.GovTx
-			tx, job.Variables, err = FormulateUpdateAccountJob(job.UpdateAccount, playbook.Account, client, logger)
-			if err != nil {
-				return err
-			}
-			err = UpdateAccountJob(job.UpdateAccount, playbook.Account, tx, client, logger)
-
-		// Util jobs
-		case *def.Account:
-			announce(job.Name, "Account", logger)
-			job.Result, err = SetAccountJob(job.Account, args, playbook, logger)
-		case *def.Set:
-			announce(job.Name, "Set", logger)
-			job.Result, err = SetValJob(job.Set, args, logger)
-
-		// Transaction jobs
-		case *def.Send:
-			announce(job.Name, "Send", logger)
-			tx, err := FormulateSendJob(job.Send, playbook.Account, client, logger)
-			if err != nil {
-				return err
-			}
-			job.Result, err = SendJob(job.Send, tx, playbook.Account, client, logger)
-		case *def.RegisterName:
-			announce(job.Name, "RegisterName", logger)
-			txs, err := FormulateRegisterNameJob(job.RegisterName, args, playbook, client, logger)
-			if err != nil {
-				return err
-			}
-			job.Result, err = RegisterNameJob(job.RegisterName, args, playbook, txs, client, logger)
-		case *def.Permission:
-			announce(job.Name, "Permission", logger)
-			tx, err := FormulatePermissionJob(job.Permission, playbook.Account, client, logger)
-			if err != nil {
-				return err
-			}
-			job.Result, err = PermissionJob(job.Permission, playbook.Account, tx, client, logger)
-
-		// Contracts jobs
-		case *def.Deploy:
-			announce(job.Name, "Deploy", logger)
-			txs, contracts, ferr := FormulateDeployJob(job.Deploy, args, playbook, client, job.Intermediate, logger)
-			if ferr != nil {
-				return ferr
-			}
-			job.Result, err = DeployJob(job.Deploy, args, playbook, client, txs, contracts, logger)
+	case *def.Call:
+		announce(job.Name, "Call", logger)
+		CallTx, ferr := FormulateCallJob(job.Call, args, playbook, client, logger)
+		if ferr != nil {
+			return ferr
+		}
+		txes = []pbpayload.Payload{CallTx}
+		inputsHash, skip, err = state.check(job, logger, txes...)
+		if err != nil || skip {
+			break
+		}
+		job.Result, job.Variables, err = CallJob(job.Call, CallTx, args, playbook, client, logger)
+	case *def.Build:
+		announce(job.Name, "Build", logger)
+		var resp *compilers.Response
+		resp, err = getCompilerWork(job.Intermediate)
+		if err != nil {
+			return err
+		}
+		job.Result, err = BuildJob(job.Build, playbook, resp, logger)
+
+	// State jobs
+	case *def.RestoreState:
+		announce(job.Name, "RestoreState", logger)
+		job.Result, err = RestoreStateJob(job.RestoreState)
+	case *def.DumpState:
+		announce(job.Name, "DumpState", logger)
+		job.Result, err = DumpStateJob(job.DumpState)
+
+	// Test jobs
+	case *def.QueryAccount:
+		announce(job.Name, "QueryAccount", logger)
+		job.Result, err = QueryAccountJob(job.QueryAccount, client, logger)
+	case *def.QueryContract:
+		announce(job.Name, "QueryContract", logger)
+		job.Result, job.Variables, err = QueryContractJob(job.QueryContract, args, playbook, client, logger)
+	case *def.QueryName:
+		announce(job.Name, "QueryName", logger)
+		job.Result, err = QueryNameJob(job.QueryName, client, logger)
+	case *def.QueryVals:
+		announce(job.Name, "QueryVals", logger)
+		job.Result, err = QueryValsJob(job.QueryVals, client, logger)
+	case *def.Assert:
+		announce(job.Name, "Assert", logger)
+		job.Result, err = AssertJob(job.Assert, logger)
+	case *def.AssertRevert:
+		announce(job.Name, "AssertRevert", logger)
+		var tx *pbpayload.CallTx
+		tx, err = FormulateAssertRevertJob(job.AssertRevert, args, playbook, client, logger)
+		if err != nil {
+			return err
+		}
+		txes = []pbpayload.Payload{tx}
+		inputsHash, skip, err = state.check(job, logger, txes...)
+		if err != nil || skip {
+			break
+		}
+		job.Result, err = AssertRevertJob(job.AssertRevert, tx, client, logger)
+	case *def.AssertEvents:
+		announce(job.Name, "AssertEvents", logger)
+		job.Result, err = AssertEventsJob(job.AssertEvents, args, playbook, client, logger)
+	case *def.AssertBalance:
+		announce(job.Name, "AssertBalance", logger)
+		job.Result, err = AssertBalanceJob(job.AssertBalance, client, logger)
+
+	default:
+		logger.InfoMsg("Error")
+		return fmt.Errorf("the Job specified in deploy.yaml and parsed as '%v' is not recognised as a valid job",
+			job)
+	}
 
-		case *def.Call:
-			announce(job.Name, "Call", logger)
-			CallTx, ferr := FormulateCallJob(job.Call, args, playbook, client, logger)
-			if ferr != nil {
-				return ferr
-			}
-			job.Result, job.Variables, err = CallJob(job.Call, CallTx, args, playbook, client, logger)
-		case *def.Build:
-			announce(job.Name, "Build", logger)
-			var resp *compilers.Response
-			resp, err = getCompilerWork(job.Intermediate)
-			if err != nil {
-				return err
-			}
-			job.Result, err = BuildJob(job.Build, playbook, resp, logger)
-
-		// State jobs
-		case *def.RestoreState:
-			announce(job.Name, "RestoreState", logger)
-			job.Result, err = RestoreStateJob(job.RestoreState)
-		case *def.DumpState:
-			announce(job.Name, "DumpState", logger)
-			job.Result, err = DumpStateJob(job.DumpState)
-
-		// Test jobs
-		case *def.QueryAccount:
-			announce(job.Name, "QueryAccount", logger)
-			job.Result, err = QueryAccountJob(job.QueryAccount, client, logger)
-		case *def.QueryContract:
-			announce(job.Name, "QueryContract", logger)
-			job.Result, job.Variables, err = QueryContractJob(job.QueryContract, args, playbook, client, logger)
-		case *def.QueryName:
-			announce(job.Name, "QueryName", logger)
-			job.Result, err = QueryNameJob(job.QueryName, client, logger)
-		case *def.QueryVals:
-			announce(job.Name, "QueryVals", logger)
-			job.Result, err = QueryValsJob(job.QueryVals, client, logger)
-		case *def.Assert:
-			announce(job.Name, "Assert", logger)
-			job.Result, err = AssertJob(job.Assert, logger)
-
-		default:
-			logger.InfoMsg("Error")
-			return fmt.Errorf("the Job specified in deploy.yaml and parsed as '%v' is not recognised as a valid job",
-				job)
-		}
-
-		if len(job.Variables) != 0 {
-			for _, theJob := range job.Variables {
-				logger.InfoMsg("Job Vars", "name", theJob.Name, "value", theJob.Value)
-			}
+	// Taken whether or not the job is recorded so that the client does not hold on to them
+	receipts := make([]*txs.Receipt, 0, len(txes))
+	for _, tx := range txes {
+		if receipt := client.TakeReceipt(tx); receipt != nil {
+			receipts = append(receipts, receipt)
 		}
+	}
 
+	if len(job.Variables) != 0 {
+		for _, theJob := range job.Variables {
+			logger.InfoMsg("Job Vars", "name", theJob.Name, "value", theJob.Value)
+		}
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if inputsHash != nil && !skip {
+		for _, receipt := range receipts {
+			job.TxHashes = append(job.TxHashes, receipt.TxHash)
+		}
+		err = state.record(job, inputsHash, contracts, libraries, receipts)
 		if err != nil {
 			return err
 		}
 	}
-
 	return nil
 }
 
@@ -265,15 +459,21 @@ func ExecutePlaybook(args *def.DeployArgs, playbook *def.Playbook, client *def.C
 	jobs := make(chan *intermediateJob, concurrentSolcWorkQueue)
 	defer close(jobs)
 
+	compiler := newCompiler(args, playbook)
 	for i := 0; i < concurrentSolc; i++ {
-		go intermediateJobRunner(jobs, logger)
+		go intermediateJobRunner(jobs, compiler, logger)
 	}
 
 	for _, job := range playbook.Jobs {
 		queueCompilerWork(job, playbook, jobs)
 	}
 
-	err = doJobs(playbook, args, client, logger)
+	state, err := loadDeployState(args, playbook, client, logger)
+	if err != nil {
+		return err
+	}
+
+	err = doJobs(playbook, args, client, state, newAccountLocks(), logger)
 	if err != nil {
 		return err
 	}
@@ -344,6 +544,11 @@ func postProcess(args *def.DeployArgs, playbook *def.Playbook, logger *logging.L
 		args.DefaultOutput = fmt.Sprintf("%s.output.json", yaml)
 	}
 
+	if args.DryRun {
+		logger.InfoMsg("Not writing output for dry run")
+		return nil
+	}
+
 	// if CurrentOutput set, we're in a meta job
 	if args.CurrentOutput != "" {
 		logger.InfoMsg("Writing meta output to current directory", "output", args.CurrentOutput)
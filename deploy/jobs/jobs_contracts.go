@@ -13,6 +13,7 @@ import (
 	"github.com/hyperledger/burrow/crypto"
 	compilers "github.com/hyperledger/burrow/deploy/compile"
 	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/deploy/proxy"
 	"github.com/hyperledger/burrow/deploy/util"
 	"github.com/hyperledger/burrow/execution/evm/abi"
 	"github.com/hyperledger/burrow/txs/payload"
@@ -115,21 +116,9 @@ func FormulateDeployJob(deploy *def.Deploy, do *def.DeployArgs, deployScript *de
 	}
 
 	txs = make([]*payload.CallTx, 0)
-	libs := make(map[string]string)
-	var list []string
-	if strings.Contains(deploy.Libraries, " ") {
-		list = strings.Split(deploy.Libraries, " ")
-	} else {
-		list = strings.Split(deploy.Libraries, ",")
-	}
-	for _, l := range list {
-		if l != "" {
-			v := strings.Split(l, ":")
-			if len(v) != 2 {
-				return nil, nil, fmt.Errorf("library %s should be contract:address format", l)
-			}
-			libs[v[0]] = v[1]
-		}
+	libs, err := parseLibraries(deploy.Libraries)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	contracts = make([]*compilers.ResponseItem, 0)
@@ -163,7 +152,7 @@ func FormulateDeployJob(deploy *def.Deploy, do *def.DeployArgs, deployScript *de
 			contractCode = contractCode + callData
 		}
 
-		tx, err := deployTx(client, deploy, contractName, string(contractCode), logger)
+		tx, err := deployTx(client, deploy, contractName, string(contractCode), contract.Abi, logger)
 		if err != nil {
 			return nil, nil, fmt.Errorf("could not deploy binary contract: %v", err)
 		}
@@ -302,6 +291,196 @@ func DeployJob(deploy *def.Deploy, do *def.DeployArgs, script *def.Playbook, cli
 	return result, nil
 }
 
+// DeployProxyJob deploys a contract as the implementation behind a new proxy. It returns the address of the proxy with
+// the address of the implementation as a variable, and the transaction that created the proxy.
+func DeployProxyJob(deploy *def.Deploy, do *def.DeployArgs, script *def.Playbook, client *def.Client,
+	txs []*payload.CallTx, contracts []*compilers.ResponseItem, logger *logging.Logger) (string, []*abi.Variable,
+	*payload.CallTx, error) {
+	if len(txs) != 1 {
+		return "", nil, nil, fmt.Errorf("a single contract must be deployed behind a proxy but %s has %d",
+			deploy.Contract, len(txs))
+	}
+	implementation, err := DeployJob(deploy, do, script, client, txs, contracts, logger)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	implementationAddress, err := crypto.AddressFromHexString(implementation)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	// The account deploying the proxy can upgrade it
+	code := proxy.Code(implementationAddress, txs[0].Input.Address)
+	logger.InfoMsg("Deploying proxy", "implementation", implementation)
+	tx, err := client.Call(&def.CallArg{
+		Input:  deploy.Source,
+		Amount: deploy.Amount,
+		Fee:    deploy.Fee,
+		Gas:    deploy.Gas,
+		Data:   hex.EncodeUpperToString(code),
+	}, logger)
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("could not formulate proxy deploy: %v", err)
+	}
+	mergeAbiSpecBytes(client, proxy.Abi)
+	proxyAddress, err := deployFinalize(do, client, tx, logger)
+	if err != nil {
+		return "", nil, tx, fmt.Errorf("Error finalizing proxy deploy for %s: %v", deploy.Contract, err)
+	}
+	// Calls to the proxy are encoded with the implementation's ABI
+	err = contracts[0].Contract.Save(script.BinPath, fmt.Sprintf("%s.bin", proxyAddress))
+	if err != nil {
+		return "", nil, tx, err
+	}
+	return proxyAddress.String(), []*abi.Variable{{Name: "implementation", Value: implementation}}, tx, nil
+}
+
+// FormulateUpgradeJob formulates the deployment of a new implementation for a proxy, having checked that its storage
+// layout is compatible with that of the current implementation
+func FormulateUpgradeJob(upgrade *def.Upgrade, do *def.DeployArgs, script *def.Playbook, client *def.Client,
+	intermediate interface{}, logger *logging.Logger) (*payload.CallTx, *compilers.ResponseItem, error) {
+	deploy := &def.Deploy{
+		Source:    upgrade.Source,
+		Contract:  upgrade.Contract,
+		Instance:  upgrade.Instance,
+		Libraries: upgrade.Libraries,
+		Fee:       upgrade.Fee,
+		Gas:       upgrade.Gas,
+		Sequence:  upgrade.Sequence,
+	}
+	txs, contracts, err := FormulateDeployJob(deploy, do, script, client, intermediate, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(txs) != 1 {
+		return nil, nil, fmt.Errorf("a single contract must be deployed to upgrade a proxy but %s has %d",
+			upgrade.Contract, len(txs))
+	}
+	upgrade.Source = deploy.Source
+	upgrade.Libraries = deploy.Libraries
+	upgrade.Fee = deploy.Fee
+	upgrade.Gas = deploy.Gas
+
+	if upgrade.SkipStorageCheck {
+		logger.InfoMsg("Skipping storage layout check", "proxy", upgrade.Proxy)
+	} else {
+		err = checkStorageLayout(upgrade.Proxy, &contracts[0].Contract, script, client)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return txs[0], contracts[0], nil
+}
+
+// UpgradeJob deploys the new implementation of a proxy and points the proxy at it, returning the address of the new
+// implementation and the transaction that upgraded the proxy
+func UpgradeJob(upgrade *def.Upgrade, do *def.DeployArgs, script *def.Playbook, client *def.Client,
+	tx *payload.CallTx, contract *compilers.ResponseItem, logger *logging.Logger) (string, *payload.CallTx, error) {
+	deploy := &def.Deploy{Contract: upgrade.Contract}
+	implementation, err := DeployJob(deploy, do, script, client, []*payload.CallTx{tx},
+		[]*compilers.ResponseItem{contract}, logger)
+	if err != nil {
+		return "", nil, err
+	}
+	implementationAddress, err := crypto.AddressFromHexString(implementation)
+	if err != nil {
+		return "", nil, err
+	}
+	data, err := proxy.UpgradeData(implementationAddress)
+	if err != nil {
+		return "", nil, err
+	}
+
+	logger.InfoMsg("Upgrading proxy", "proxy", upgrade.Proxy, "implementation", implementation)
+	upgradeTx, err := client.Call(&def.CallArg{
+		Input:   upgrade.Source,
+		Address: upgrade.Proxy,
+		Fee:     upgrade.Fee,
+		Gas:     upgrade.Gas,
+		Data:    hex.EncodeUpperToString(data),
+	}, logger)
+	if err != nil {
+		return "", nil, err
+	}
+	txe, err := client.SignAndBroadcast(upgradeTx, logger)
+	if err != nil {
+		return "", upgradeTx, util.ChainErrorHandler(payload.InputsString(upgradeTx.GetInputs()), err, logger)
+	}
+	if txe.Exception != nil {
+		reason, _ := abi.UnpackRevert(txe.GetResult().GetReturn())
+		if reason != nil {
+			return "", upgradeTx, fmt.Errorf("could not upgrade proxy %s: %s", upgrade.Proxy, *reason)
+		}
+		return "", upgradeTx, fmt.Errorf("could not upgrade proxy %s: %v", upgrade.Proxy, txe.Exception)
+	}
+	logEvents(txe, client, logger)
+
+	proxyAddress, err := crypto.AddressFromHexString(upgrade.Proxy)
+	if err != nil {
+		return "", upgradeTx, err
+	}
+	err = contract.Contract.Save(script.BinPath, fmt.Sprintf("%s.bin", proxyAddress))
+	if err != nil {
+		return "", upgradeTx, err
+	}
+	return implementation, upgradeTx, nil
+}
+
+// Checks that the next implementation of a proxy can use the storage written by its current implementation, whose
+// storage layout is read from the bin file saved when it was deployed
+func checkStorageLayout(proxyAddress string, next *compilers.SolidityContract, script *def.Playbook,
+	client *def.Client) error {
+	address, err := crypto.AddressFromHexString(proxyAddress)
+	if err != nil {
+		return err
+	}
+	slot, err := client.GetStorage(address, proxy.ImplementationSlot)
+	if err != nil {
+		return fmt.Errorf("could not read implementation of proxy %v: %v", address, err)
+	}
+	current := crypto.AddressFromWord256(slot)
+	if current == crypto.ZeroAddress {
+		return fmt.Errorf("%v is not a proxy deployed by a deploy job", address)
+	}
+	binFile := filepath.Join(script.BinPath, fmt.Sprintf("%s.bin", current))
+	prev, err := compilers.LoadSolidityContract(binFile)
+	if err != nil {
+		return fmt.Errorf("could not load the current implementation of proxy %v to check its storage layout: %v",
+			address, err)
+	}
+	if prev.StorageLayout == nil || next.StorageLayout == nil {
+		return fmt.Errorf("the storage layouts needed to check the upgrade of proxy %v are not known, compile both "+
+			"implementations with solc 0.5.13 or later or set skip-storage-check", address)
+	}
+	err = prev.StorageLayout.CheckUpgrade(next.StorageLayout)
+	if err != nil {
+		return fmt.Errorf("storage layout is not compatible with the current implementation of proxy %v: %v",
+			address, err)
+	}
+	return nil
+}
+
+// Parses libraries listed as contract:address separated by spaces or commas
+func parseLibraries(libraries string) (map[string]string, error) {
+	libs := make(map[string]string)
+	var list []string
+	if strings.Contains(libraries, " ") {
+		list = strings.Split(libraries, " ")
+	} else {
+		list = strings.Split(libraries, ",")
+	}
+	for _, l := range list {
+		if l != "" {
+			v := strings.Split(l, ":")
+			if len(v) != 2 {
+				return nil, fmt.Errorf("library %s should be contract:address format", l)
+			}
+			libs[v[0]] = v[1]
+		}
+	}
+	return libs, nil
+}
+
 func matchInstanceName(objectName, deployInstance string) bool {
 	if objectName == "" {
 		return false
@@ -381,10 +560,21 @@ func deployContract(deploy *def.Deploy, do *def.DeployArgs, script *def.Playbook
 		}
 	}
 
-	return deployTx(client, deploy, compilersResponse.Objectname, contractCode, logger)
+	return deployTx(client, deploy, compilersResponse.Objectname, contractCode, compilersResponse.Contract.Abi, logger)
 }
 
-func deployTx(client *def.Client, deploy *def.Deploy, contractName, contractCode string, logger *logging.Logger) (*payload.CallTx, error) {
+func deployTx(client *def.Client, deploy *def.Deploy, contractName, contractCode string, contractAbi []byte,
+	logger *logging.Logger) (*payload.CallTx, error) {
+	var contractMeta string
+	if deploy.StoreAbi {
+		contractMeta = string(contractAbi)
+	}
+	amount := deploy.Amount
+	if deploy.Proxy {
+		// Any amount is sent to the proxy instead
+		amount = "0"
+	}
+
 	// Deploy contract
 	logger.TraceMsg("Deploying Contract",
 		"contract", contractName,
@@ -393,12 +583,13 @@ func deployTx(client *def.Client, deploy *def.Deploy, contractName, contractCode
 		"chain", client.ChainAddress)
 
 	return client.Call(&def.CallArg{
-		Input:    deploy.Source,
-		Amount:   deploy.Amount,
-		Fee:      deploy.Fee,
-		Gas:      deploy.Gas,
-		Data:     contractCode,
-		Sequence: deploy.Sequence,
+		Input:        deploy.Source,
+		Amount:       amount,
+		Fee:          deploy.Fee,
+		Gas:          deploy.Gas,
+		Data:         contractCode,
+		Sequence:     deploy.Sequence,
+		ContractMeta: contractMeta,
 	}, logger)
 }
 
@@ -545,10 +736,6 @@ func deployFinalize(do *def.DeployArgs, client *def.Client, tx payload.Payload,
 }
 
 func logEvents(txe *exec.TxExecution, client *def.Client, logger *logging.Logger) {
-	if client.AllSpecs == nil {
-		return
-	}
-
 	for _, event := range txe.Events {
 		eventLog := event.GetLog()
 
@@ -556,37 +743,52 @@ func logEvents(txe *exec.TxExecution, client *def.Client, logger *logging.Logger
 			continue
 		}
 
-		var eventID abi.EventID
-		copy(eventID[:], eventLog.GetTopic(0).Bytes())
-
-		evAbi, ok := client.AllSpecs.EventsById[eventID]
-		if !ok {
-			logger.InfoMsg("Could not find ABI for Event", "Event ID", hex.EncodeUpperToString(eventID[:]))
+		evAbi, vals, err := decodeEvent(eventLog, client)
+		if err != nil {
+			logger.InfoMsg("Could not decode Event", "error", err)
 			continue
 		}
 
-		vals := make([]interface{}, len(evAbi.Inputs))
+		var fields []interface{}
+		fields = append(fields, "name")
+		fields = append(fields, evAbi.Name)
 		for i := range vals {
-			vals[i] = new(string)
+			fields = append(fields, evAbi.Inputs[i].Name)
+			fields = append(fields, vals[i])
 		}
+		logger.TraceMsg("EVM Event", fields...)
+	}
+}
 
-		if err := abi.UnpackEvent(&evAbi, eventLog.Topics, eventLog.Data, vals...); err == nil {
-			var fields []interface{}
-			fields = append(fields, "name")
-			fields = append(fields, evAbi.Name)
-			for i := range vals {
-				fields = append(fields, evAbi.Inputs[i].Name)
-				val := vals[i].(*string)
-				fields = append(fields, *val)
-			}
-			logger.TraceMsg("EVM Event", fields...)
-		}
+// decodeEvent decodes a Solidity event log using the ABIs seen by the client, returning the event's spec and the
+// values of its arguments rendered as strings
+func decodeEvent(eventLog *exec.LogEvent, client *def.Client) (*abi.EventSpec, []string, error) {
+	var eventID abi.EventID
+	copy(eventID[:], eventLog.GetTopic(0).Bytes())
+
+	evAbi, ok := client.EventSpec(eventID)
+	if !ok {
+		return nil, nil, fmt.Errorf("could not find ABI for event with ID %s", hex.EncodeUpperToString(eventID[:]))
+	}
+
+	vals := make([]interface{}, len(evAbi.Inputs))
+	for i := range vals {
+		vals[i] = new(string)
+	}
+	if err := abi.UnpackEvent(&evAbi, eventLog.Topics, eventLog.Data, vals...); err != nil {
+		return nil, nil, fmt.Errorf("could not unpack event %s: %v", evAbi.Name, err)
+	}
+
+	strs := make([]string, len(vals))
+	for i := range vals {
+		strs[i] = *vals[i].(*string)
 	}
+	return &evAbi, strs, nil
 }
 
 func mergeAbiSpecBytes(client *def.Client, bs []byte) {
 	spec, err := abi.ReadAbiSpec(bs)
 	if err == nil {
-		client.AllSpecs = abi.MergeAbiSpec([]*abi.AbiSpec{client.AllSpecs, spec})
+		client.MergeAbiSpec(spec)
 	}
 }
@@ -0,0 +1,150 @@
+package jobs
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/deploy/util"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/logging"
+)
+
+// IfJob runs the jobs of an if job when its condition holds and its else jobs otherwise. It returns whether the
+// condition held and the results of the jobs that ran as variables.
+func IfJob(name string, ifJob *def.If, args *def.DeployArgs, playbook *def.Playbook, client *def.Client,
+	state *deployState, locks *accountLocks, logger *logging.Logger) (string, []*abi.Variable, error) {
+	holds, err := relationHolds(ifJob.Key, ifJob.Relation, ifJob.Value)
+	if err != nil {
+		return "", nil, err
+	}
+	logger.InfoMsg("Condition",
+		"key", ifJob.Key,
+		"relation", ifJob.Relation,
+		"value", ifJob.Value,
+		"holds", holds)
+
+	branch := nestedPlaybook(playbook, ifJob.Jobs)
+	branchState := state.meta(name)
+	if !holds {
+		branch = nestedPlaybook(playbook, ifJob.Else)
+		branchState = branchState.meta("else")
+	}
+	err = doJobs(branch, args, client, branchState, locks, logger)
+	return strconv.FormatBool(holds), resultVariables(branch.Jobs, ""), err
+}
+
+// ForeachJob runs a copy of the jobs of a foreach job for each item, returning the number of items and the results of
+// the jobs run for item i as variables suffixed with _i
+func ForeachJob(name string, foreach *def.Foreach, args *def.DeployArgs, playbook *def.Playbook,
+	client *def.Client, state *deployState, locks *accountLocks, logger *logging.Logger) (string, []*abi.Variable, error) {
+	items, err := foreachItems(foreach, args, playbook, client, logger)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var variables []*abi.Variable
+	for i, item := range items {
+		logger.InfoMsg("Foreach item", "job", name, "index", i, foreach.Variable(), item.Result)
+		jobs := make([]*def.Job, len(foreach.Jobs))
+		for j, job := range foreach.Jobs {
+			jobs[j] = job.Copy()
+		}
+		// The item is held by an intermediate playbook so that the jobs can refer to it as a variable
+		iteration := nestedPlaybook(nestedPlaybook(playbook, []*def.Job{item}), jobs)
+		err = doJobs(iteration, args, client, state.meta(name).meta(strconv.Itoa(i)), locks, logger)
+		variables = append(variables, resultVariables(jobs, fmt.Sprintf("_%d", i))...)
+		if err != nil {
+			return "", variables, err
+		}
+	}
+	return strconv.Itoa(len(items)), variables, nil
+}
+
+// Returns a job holding each item as its result, and the values of items that are mappings as its variables
+func foreachItems(foreach *def.Foreach, args *def.DeployArgs, playbook *def.Playbook, client *def.Client,
+	logger *logging.Logger) ([]*def.Job, error) {
+	var values []interface{}
+	switch items := util.PreProcessInputValue(foreach.Items, args, playbook, client, logger).(type) {
+	case string:
+		if !strings.HasPrefix(items, "[") {
+			items = "[" + items + "]"
+		}
+		elements, err := abi.SplitList(items)
+		if err != nil {
+			return nil, fmt.Errorf("could not read foreach items: %v", err)
+		}
+		for _, e := range elements {
+			values = append(values, e)
+		}
+	case []interface{}:
+		values = items
+	default:
+		return nil, fmt.Errorf("foreach items should be a list, not %v", foreach.Items)
+	}
+
+	jobs := make([]*def.Job, len(values))
+	for i, value := range values {
+		result, err := resultString(value)
+		if err != nil {
+			return nil, err
+		}
+		jobs[i] = &def.Job{
+			Name:   foreach.Variable(),
+			Set:    &def.Set{Value: result},
+			Result: result,
+		}
+		if fields, ok := value.(map[string]interface{}); ok {
+			for key, field := range fields {
+				str, err := resultString(field)
+				if err != nil {
+					return nil, err
+				}
+				jobs[i].Variables = append(jobs[i].Variables, &abi.Variable{Name: key, Value: str})
+			}
+		}
+	}
+	return jobs, nil
+}
+
+// A playbook of nested jobs that shares the paths and account of the playbook containing them and can refer to the
+// results of its jobs
+func nestedPlaybook(playbook *def.Playbook, jobs []*def.Job) *def.Playbook {
+	return &def.Playbook{
+		Filename: playbook.Filename,
+		Account:  playbook.Account,
+		Jobs:     jobs,
+		Path:     playbook.Path,
+		BinPath:  playbook.BinPath,
+		Parent:   playbook,
+	}
+}
+
+func resultVariables(jobs []*def.Job, suffix string) []*abi.Variable {
+	var variables []*abi.Variable
+	for _, job := range jobs {
+		if job.Result == nil {
+			continue
+		}
+		result, err := resultString(job.Result)
+		if err != nil {
+			continue
+		}
+		variables = append(variables, &abi.Variable{Name: job.Name + suffix, Value: result})
+	}
+	return variables
+}
+
+// Results that are not strings are rendered as JSON, as they are when referred to as variables
+func resultString(result interface{}) (string, error) {
+	if str, ok := result.(string); ok {
+		return str, nil
+	}
+	bs, err := json.Marshal(result)
+	if err != nil {
+		return "", err
+	}
+	return string(bs), nil
+}
@@ -26,6 +26,9 @@ func FormulateUpdateAccountJob(gov *def.UpdateAccount, account string, client *d
 		Native:      gov.Native,
 		Roles:       gov.Roles,
 		Permissions: perms,
+		Signers:     gov.Signers,
+		Threshold:   gov.Threshold,
+		RotateKey:   gov.RotateKey,
 	}
 	newAccountMatch := def.NewKeyRegex.FindStringSubmatch(gov.Target)
 	if len(newAccountMatch) > 0 {
@@ -38,7 +41,7 @@ func FormulateUpdateAccountJob(gov *def.UpdateAccount, account string, client *d
 		arg.PublicKey = publicKey.String()
 	} else if len(gov.Target) == crypto.AddressHexLength {
 		arg.Address = gov.Target
-	} else {
+	} else if gov.Target != "" {
 		arg.PublicKey = gov.Target
 	}
 
@@ -173,6 +173,14 @@ func ProposalJob(prop *def.Proposal, do *def.DeployArgs, parentScript *def.Playb
 	}
 
 	proposal := payload.Proposal{Name: prop.Name, Description: prop.Description, BatchTx: &proposeBatch}
+	proposal.ExpiryHeight, err = client.ParseUint64(prop.ExpiryHeight)
+	if err != nil {
+		return "", err
+	}
+	proposal.Quorum, err = client.ParseUint64(prop.Quorum)
+	if err != nil {
+		return "", err
+	}
 
 	proposalInput, err := client.TxInput(prop.ProposalAddress, "", prop.ProposalSequence, false, logger)
 	if err != nil {
@@ -205,7 +213,7 @@ func ProposalJob(prop *def.Proposal, do *def.DeployArgs, parentScript *def.Playb
 			"votes", voteAddresses)
 
 		return "", err
-	} else if do.ProposeVote {
+	} else if do.ProposeVote || do.ProposeAgainst || do.ProposeAbstain || do.ProposeWithdraw {
 		ballot, err := client.GetProposal(proposalHash, logger)
 		if err != nil {
 			logger.InfoMsg("Proposal could not be found", "error", err)
@@ -224,10 +232,21 @@ func ProposalJob(prop *def.Proposal, do *def.DeployArgs, parentScript *def.Playb
 			return "", err
 		}
 
-		logger.InfoMsg("Voting for proposal", "hash", proposalHash)
-
 		h := binary.HexBytes(proposalHash)
 		proposalTx = &payload.ProposalTx{ProposalHash: &h, VotingWeight: 1, Input: input}
+		switch {
+		case do.ProposeWithdraw:
+			logger.InfoMsg("Withdrawing vote on proposal", "hash", proposalHash)
+			proposalTx.Withdraw = true
+		case do.ProposeAgainst:
+			logger.InfoMsg("Voting against proposal", "hash", proposalHash)
+			proposalTx.Choice = payload.Vote_AGAINST
+		case do.ProposeAbstain:
+			logger.InfoMsg("Abstaining on proposal", "hash", proposalHash)
+			proposalTx.Choice = payload.Vote_ABSTAIN
+		default:
+			logger.InfoMsg("Voting for proposal", "hash", proposalHash)
+		}
 	} else if do.ProposeCreate {
 		input, err := client.TxInput(FirstOf(prop.Source, parentScript.Account), "", prop.Sequence, true, logger)
 		if err != nil {
@@ -239,7 +258,8 @@ func ProposalJob(prop *def.Proposal, do *def.DeployArgs, parentScript *def.Playb
 		logger.TraceMsg("Proposal json", "json", string(bs))
 		proposalTx = &payload.ProposalTx{VotingWeight: 1, Input: input, Proposal: &proposal}
 	} else {
-		logger.InfoMsg("please specify one of --proposal-create, --proposal-vote, --proposal-verify")
+		logger.InfoMsg("please specify one of --proposal-create, --proposal-vote, --proposal-against, " +
+			"--proposal-abstain, --proposal-withdraw, --proposal-verify")
 		return "", nil
 	}
 
@@ -249,6 +269,8 @@ func ProposalJob(prop *def.Proposal, do *def.DeployArgs, parentScript *def.Playb
 		return "", err
 	}
 
+	// Proposals are not recorded in the deploy state so their receipts are not needed
+	client.TakeReceipt(proposalTx)
 	result := fmt.Sprintf("%X", txe.Receipt.TxHash)
 
 	return result, nil
@@ -2,14 +2,17 @@ package jobs
 
 import (
 	"fmt"
+	"math/big"
 	"strconv"
 
 	hex "github.com/tmthrgd/go-hex"
 
 	"github.com/hyperledger/burrow/deploy/def"
 	"github.com/hyperledger/burrow/deploy/util"
+	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/evm/abi"
 	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/txs/payload"
 )
 
 func QueryContractJob(query *def.QueryContract, do *def.DeployArgs, script *def.Playbook, client *def.Client, logger *logging.Logger) (string, []*abi.Variable, error) {
@@ -132,67 +135,195 @@ func AssertJob(assertion *def.Assert, logger *logging.Logger) (string, error) {
 		"relation", assertion.Relation,
 		"value", assertion.Value)
 
-	switch assertion.Relation {
+	holds, err := relationHolds(assertion.Key, assertion.Relation, assertion.Value)
+	if err != nil {
+		return "", err
+	}
+	if holds {
+		return assertPass(assertion.Relation, assertion.Key, assertion.Value, logger)
+	}
+	return assertFail(assertion.Relation, assertion.Key, assertion.Value, logger)
+}
+
+// relationHolds evaluates the relation of an assert or if job between key and value
+func relationHolds(key, relation, value string) (bool, error) {
+	switch relation {
 	case "==", "eq":
-		/*log.Debug("Compare", strings.Compare(assertion.Key, assertion.Value))
-		log.Debug("UTF8?: ", utf8.ValidString(assertion.Key))
-		log.Debug("UTF8?: ", utf8.ValidString(assertion.Value))
-		log.Debug("UTF8?: ", utf8.RuneCountInString(assertion.Key))
-		log.Debug("UTF8?: ", utf8.RuneCountInString(assertion.Value))*/
-		if assertion.Key == assertion.Value {
-			return assertPass("==", assertion.Key, assertion.Value, logger)
-		} else {
-			return assertFail("==", assertion.Key, assertion.Value, logger)
-		}
+		return key == value, nil
 	case "!=", "ne":
-		if assertion.Key != assertion.Value {
-			return assertPass("!=", assertion.Key, assertion.Value, logger)
-		} else {
-			return assertFail("!=", assertion.Key, assertion.Value, logger)
-		}
+		return key != value, nil
+	case ">", "gt", ">=", "ge", "<", "lt", "<=", "le":
+	default:
+		return false, fmt.Errorf("Error: Bad assert relation: \"%s\" is not a valid relation. See documentation for more information.", relation)
+	}
+
+	k, v, err := bulkConvert(key, value)
+	if err != nil {
+		_, err = convFail()
+		return false, err
+	}
+	switch relation {
 	case ">", "gt":
-		k, v, err := bulkConvert(assertion.Key, assertion.Value)
-		if err != nil {
-			return convFail()
-		}
-		if k > v {
-			return assertPass(">", assertion.Key, assertion.Value, logger)
-		} else {
-			return assertFail(">", assertion.Key, assertion.Value, logger)
-		}
+		return k > v, nil
 	case ">=", "ge":
-		k, v, err := bulkConvert(assertion.Key, assertion.Value)
+		return k >= v, nil
+	case "<", "lt":
+		return k < v, nil
+	default:
+		return k <= v, nil
+	}
+}
+
+func FormulateAssertRevertJob(assertion *def.AssertRevert, do *def.DeployArgs, playbook *def.Playbook, client *def.Client, logger *logging.Logger) (*payload.CallTx, error) {
+	return FormulateCallJob(&def.Call{
+		Source:      assertion.Source,
+		Destination: assertion.Destination,
+		Function:    assertion.Function,
+		Data:        assertion.Data,
+		Amount:      assertion.Amount,
+		Fee:         assertion.Fee,
+		Gas:         assertion.Gas,
+		Bin:         assertion.Bin,
+	}, do, playbook, client, logger)
+}
+
+func AssertRevertJob(assertion *def.AssertRevert, tx *payload.CallTx, client *def.Client, logger *logging.Logger) (string, error) {
+	txe, err := client.SignAndBroadcast(tx, logger)
+	if err != nil {
+		return "", util.ChainErrorHandler(payload.InputsString(tx.GetInputs()), err, logger)
+	}
+
+	if txe.Exception == nil {
+		return assertFail("reverts", assertion.Function, "succeeded", logger)
+	}
+	if txe.Exception.ErrorCode() != errors.ErrorCodeExecutionReverted {
+		return assertFail("reverts", assertion.Function, txe.Exception.Error(), logger)
+	}
+	var reason string
+	message, err := abi.UnpackRevert(txe.Result.Return)
+	if err != nil {
+		return "", err
+	}
+	if message != nil {
+		reason = *message
+	}
+	if assertion.Reason != "" && assertion.Reason != reason {
+		return assertFail("reverts with", assertion.Reason, reason, logger)
+	}
+	logger.InfoMsg("Assertion Succeeded",
+		"operation", "reverts",
+		"function", assertion.Function,
+		"reason", reason)
+	return reason, nil
+}
+
+func AssertEventsJob(assertion *def.AssertEvents, do *def.DeployArgs, playbook *def.Playbook, client *def.Client, logger *logging.Logger) (string, error) {
+	job := findJob(assertion.Job, playbook)
+	if job == nil {
+		return "", fmt.Errorf("could not find job %s to assert the events of", assertion.Job)
+	}
+	if len(job.TxHashes) == 0 {
+		return "", fmt.Errorf("job %s did not send any transactions so cannot have emitted events", assertion.Job)
+	}
+
+	// Decode every event emitted by the job's transactions
+	type emitted struct {
+		name string
+		args map[string]string
+	}
+	var events []emitted
+	for _, txHash := range job.TxHashes {
+		txe, err := client.GetTx(txHash, logger)
 		if err != nil {
-			return convFail()
+			return "", fmt.Errorf("could not get transaction %v of job %s: %v", txHash, assertion.Job, err)
 		}
-		if k >= v {
-			return assertPass(">=", assertion.Key, assertion.Value, logger)
-		} else {
-			return assertFail(">=", assertion.Key, assertion.Value, logger)
+		for _, event := range txe.Events {
+			eventLog := event.GetLog()
+			if eventLog == nil {
+				continue
+			}
+			evAbi, vals, err := decodeEvent(eventLog, client)
+			if err != nil {
+				logger.InfoMsg("Could not decode Event", "error", err)
+				continue
+			}
+			args := make(map[string]string, len(vals))
+			for i, val := range vals {
+				args[evAbi.Inputs[i].Name] = val
+			}
+			events = append(events, emitted{name: evAbi.Name, args: args})
 		}
-	case "<", "lt":
-		k, v, err := bulkConvert(assertion.Key, assertion.Value)
-		if err != nil {
-			return convFail()
+	}
+
+	// Match the expected events in order, allowing others in between
+	next := 0
+	for _, expected := range assertion.Events {
+		args := make(map[string]string, len(expected.Args))
+		for name, value := range expected.Args {
+			arg, err := util.PreProcess(fmt.Sprint(value), do, playbook, client, logger)
+			if err != nil {
+				return "", err
+			}
+			args[name] = arg
 		}
-		if k < v {
-			return assertPass("<", assertion.Key, assertion.Value, logger)
-		} else {
-			return assertFail("<", assertion.Key, assertion.Value, logger)
+		matched := false
+		for ; next < len(events) && !matched; next++ {
+			matched = events[next].name == expected.Name && matchEventArgs(args, events[next].args)
 		}
-	case "<=", "le":
-		k, v, err := bulkConvert(assertion.Key, assertion.Value)
-		if err != nil {
-			return convFail()
+		if !matched {
+			return assertFail("emits", assertion.Job, fmt.Sprintf("%s%v", expected.Name, args), logger)
 		}
-		if k <= v {
-			return assertPass("<=", assertion.Key, assertion.Value, logger)
-		} else {
-			return assertFail("<=", assertion.Key, assertion.Value, logger)
+	}
+	return assertPass("emits", assertion.Job, fmt.Sprintf("%d events", len(assertion.Events)), logger)
+}
+
+func matchEventArgs(expected, actual map[string]string) bool {
+	for name, value := range expected {
+		if actual[name] != value {
+			return false
 		}
-	default:
-		return "", fmt.Errorf("Error: Bad assert relation: \"%s\" is not a valid relation. See documentation for more information.", assertion.Relation)
 	}
+	return true
+}
+
+// findJob looks up a job by name in a playbook and those it was run from
+func findJob(name string, playbook *def.Playbook) *def.Job {
+	for ; playbook != nil; playbook = playbook.Parent {
+		for _, job := range playbook.Jobs {
+			if job.Name == name {
+				return job
+			}
+		}
+	}
+	return nil
+}
+
+func AssertBalanceJob(assertion *def.AssertBalance, client *def.Client, logger *logging.Logger) (string, error) {
+	address, err := client.GetKeyAddress(assertion.Account, logger)
+	if err != nil {
+		return "", err
+	}
+	acc, err := client.GetAccount(address)
+	if err != nil {
+		return "", err
+	}
+	if acc == nil {
+		return "", fmt.Errorf("account %s does not exist", assertion.Account)
+	}
+
+	before, ok := new(big.Int).SetString(assertion.Before, 10)
+	if !ok {
+		return "", fmt.Errorf("could not parse balance before %s as an integer", assertion.Before)
+	}
+	delta, ok := new(big.Int).SetString(assertion.Delta, 10)
+	if !ok {
+		return "", fmt.Errorf("could not parse balance delta %s as an integer", assertion.Delta)
+	}
+	actual := new(big.Int).Sub(new(big.Int).SetUint64(acc.Balance), before)
+	if actual.Cmp(delta) != 0 {
+		return assertFail("balance changed by", assertion.Delta, actual.String(), logger)
+	}
+	return assertPass("balance changed by", assertion.Delta, actual.String(), logger)
 }
 
 func bulkConvert(key, value string) (int, int, error) {
@@ -187,13 +187,15 @@ func FormulatePermissionJob(perm *def.Permission, account string, client *def.Cl
 
 	// Formulate tx
 	return client.Permissions(&def.PermArg{
-		Input:      perm.Source,
-		Sequence:   perm.Sequence,
-		Action:     perm.Action,
-		Target:     perm.Target,
-		Permission: perm.Permission,
-		Role:       perm.Role,
-		Value:      perm.Value,
+		Input:        perm.Source,
+		Sequence:     perm.Sequence,
+		Action:       perm.Action,
+		Target:       perm.Target,
+		Permission:   perm.Permission,
+		Role:         perm.Role,
+		Value:        perm.Value,
+		ExpiryHeight: perm.ExpiryHeight,
+		ExpiryTime:   perm.ExpiryTime,
 	}, logger)
 }
 
@@ -73,6 +73,8 @@ func ListProposals(args *def.DeployArgs, reqState ProposalState, logger *logging
 			state = "FAILED"
 		case payload.Ballot_EXECUTED:
 			state = "EXECUTED"
+		case payload.Ballot_EXPIRED:
+			state = "EXPIRED"
 		case payload.Ballot_PROPOSED:
 			if ProposalExpired(prop.Ballot.Proposal, client, logger) != nil {
 				state = "EXPIRED"
@@ -85,18 +87,35 @@ func ListProposals(args *def.DeployArgs, reqState ProposalState, logger *logging
 			continue
 		}
 
+		votes := make(map[payload.Vote_VoteChoice]int)
+		for _, vote := range prop.Ballot.GetVotes() {
+			votes[vote.Choice]++
+		}
 		logger.InfoMsg("Proposal",
 			"ProposalHash", fmt.Sprintf("%x", prop.Hash),
 			"Name", prop.Ballot.Proposal.Name,
 			"Description", prop.Ballot.Proposal.Description,
 			"State", state,
-			"Votes", len(prop.Ballot.GetVotes()))
+			"Votes", len(prop.Ballot.GetVotes()),
+			"For", votes[payload.Vote_FOR],
+			"Against", votes[payload.Vote_AGAINST],
+			"Abstain", votes[payload.Vote_ABSTAIN])
 	}
 
 	return nil
 }
 
 func ProposalExpired(proposal *payload.Proposal, client *def.Client, logger *logging.Logger) error {
+	if proposal.ExpiryHeight != 0 {
+		stat, err := client.Status(logger)
+		if err != nil {
+			return err
+		}
+		if stat.SyncInfo.LatestBlockHeight >= proposal.ExpiryHeight {
+			return fmt.Errorf("Proposal has expired at height %d", proposal.ExpiryHeight)
+		}
+	}
+
 	for _, input := range proposal.BatchTx.Inputs {
 		acc, err := client.GetAccount(input.Address)
 		if err != nil {
@@ -0,0 +1,133 @@
+// Package proxy provides an upgradeable proxy contract. The proxy delegates every call to an implementation contract
+// so that calls run the implementation's code against the proxy's storage. Its admin can swap the implementation for
+// another by calling upgradeTo(address), leaving the proxy's address and storage as they were.
+//
+// The implementation and admin addresses are held in the storage slots given by EIP-1967 so that they do not collide
+// with the implementation's own storage, and upgrades emit the EIP-1967 Upgraded event. The proxy is written in
+// assembly here rather than compiled from Solidity so that deploying it does not depend on solc.
+package proxy
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/crypto/sha3"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	. "github.com/hyperledger/burrow/execution/evm/asm"
+	. "github.com/hyperledger/burrow/execution/evm/asm/bc"
+)
+
+// Abi describes the functions and events of the proxy itself. Any function not listed here is delegated to the
+// implementation, so implementations should not define a function with the same signature as upgradeTo.
+var Abi = []byte(`[{"type":"function","name":"upgradeTo","inputs":[{"name":"implementation","type":"address"}],` +
+	`"outputs":[],"constant":false,"payable":false,"stateMutability":"nonpayable"},` +
+	`{"type":"event","name":"Upgraded","inputs":[{"name":"implementation","type":"address","indexed":true}],` +
+	`"anonymous":false}]`)
+
+// NotAdminReason is the revert reason given when an account other than the admin tries to upgrade the proxy
+const NotAdminReason = "caller is not the proxy admin"
+
+var (
+	// ImplementationSlot holds the address of the implementation
+	ImplementationSlot = eip1967Slot("eip1967.proxy.implementation")
+	// AdminSlot holds the address of the account allowed to upgrade the proxy
+	AdminSlot = eip1967Slot("eip1967.proxy.admin")
+	// Spec is the parsed Abi
+	Spec = mustReadSpec()
+	// The topic of the Upgraded event
+	upgradedTopic = binary.Word256(Spec.Events["Upgraded"].EventID)
+)
+
+// Code returns the code to deploy a proxy delegating to implementation that admin can upgrade
+func Code(implementation, admin crypto.Address) []byte {
+	runtime := runtimeCode()
+	initCode := func(runtimeOffset int) []byte {
+		return MustSplice(
+			PUSH20, implementation, PUSH32, ImplementationSlot, SSTORE,
+			PUSH20, admin, PUSH32, AdminSlot, SSTORE,
+			PUSH20, implementation, PUSH32, upgradedTopic, PUSH1, 0, DUP1, LOG2,
+			// Return the runtime code that follows
+			PUSH2, uint16Bytes(len(runtime)), DUP1, PUSH2, uint16Bytes(runtimeOffset), PUSH1, 0, CODECOPY,
+			PUSH1, 0, RETURN)
+	}
+	// The length of the init code does not depend on the offset it contains
+	return Concat(initCode(len(initCode(0))), runtime)
+}
+
+// UpgradeData returns the data of a call to the proxy that upgrades it to implementation
+func UpgradeData(implementation crypto.Address) ([]byte, error) {
+	data, _, err := Spec.Pack("upgradeTo", implementation)
+	return data, err
+}
+
+// The code run when the proxy is called
+func runtimeCode() []byte {
+	selector := MustSplice(PUSH1, 0, CALLDATALOAD, PUSH1, 0xE0, SHR)
+	// Delegate the call to the implementation, copying its return data into memory
+	delegate := MustSplice(
+		CALLDATASIZE, PUSH1, 0, PUSH1, 0, CALLDATACOPY,
+		PUSH1, 0, PUSH1, 0, CALLDATASIZE, PUSH1, 0, PUSH32, ImplementationSlot, SLOAD, GAS, DELEGATECALL,
+		RETURNDATASIZE, PUSH1, 0, PUSH1, 0, RETURNDATACOPY)
+	// Revert with whatever the implementation reverted with
+	reverted := MustSplice(RETURNDATASIZE, PUSH1, 0, REVERT)
+	returned := MustSplice(JUMPDEST, RETURNDATASIZE, PUSH1, 0, RETURN)
+	authorise := MustSplice(JUMPDEST, PUSH32, AdminSlot, SLOAD, CALLER, EQ)
+	notAdmin := revert(NotAdminReason)
+	upgrade := MustSplice(JUMPDEST,
+		PUSH1, 4, CALLDATALOAD, DUP1, PUSH32, ImplementationSlot, SSTORE,
+		PUSH32, upgradedTopic, PUSH1, 0, DUP1, LOG2, STOP)
+
+	jumpi := func(to int) []byte {
+		return MustSplice(PUSH2, uint16Bytes(to), JUMPI)
+	}
+	dispatch := func(to int) []byte {
+		return MustSplice(PUSH4, Spec.Functions["upgradeTo"].FunctionID, EQ, jumpi(to))
+	}
+	// Jump instructions have the same length whatever their destination
+	returnedAt := len(selector) + len(dispatch(0)) + len(delegate) + len(jumpi(0)) + len(reverted)
+	authoriseAt := returnedAt + len(returned)
+	upgradeAt := authoriseAt + len(authorise) + len(jumpi(0)) + len(notAdmin)
+	return Concat(selector, dispatch(authoriseAt), delegate, jumpi(returnedAt), reverted, returned,
+		authorise, jumpi(upgradeAt), notAdmin, upgrade)
+}
+
+// Reverts with reason, writing its ABI encoding into memory a word at a time
+func revert(reason string) []byte {
+	data, _, err := abi.RevertAbi.Pack("Error", reason)
+	if err != nil {
+		panic(fmt.Errorf("could not pack revert reason: %v", err))
+	}
+	var code []byte
+	for i := 0; i < len(data); i += binary.Word256Length {
+		word := binary.RightPadWord256(data[i:min(i+binary.Word256Length, len(data))])
+		code = MustSplice(code, PUSH32, word, PUSH2, uint16Bytes(i), MSTORE)
+	}
+	return MustSplice(code, PUSH2, uint16Bytes(len(data)), PUSH1, 0, REVERT)
+}
+
+// The storage slot EIP-1967 derives from name
+func eip1967Slot(name string) binary.Word256 {
+	hash := new(big.Int).SetBytes(sha3.Sha3([]byte(name)))
+	return binary.LeftPadWord256(hash.Sub(hash, big.NewInt(1)).Bytes())
+}
+
+func mustReadSpec() *abi.AbiSpec {
+	spec, err := abi.ReadAbiSpec(Abi)
+	if err != nil {
+		panic(fmt.Errorf("could not read proxy ABI: %v", err))
+	}
+	return spec
+}
+
+func uint16Bytes(n int) []byte {
+	return []byte{byte(n >> 8), byte(n)}
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
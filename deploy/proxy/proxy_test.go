@@ -0,0 +1,106 @@
+package proxy
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/evm"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/solidity"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/permission"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hex "github.com/tmthrgd/go-hex"
+)
+
+func TestSlots(t *testing.T) {
+	assert.Equal(t, "360894A13BA1A3210667C828492DB98DCA3E2076CC3735A920A3CA505D382BBC",
+		hex.EncodeUpperToString(ImplementationSlot.Bytes()))
+	assert.Equal(t, "B53127684A568B3173AE13B9F8A6016E243E63B6E8EE1178D6A717850B5D6103",
+		hex.EncodeUpperToString(AdminSlot.Bytes()))
+}
+
+// Reads unset storage as zero like the chain's state does
+type memoryState struct {
+	*acmstate.MemoryState
+}
+
+func (ms memoryState) GetStorage(address crypto.Address, key binary.Word256) (binary.Word256, error) {
+	return ms.Storage[address][key], nil
+}
+
+func TestProxy(t *testing.T) {
+	st := memoryState{acmstate.NewMemoryState()}
+	require.NoError(t, st.UpdateAccount(&acm.Account{
+		Address:     acm.GlobalPermissionsAddress,
+		Permissions: permission.DefaultAccountPermissions,
+	}))
+	cache := evm.NewState(st, func(height uint64) []byte { return nil })
+	vm := evm.NewVM(evm.Params{}, crypto.ZeroAddress, nil, logging.NewNoopLogger())
+	admin := crypto.Address{1}
+	other := crypto.Address{2}
+	spec, err := abi.ReadAbiSpec(solidity.Abi_ZeroReset)
+	require.NoError(t, err)
+
+	create := func(name string, code []byte) crypto.Address {
+		address := crypto.NewContractAddress(admin, []byte(name))
+		cache.CreateAccount(address)
+		gas := uint64(1000000)
+		runtime, err := vm.Call(cache, evm.NewNoopEventSink(), admin, address, code, nil, 0, &gas)
+		require.NoError(t, err)
+		cache.InitCode(address, runtime)
+		return address
+	}
+	// Runs each call in its own cache as a transaction would so that a revert does not affect later calls
+	call := func(txe *exec.TxExecution, caller, address crypto.Address, data []byte) ([]byte, error) {
+		txCache := cache.NewCache()
+		gas := uint64(1000000)
+		ret, err := vm.Call(txCache, txe, caller, address, cache.GetCode(address), data, 0, &gas)
+		if err != nil {
+			return ret, err
+		}
+		return ret, txCache.Sync()
+	}
+	callZeroReset := func(address crypto.Address, function string, args ...interface{}) []byte {
+		data, _, err := spec.Pack(function, args...)
+		require.NoError(t, err)
+		ret, err := call(new(exec.TxExecution), other, address, data)
+		require.NoError(t, err)
+		return ret
+	}
+
+	implementation := create("implementation", solidity.Bytecode_ZeroReset)
+	proxy := create("proxy", Code(implementation, admin))
+	assert.Equal(t, binary.LeftPadWord256(implementation.Bytes()), cache.GetStorage(proxy, ImplementationSlot))
+	assert.Equal(t, binary.LeftPadWord256(admin.Bytes()), cache.GetStorage(proxy, AdminSlot))
+
+	// Calls run the implementation against the proxy's storage
+	callZeroReset(proxy, "setUint", 42)
+	assert.Equal(t, binary.Int64ToWord256(42).Bytes(), callZeroReset(proxy, "getUint"))
+	assert.Equal(t, binary.Zero256, cache.GetStorage(implementation, binary.Int64ToWord256(1)))
+
+	// Only the admin can upgrade
+	next := create("next", solidity.Bytecode_ZeroReset)
+	data, err := UpgradeData(next)
+	require.NoError(t, err)
+	ret, err := call(new(exec.TxExecution), other, proxy, data)
+	require.Error(t, err)
+	reason, err := abi.UnpackRevert(ret)
+	require.NoError(t, err)
+	assert.Equal(t, NotAdminReason, *reason)
+
+	txe := new(exec.TxExecution)
+	_, err = call(txe, admin, proxy, data)
+	require.NoError(t, err)
+	assert.Equal(t, binary.LeftPadWord256(next.Bytes()), cache.GetStorage(proxy, ImplementationSlot))
+	require.NotNil(t, txe.Events[0].Log)
+	assert.Equal(t, []binary.Word256{upgradedTopic, binary.LeftPadWord256(next.Bytes())}, txe.Events[0].Log.Topics)
+
+	// Storage is kept across the upgrade
+	assert.Equal(t, binary.Int64ToWord256(42).Bytes(), callZeroReset(proxy, "getUint"))
+}
@@ -23,6 +23,7 @@ type playbookWork struct {
 type playbookResult struct {
 	jobNo    int
 	log      bytes.Buffer
+	plan     *def.Plan
 	err      error
 	duration time.Duration
 }
@@ -30,6 +31,7 @@ type playbookResult struct {
 func worker(playbooks <-chan playbookWork, results chan<- playbookResult, args *def.DeployArgs, logger *logging.Logger) {
 
 	client := def.NewClient(args.Chain, args.KeysService, args.MempoolSign, time.Duration(args.Timeout)*time.Second)
+	client.DryRun = args.DryRun
 
 	for playbook := range playbooks {
 		doWork := func(work playbookWork) (logBuf bytes.Buffer, err error) {
@@ -57,10 +59,12 @@ func worker(playbooks <-chan playbookWork, results chan<- playbookResult, args *
 			}
 
 			// Load existing bin files to decode events
-			var abiError error
-			client.AllSpecs, abiError = abi.LoadPath(script.BinPath)
-			if err != nil {
+			allSpecs, abiError := abi.LoadPath(script.BinPath)
+			if abiError != nil {
 				logger.InfoMsg("failed to load ABIs for Event parsing", "path", script.BinPath, "error", abiError)
+			} else {
+				// Merged under the client's lock since it is shared with the playbooks this worker has already run
+				client.MergeAbiSpec(allSpecs)
 			}
 
 			err = jobs.ExecutePlaybook(args, script, client, logger)
@@ -68,10 +72,21 @@ func worker(playbooks <-chan playbookWork, results chan<- playbookResult, args *
 		}
 
 		startTime := time.Now()
-		logBuf, err := doWork(playbook)
+		// Each playbook is planned against the chain's state rather than that left by the playbooks this worker has
+		// already dry-run, since which playbooks share a worker is arbitrary
+		err := client.ResetPlan()
+		var logBuf bytes.Buffer
+		if err == nil {
+			logBuf, err = doWork(playbook)
+		}
+		var plan *def.Plan
+		if err == nil && args.DryRun {
+			plan, err = client.Plan()
+		}
 		results <- playbookResult{
 			jobNo:    playbook.jobNo,
 			log:      logBuf,
+			plan:     plan,
 			err:      err,
 			duration: time.Since(startTime),
 		}
@@ -120,6 +135,9 @@ func RunPlaybooks(args *def.DeployArgs, playbooks []string, logger *logging.Logg
 			if res.err != nil {
 				fmt.Fprintf(os.Stderr, "ERROR: %v", res.err)
 			}
+			if res.plan != nil {
+				fmt.Fprintf(os.Stdout, "Plan for %s:\n%v\n", playbooks[printed], res.plan)
+			}
 			res.log.Truncate(0)
 			if jobResult.err != nil {
 				failures++
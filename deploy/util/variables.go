@@ -221,39 +221,64 @@ func PreProcessInputData(function string, data interface{}, do *def.DeployArgs,
 		}
 		val := reflect.ValueOf(data)
 		for i := 0; i < val.Len(); i++ {
-			s := val.Index(i)
-			var newString string
-			switch s.Interface().(type) {
-			case bool:
-				newString = strconv.FormatBool(s.Interface().(bool))
-			case int, int32, int64:
-				newString = strconv.FormatInt(int64(s.Interface().(int)), 10)
-			case []interface{}:
-				var args []string
-				for _, index := range s.Interface().([]interface{}) {
-					value := reflect.ValueOf(index)
-					var stringified string
-					switch value.Kind() {
-					case reflect.Int:
-						stringified = strconv.FormatInt(value.Int(), 10)
-					case reflect.String:
-						stringified = value.String()
-					}
-					index, _ = PreProcess(stringified, do, script, client, logger)
-					args = append(args, stringified)
-				}
-				newString = "[" + strings.Join(args, ",") + "]"
-				logger.TraceMsg(newString)
-			default:
-				newString = s.Interface().(string)
-			}
-			newString, _ = PreProcess(newString, do, script, client, logger)
-			callDataArray = append(callDataArray, newString)
+			callDataArray = append(callDataArray, PreProcessInputValue(val.Index(i).Interface(), do, script, client,
+				logger))
 		}
 	}
 	return function, callDataArray, nil
 }
 
+// PreProcessInputValue replaces variables in a value given in a playbook, such as an argument to pass to the ABI
+// packer. Scalars are returned as strings, lists of scalars as strings in brackets and lists containing mappings
+// (such as arrays of structs) as slices. Mappings, used for struct arguments, are returned as maps keyed by field
+// name.
+func PreProcessInputValue(value interface{}, do *def.DeployArgs, script *def.Playbook, client *def.Client,
+	logger *logging.Logger) interface{} {
+	switch v := value.(type) {
+	case bool:
+		return strconv.FormatBool(v)
+	case int:
+		return strconv.Itoa(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case string:
+		output, _ := PreProcess(v, do, script, client, logger)
+		return output
+	case []interface{}:
+		elements := make([]interface{}, len(v))
+		strs := make([]string, len(v))
+		scalar := true
+		for i, e := range v {
+			elements[i] = PreProcessInputValue(e, do, script, client, logger)
+			str, ok := elements[i].(string)
+			strs[i] = str
+			scalar = scalar && ok
+		}
+		if !scalar {
+			return elements
+		}
+		newString := "[" + strings.Join(strs, ",") + "]"
+		logger.TraceMsg(newString)
+		return newString
+	case map[string]interface{}:
+		fields := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			fields[k] = PreProcessInputValue(e, do, script, client, logger)
+		}
+		return fields
+	case map[interface{}]interface{}:
+		fields := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			fields[fmt.Sprint(k)] = PreProcessInputValue(e, do, script, client, logger)
+		}
+		return fields
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
 func PreProcessLibs(libs string, do *def.DeployArgs, script *def.Playbook, client *def.Client, logger *logging.Logger) (string, error) {
 	libraries, _ := PreProcess(libs, do, script, client, logger)
 	if libraries != "" {
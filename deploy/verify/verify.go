@@ -0,0 +1,107 @@
+// Package verify checks that the code of a deployed contract was compiled from the sources recorded for it when it
+// was deployed.
+package verify
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+
+	"github.com/hyperledger/burrow/crypto/sha3"
+	"github.com/hyperledger/burrow/deploy/compile"
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/logging"
+	hex "github.com/tmthrgd/go-hex"
+)
+
+type Match int
+
+const (
+	// The code is identical to that compiled from the sources
+	Exact Match = iota + 1
+	// The code differs only in the hash of its metadata, as when sources differ only in comments or whitespace
+	Partial
+)
+
+func (m Match) String() string {
+	switch m {
+	case Exact:
+		return "exact match"
+	case Partial:
+		return "partial match"
+	}
+	return "no match"
+}
+
+// Contract recompiles the sources in workDir that the contract was recorded as compiled from and checks the result
+// against code, the contract's runtime code on chain. The solc recorded is looked for in solcDir and on the path.
+func Contract(record *def.ContractState, code []byte, workDir, solcDir string, logger *logging.Logger) (Match, error) {
+	err := checkSources(record, workDir)
+	if err != nil {
+		return 0, err
+	}
+	compiler := &compile.Compiler{
+		Version:       record.Compiler,
+		Optimize:      record.Optimize,
+		OptimizerRuns: record.OptimizerRuns,
+		Remappings:    record.Remappings,
+		SolcDir:       solcDir,
+	}
+	resp, err := compiler.Compile(record.Source, workDir, nil, logger)
+	if err != nil {
+		return 0, err
+	}
+	if resp.Version != record.Compiler {
+		return 0, fmt.Errorf("%s was compiled with solc %s but only solc %s is installed", record.Contract,
+			record.Compiler, resp.Version)
+	}
+	if resp.Error != "" {
+		return 0, fmt.Errorf("could not compile %s: %s", record.Source, resp.Error)
+	}
+	for _, object := range resp.Objects {
+		if object.Objectname != record.Contract {
+			continue
+		}
+		err = object.Contract.LinkDeployed(record.Libraries)
+		if err != nil {
+			return 0, err
+		}
+		compiled, err := hex.DecodeString(object.Contract.Evm.DeployedBytecode.Object)
+		if err != nil {
+			return 0, fmt.Errorf("could not decode code compiled for %s: %v", record.Contract, err)
+		}
+		if bytes.Equal(compiled, code) {
+			return Exact, nil
+		}
+		if bytes.Equal(compile.StripMetadataHash(compiled), compile.StripMetadataHash(code)) {
+			return Partial, nil
+		}
+		return 0, fmt.Errorf("code of %s differs from that compiled from %s", record.Contract, record.Source)
+	}
+	return 0, fmt.Errorf("could not find contract %s in %s", record.Contract, record.Source)
+}
+
+// Checks the sources are unchanged since the contract was deployed so that there is no point compiling them if not
+func checkSources(record *def.ContractState, workDir string) error {
+	paths := make([]string, 0, len(record.SourceHashes))
+	for path := range record.SourceHashes {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		file := path
+		if !filepath.IsAbs(file) {
+			file = filepath.Join(workDir, file)
+		}
+		source, err := ioutil.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("could not read source %s: %v", path, err)
+		}
+		if !bytes.Equal(sha3.Sha3(source), record.SourceHashes[path]) {
+			return fmt.Errorf("source %s has changed since %s was deployed", path, record.Contract)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package verify
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto/sha3"
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContract_ChangedSource(t *testing.T) {
+	dir, err := ioutil.TempDir("", "verify")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	source := []byte("pragma solidity >=0.0.0;\ncontract Storage {}\n")
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "storage.sol"), source, 0644))
+	record := &def.ContractState{
+		Source:       "storage.sol",
+		Contract:     "Storage",
+		SourceHashes: map[string]binary.HexBytes{"storage.sol": sha3.Sha3(source)},
+	}
+	require.NoError(t, checkSources(record, dir))
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "storage.sol"), append(source, '\n'), 0644))
+	_, err = Contract(record, nil, dir, "", logging.NewNoopLogger())
+	assert.EqualError(t, err, "source storage.sol has changed since Storage was deployed")
+
+	record.SourceHashes["lib.sol"] = nil
+	_, err = Contract(record, nil, dir, "", logging.NewNoopLogger())
+	assert.Contains(t, err.Error(), "could not read source lib.sol")
+}
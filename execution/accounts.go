@@ -37,10 +37,6 @@ func NewAccounts(reader acmstate.Reader, keyClient keys.KeyClient, mutexCount in
 	}
 }
 func (accs *Accounts) SigningAccount(address crypto.Address) (*SigningAccount, error) {
-	signer, err := keys.AddressableSigner(accs.keyClient, address)
-	if err != nil {
-		return nil, err
-	}
 	account, err := accs.GetAccount(address)
 	if err != nil {
 		return nil, err
@@ -51,7 +47,16 @@ func (accs *Accounts) SigningAccount(address crypto.Address) (*SigningAccount, e
 			Address: address,
 		}
 	}
-	pubKey, err := accs.keyClient.PublicKey(address)
+	// Sign with the key the account's key has been rotated to if it has been
+	keyAddress := address
+	if account.PublicKey.IsSet() {
+		keyAddress = account.PublicKey.GetAddress()
+	}
+	signer, err := keys.AddressableSigner(accs.keyClient, keyAddress)
+	if err != nil {
+		return nil, err
+	}
+	pubKey, err := accs.keyClient.PublicKey(keyAddress)
 	if err != nil {
 		return nil, err
 	}
@@ -18,6 +18,13 @@ import (
 // TODO: make configurable
 const GasLimit = uint64(1000000)
 
+const (
+	// The largest metadata a CallTx may store alongside the contract it creates
+	MaxContractMetaLength = 1 << 16
+	// Gas used for each byte of contract metadata stored, taken from that left over from creating the contract
+	GasContractMetaByte = uint64(1)
+)
+
 type CallContext struct {
 	StateWriter acmstate.ReaderWriter
 	RunCall     bool
@@ -75,14 +82,23 @@ func (ctx *CallContext) Precheck() (*acm.Account, *acm.Account, error) {
 	// Calling a nil destination is defined as requesting contract creation
 	createContract := ctx.tx.Address == nil
 
+	if ctx.tx.ContractMeta != "" {
+		if !createContract {
+			return nil, nil, errors.ErrorCodef(errors.ErrorCodeIllegalWrite,
+				"ContractMeta can only be stored by a CallTx creating a contract")
+		}
+		if len(ctx.tx.ContractMeta) > MaxContractMetaLength {
+			return nil, nil, errors.ErrorCodef(errors.ErrorCodeInvalidString,
+				"ContractMeta of %d bytes is longer than the maximum of %d", len(ctx.tx.ContractMeta),
+				MaxContractMetaLength)
+		}
+	}
+
 	if createContract {
-		if !hasCreateContractPermission(ctx.StateWriter, inAcc, ctx.Logger) {
+		if !hasCreateContractPermission(ctx.StateWriter, ctx.Blockchain, inAcc, ctx.Logger) {
 			return nil, nil, fmt.Errorf("account %s does not have CreateContract permission", ctx.tx.Input.Address)
 		}
 	} else {
-		if !hasCallPermission(ctx.StateWriter, inAcc, ctx.Logger) {
-			return nil, nil, fmt.Errorf("account %s does not have Call permission", ctx.tx.Input.Address)
-		}
 		// check if its a native contract
 		if evm.IsRegisteredNativeContract(*ctx.tx.Address) {
 			return nil, nil, errors.ErrorCodef(errors.ErrorCodeReservedAddress,
@@ -100,6 +116,13 @@ func (ctx *CallContext) Precheck() (*acm.Account, *acm.Account, error) {
 		if err != nil {
 			return nil, nil, err
 		}
+		if !canCall(ctx.StateWriter, ctx.Blockchain, inAcc, outAcc, ctx.tx.Data, ctx.Logger) {
+			if outAcc != nil && outAcc.Permissions.HasCallACL() {
+				return nil, nil, fmt.Errorf("account %s is not granted a call to %s by its call ACL",
+					ctx.tx.Input.Address, outAcc.Address)
+			}
+			return nil, nil, fmt.Errorf("account %s does not have Call permission", ctx.tx.Input.Address)
+		}
 	}
 
 	err = ctx.StateWriter.UpdateAccount(inAcc)
@@ -188,6 +211,9 @@ func (ctx *CallContext) Deliver(inAcc, outAcc *acm.Account, value uint64) error
 	logger := ctx.Logger.With(structure.TxHashKey, txHash)
 	vmach := evm.NewVM(params, caller, txHash, logger, ctx.VMOptions...)
 	ret, exception := vmach.Call(txCache, ctx.txe, caller, callee, code, ctx.tx.Data, value, &gas)
+	if exception == nil && createContract && ctx.tx.ContractMeta != "" {
+		exception = useContractMetaGas(len(ctx.tx.ContractMeta), &gas)
+	}
 	if exception != nil {
 		// Failure. Charge the gas fee. The 'value' was otherwise not transferred.
 		ctx.Logger.InfoMsg("Error on execution",
@@ -204,6 +230,11 @@ func (ctx *CallContext) Deliver(inAcc, outAcc *acm.Account, value uint64) error
 		if err != nil {
 			return err
 		}
+		if createContract && ctx.tx.ContractMeta != "" {
+			if err := ctx.storeContractMeta(callee); err != nil {
+				return err
+			}
+		}
 	}
 	ctx.CallEvents(exception)
 	ctx.txe.Return(ret, ctx.tx.GasLimit-gas)
@@ -216,6 +247,30 @@ func (ctx *CallContext) Deliver(inAcc, outAcc *acm.Account, value uint64) error
 	return nil
 }
 
+// Uses the gas for storing contract metadata of length bytes, failing the creation of the contract if there is not enough
+func useContractMetaGas(length int, gas *uint64) errors.CodedError {
+	metaGas := uint64(length) * GasContractMetaByte
+	if *gas < metaGas {
+		return errors.ErrorCodef(errors.ErrorCodeInsufficientGas,
+			"storing %d bytes of contract metadata needs %d gas but only %d is left", length, metaGas, *gas)
+	}
+	*gas -= metaGas
+	return nil
+}
+
+// Store the metadata provided by the CallTx alongside the code of the newly created contract
+func (ctx *CallContext) storeContractMeta(address crypto.Address) error {
+	acc, err := ctx.StateWriter.GetAccount(address)
+	if err != nil {
+		return err
+	}
+	if acc == nil {
+		return fmt.Errorf("could not find created contract %v to store its metadata", address)
+	}
+	acc.ContractMeta = ctx.tx.ContractMeta
+	return ctx.StateWriter.UpdateAccount(acc)
+}
+
 func (ctx *CallContext) CallEvents(err error) {
 	// Fire Events for sender and receiver a separate event will be fired from vm for each additional call
 	ctx.txe.Input(ctx.tx.Input.Address, errors.AsException(err))
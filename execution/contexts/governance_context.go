@@ -17,8 +17,9 @@ import (
 )
 
 type GovernanceContext struct {
+	Blockchain   Blockchain
 	StateWriter  acmstate.ReaderWriter
-	ValidatorSet validator.Alterer
+	ValidatorSet validator.ReaderAlterer
 	Logger       *logging.Logger
 	tx           *payload.GovTx
 	txe          *exec.TxExecution
@@ -39,9 +40,9 @@ func (ctx *GovernanceContext) Execute(txe *exec.TxExecution, p payload.Payload)
 		return err
 	}
 
-	// ensure all inputs have root permissions
-	err = allHavePermission(ctx.StateWriter, permission.Root, accounts, ctx.Logger)
-	if err != nil {
+	// ensure all inputs have root permissions, unless they are only rotating their own keys
+	err = allHavePermission(ctx.StateWriter, ctx.Blockchain, permission.Root, accounts, ctx.Logger)
+	if err != nil && !onlyRotatesOwnKeys(ctx.tx) {
 		return errors.Wrap(err, "at least one input lacks permission for GovTx")
 	}
 
@@ -50,30 +51,45 @@ func (ctx *GovernanceContext) Execute(txe *exec.TxExecution, p payload.Payload)
 	}
 
 	for _, update := range ctx.tx.AccountUpdates {
+		if update.Address == nil && update.PublicKey == nil && update.Multisig != nil {
+			// A new multisig account
+			address := update.Multisig.Address()
+			update.Address = &address
+		}
 		if update.Address == nil && update.PublicKey == nil {
 			// We do not want to generate a key
 			return fmt.Errorf("could not execution GovTx since account template %v contains neither "+
 				"address or public key", update)
 		}
+		if update.RotateKey {
+			governAccountEvent, err := ctx.RotateKey(update)
+			if err != nil {
+				txe.GovernAccount(governAccountEvent, errors.AsException(err))
+				return err
+			}
+			txe.GovernAccount(governAccountEvent, nil)
+			continue
+		}
+		keyFromState := update.PublicKey == nil
 		if update.PublicKey == nil {
 			update.PublicKey, err = ctx.MaybeGetPublicKey(*update.Address)
 			if err != nil {
 				return err
 			}
 		}
-		// Check address
-		if update.PublicKey != nil {
+		// Check address, unless the key is the account's own, which will not match its address if it has been rotated
+		if update.PublicKey != nil && !keyFromState {
 			address := update.PublicKey.GetAddress()
 			if update.Address != nil && address != *update.Address {
 				return fmt.Errorf("supplied public key %v whose address %v does not match %v provided by"+
 					"GovTx", update.PublicKey, address, update.Address)
 			}
 			update.Address = &address
-		} else if update.Balances().HasPower() {
+		} else if update.PublicKey == nil && update.Balances().HasPower() {
 			// If we are updating power we will need the key
 			return fmt.Errorf("GovTx must be provided with public key when updating validator power")
 		}
-		account, err := getOrMakeOutput(ctx.StateWriter, accounts, *update.Address, ctx.Logger)
+		account, err := getOrMakeOutput(ctx.StateWriter, ctx.Blockchain, accounts, *update.Address, ctx.Logger)
 		if err != nil {
 			return err
 		}
@@ -114,6 +130,18 @@ func (ctx *GovernanceContext) UpdateAccount(account *acm.Account, update *spec.T
 			return ev, err
 		}
 	}
+	if update.Multisig != nil {
+		if len(update.Multisig.PublicKeys) == 0 && update.Multisig.Threshold == 0 {
+			// An empty multisig returns the account to being signed for by its own key
+			account.Multisig = nil
+		} else {
+			err = update.Multisig.Validate()
+			if err != nil {
+				return ev, err
+			}
+			account.Multisig = update.Multisig
+		}
+	}
 	if update.Code != nil {
 		account.Code = *update.Code
 		if err != nil {
@@ -138,6 +166,55 @@ func (ctx *GovernanceContext) UpdateAccount(account *acm.Account, update *spec.T
 	return
 }
 
+// RotateKey replaces the public key of an existing account, keeping its address, balance, permissions and code
+func (ctx *GovernanceContext) RotateKey(update *spec.TemplateAccount) (ev *exec.GovernAccountEvent, err error) {
+	ev = &exec.GovernAccountEvent{
+		AccountUpdate: update,
+	}
+	if update.Address == nil || update.PublicKey == nil {
+		return ev, fmt.Errorf("GovTx must be provided with both address and new public key to rotate an account's key")
+	}
+	if !update.PublicKey.IsValid() {
+		return ev, fmt.Errorf("cannot rotate key of account %v to invalid public key %v", update.Address,
+			update.PublicKey)
+	}
+	if !onlyRotatesKey(update) {
+		return ev, fmt.Errorf("GovTx rotating the key of account %v cannot also update the account", update.Address)
+	}
+	account, err := ctx.StateWriter.GetAccount(*update.Address)
+	if err != nil {
+		return ev, err
+	}
+	if account == nil {
+		return ev, fmt.Errorf("cannot rotate key of account %v since it does not exist", update.Address)
+	}
+	if account.Multisig != nil {
+		return ev, fmt.Errorf("cannot rotate key of multisig account %v, update its multisig keys instead",
+			update.Address)
+	}
+	// A validator is identified by its key so rotating it would leave the power with the old key
+	addresses := []crypto.Address{account.Address}
+	if account.PublicKey.IsSet() {
+		addresses = append(addresses, account.PublicKey.GetAddress())
+	}
+	for _, address := range addresses {
+		power, err := ctx.ValidatorSet.Power(address)
+		if err != nil {
+			return ev, err
+		}
+		if power.Sign() != 0 {
+			return ev, fmt.Errorf("cannot rotate key of account %v since it is a validator with power %v, "+
+				"remove its power first", update.Address, power)
+		}
+	}
+	ctx.Logger.InfoMsg("Rotating account key",
+		"account", account.Address,
+		"old_public_key", account.PublicKey,
+		"new_public_key", update.PublicKey)
+	account.PublicKey = *update.PublicKey
+	return ev, ctx.StateWriter.UpdateAccount(account)
+}
+
 func (ctx *GovernanceContext) MaybeGetPublicKey(address crypto.Address) (*crypto.PublicKey, error) {
 	// First try state in case chain has received input previously
 	acc, err := ctx.StateWriter.GetAccount(address)
@@ -150,3 +227,22 @@ func (ctx *GovernanceContext) MaybeGetPublicKey(address crypto.Address) (*crypto
 	}
 	return nil, nil
 }
+
+// An account without root permission may rotate its own key with a GovTx it signs itself
+func onlyRotatesOwnKeys(tx *payload.GovTx) bool {
+	inputs := make(map[crypto.Address]bool)
+	for _, in := range tx.Inputs {
+		inputs[in.Address] = true
+	}
+	for _, update := range tx.AccountUpdates {
+		if !update.RotateKey || update.Address == nil || !inputs[*update.Address] || !onlyRotatesKey(update) {
+			return false
+		}
+	}
+	return len(tx.AccountUpdates) > 0
+}
+
+func onlyRotatesKey(update *spec.TemplateAccount) bool {
+	return update.NodeAddress == nil && len(update.Amounts) == 0 && len(update.Permissions) == 0 &&
+		len(update.Roles) == 0 && update.Code == nil && update.Multisig == nil
+}
@@ -19,7 +19,7 @@ var regexpAlphaNum = regexp.MustCompile("^[a-zA-Z0-9._/-@]*$")
 var regexpJSON = regexp.MustCompile(`^[a-zA-Z0-9_/ \-+"':,\n\t.{}()\[\]]*$`)
 
 type NameContext struct {
-	Blockchain  BlockchainHeight
+	Blockchain  Blockchain
 	StateWriter acmstate.ReaderWriter
 	NameReg     names.ReaderWriter
 	Logger      *logging.Logger
@@ -43,7 +43,7 @@ func (ctx *NameContext) Execute(txe *exec.TxExecution, p payload.Payload) error
 		return errors.ErrorCodeInvalidAddress
 	}
 	// check permission
-	if !hasNamePermission(ctx.StateWriter, inAcc, ctx.Logger) {
+	if !hasNamePermission(ctx.StateWriter, ctx.Blockchain, inAcc, ctx.Logger) {
 		return fmt.Errorf("account %s does not have Name permission", ctx.tx.Input.Address)
 	}
 	if ctx.tx.Input.Amount < ctx.tx.Fee {
@@ -14,6 +14,7 @@ import (
 )
 
 type PermissionsContext struct {
+	Blockchain  Blockchain
 	StateWriter acmstate.ReaderWriter
 	Logger      *logging.Logger
 	tx          *payload.PermsTx
@@ -43,7 +44,7 @@ func (ctx *PermissionsContext) Execute(txe *exec.TxExecution, p payload.Payload)
 
 	permFlag := ctx.tx.PermArgs.Action
 	// check permission
-	if !HasPermission(ctx.StateWriter, inAcc, permFlag, ctx.Logger) {
+	if !HasPermission(ctx.StateWriter, ctx.Blockchain, inAcc, permFlag, ctx.Logger) {
 		return fmt.Errorf("account %s does not have moderator permission %s (%b)", ctx.tx.Input.Address,
 			permFlag.String(), permFlag)
 	}
@@ -61,11 +62,18 @@ func (ctx *PermissionsContext) Execute(txe *exec.TxExecution, p payload.Payload)
 	case permission.SetBase:
 		permAcc, err = mutatePermissions(ctx.StateWriter, *ctx.tx.PermArgs.Target,
 			func(perms *permission.AccountPermissions) error {
+				if ctx.tx.PermArgs.ExpiryHeight != nil || ctx.tx.PermArgs.ExpiryTime != nil {
+					blockHeight, blockTime := nextBlock(ctx.Blockchain)
+					perms.AddGrant(ctx.tx.PermArgs.Grant(nil), blockHeight, blockTime)
+					return nil
+				}
+				perms.RevokeGrants(*ctx.tx.PermArgs.Permission)
 				return perms.Base.Set(*ctx.tx.PermArgs.Permission, *ctx.tx.PermArgs.Value)
 			})
 	case permission.UnsetBase:
 		permAcc, err = mutatePermissions(ctx.StateWriter, *ctx.tx.PermArgs.Target,
 			func(perms *permission.AccountPermissions) error {
+				perms.RevokeGrants(*ctx.tx.PermArgs.Permission)
 				return perms.Base.Unset(*ctx.tx.PermArgs.Permission)
 			})
 	case permission.SetGlobal:
@@ -93,6 +101,37 @@ func (ctx *PermissionsContext) Execute(txe *exec.TxExecution, p payload.Payload)
 				}
 				return nil
 			})
+	case permission.GrantCall:
+		permAcc, err = mutatePermissions(ctx.StateWriter, *ctx.tx.PermArgs.Target,
+			func(perms *permission.AccountPermissions) error {
+				if !perms.AddCallGrant(ctx.tx.PermArgs.CallGrant()) {
+					return fmt.Errorf("call grant (%v) already exists for account %s",
+						ctx.tx.PermArgs.CallGrant(), *ctx.tx.PermArgs.Target)
+				}
+				return nil
+			})
+	case permission.RevokeCall:
+		permAcc, err = mutatePermissions(ctx.StateWriter, *ctx.tx.PermArgs.Target,
+			func(perms *permission.AccountPermissions) error {
+				if !perms.RemoveCallGrant(ctx.tx.PermArgs.CallGrant()) {
+					return fmt.Errorf("call grant (%v) does not exist for account %s",
+						ctx.tx.PermArgs.CallGrant(), *ctx.tx.PermArgs.Target)
+				}
+				return nil
+			})
+	case permission.CanCall:
+		return fmt.Errorf("CanCall is for contracts, not humans. Just look at the blockchain")
+	case permission.Delegate:
+		err = ctx.ensureDelegable(inAcc, *ctx.tx.PermArgs.Permission)
+		if err != nil {
+			return err
+		}
+		permAcc, err = mutatePermissions(ctx.StateWriter, *ctx.tx.PermArgs.Target,
+			func(perms *permission.AccountPermissions) error {
+				blockHeight, blockTime := nextBlock(ctx.Blockchain)
+				perms.AddGrant(ctx.tx.PermArgs.Grant(&inAcc.Address), blockHeight, blockTime)
+				return nil
+			})
 	default:
 		return fmt.Errorf("invalid permission function: %v", permFlag)
 	}
@@ -125,6 +164,24 @@ func (ctx *PermissionsContext) Execute(txe *exec.TxExecution, p payload.Payload)
 	return nil
 }
 
+// An account may only delegate permissions it holds through its own or global base permissions, so that delegated
+// permissions cannot be delegated again. Delegated grants are not revoked when the delegating account later loses the
+// permission, they last until their expiry unless the permission is set or unset on the account holding them.
+func (ctx *PermissionsContext) ensureDelegable(acc *acm.Account, perms permission.PermFlag) error {
+	base := acc.Permissions.Base.Compose(acmstate.GlobalAccountPermissions(ctx.StateWriter).Base)
+	for perm := permission.PermFlag(1); perm <= perms; perm <<= 1 {
+		if perms&perm == 0 {
+			continue
+		}
+		v, err := base.Get(perm)
+		if err != nil || !v {
+			return fmt.Errorf("account %s cannot delegate permission %s (%b) it does not hold", acc.Address,
+				perm.String(), perm)
+		}
+	}
+	return nil
+}
+
 func mutatePermissions(stateReader acmstate.Reader, address crypto.Address,
 	mutator func(*permission.AccountPermissions) error) (*acm.Account, error) {
 
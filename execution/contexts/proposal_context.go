@@ -21,6 +21,8 @@ import (
 type ProposalContext struct {
 	ChainID           string
 	ProposalThreshold uint64
+	ProposalQuorum    uint64
+	Blockchain        Blockchain
 	StateWriter       acmstate.ReaderWriter
 	ValidatorSet      validator.Writer
 	ProposalReg       proposal.ReaderWriter
@@ -48,7 +50,7 @@ func (ctx *ProposalContext) Execute(txe *exec.TxExecution, p payload.Payload) er
 	}
 
 	// check permission
-	if !hasProposalPermission(ctx.StateWriter, inAcc, ctx.Logger) {
+	if !hasProposalPermission(ctx.StateWriter, ctx.Blockchain, inAcc, ctx.Logger) {
 		return fmt.Errorf("account %s does not have Proposal permission", ctx.tx.Input.Address)
 	}
 
@@ -66,8 +68,11 @@ func (ctx *ProposalContext) Execute(txe *exec.TxExecution, p payload.Payload) er
 		if err != nil {
 			return err
 		}
+		if ballot == nil {
+			return errors.ErrorCodef(errors.ErrorCodeInvalidProposal, "no proposal with hash %X", proposalHash)
+		}
 	} else {
-		if ctx.tx.ProposalHash != nil || ctx.tx.Proposal.BatchTx == nil ||
+		if ctx.tx.ProposalHash != nil || ctx.tx.Withdraw || ctx.tx.Proposal.BatchTx == nil ||
 			len(ctx.tx.Proposal.BatchTx.Txs) == 0 || len(ctx.tx.Proposal.BatchTx.GetInputs()) == 0 {
 			return errors.ErrorCodeInvalidProposal
 		}
@@ -85,26 +90,58 @@ func (ctx *ProposalContext) Execute(txe *exec.TxExecution, p payload.Payload) er
 		}
 
 		if ballot == nil {
+			if ctx.expired(ctx.tx.Proposal) {
+				return errors.ErrorCodef(errors.ErrorCodeExpiredProposal,
+					"cannot create proposal that expired at height %d", ctx.tx.Proposal.ExpiryHeight)
+			}
 			ballot = &payload.Ballot{
 				Proposal:      ctx.tx.Proposal,
 				ProposalState: payload.Ballot_PROPOSED,
 			}
+			txe.Proposal(&exec.ProposalEvent{ProposalHash: proposalHash, ProposalState: ballot.ProposalState})
 		}
 
 		// else vote for existing proposal
 	}
 
-	// Check that we have not voted this already
-	for _, vote := range ballot.Votes {
-		for _, i := range ctx.tx.GetInputs() {
-			if i.Address == vote.Address {
+	switch ballot.ProposalState {
+	case payload.Ballot_PROPOSED:
+	case payload.Ballot_EXPIRED:
+		return errors.ErrorCodef(errors.ErrorCodeExpiredProposal, "proposal expired at height %d",
+			ballot.Proposal.ExpiryHeight)
+	default:
+		return errors.ErrorCodeProposalExecuted
+	}
+
+	// The executor expires proposals at the end of the block at their expiry height, but a late vote is never counted
+	if ctx.expired(ballot.Proposal) {
+		return errors.ErrorCodef(errors.ErrorCodeExpiredProposal, "proposal expired at height %d",
+			ballot.Proposal.ExpiryHeight)
+	}
+
+	if ctx.tx.Withdraw {
+		remaining := make([]*payload.Vote, 0, len(ballot.Votes))
+		for _, vote := range ballot.Votes {
+			if !ctx.isInput(vote.Address) {
+				remaining = append(remaining, vote)
+			}
+		}
+		if len(remaining) == len(ballot.Votes) {
+			return errors.ErrorCodef(errors.ErrorCodeInvalidProposal, "%v has no vote to withdraw",
+				ctx.tx.Input.Address)
+		}
+		ballot.Votes = remaining
+	} else {
+		// Check that we have not voted this already
+		for _, vote := range ballot.Votes {
+			if ctx.isInput(vote.Address) {
 				return errors.ErrorCodeAlreadyVoted
 			}
 		}
 	}
 
 	// count votes for proposal
-	votes := make(map[crypto.Address]int64)
+	votes := make(map[crypto.Address]*payload.Vote)
 
 	if ballot.Votes == nil {
 		ballot.Votes = make([]*payload.Vote, 0)
@@ -116,10 +153,10 @@ func (ctx *ProposalContext) Execute(txe *exec.TxExecution, p payload.Payload) er
 			return err
 		}
 		// Belt and braces, should have already been checked
-		if !hasProposalPermission(ctx.StateWriter, acc, ctx.Logger) {
+		if !hasProposalPermission(ctx.StateWriter, ctx.Blockchain, acc, ctx.Logger) {
 			return fmt.Errorf("account %s does not have Proposal permission", ctx.tx.Input.Address)
 		}
-		votes[v.Address] = v.VotingWeight
+		votes[v.Address] = v
 	}
 
 	for _, i := range ballot.Proposal.BatchTx.GetInputs() {
@@ -135,7 +172,7 @@ func (ctx *ProposalContext) Execute(txe *exec.TxExecution, p payload.Payload) er
 			return errors.ErrorCodeInvalidAddress
 		}
 
-		if !hasBatchPermission(ctx.StateWriter, proposeAcc, ctx.Logger) {
+		if !hasBatchPermission(ctx.StateWriter, ctx.Blockchain, proposeAcc, ctx.Logger) {
 			return fmt.Errorf("account %s does not have batch permission", i.Address)
 		}
 
@@ -146,20 +183,33 @@ func (ctx *ProposalContext) Execute(txe *exec.TxExecution, p payload.Payload) er
 
 	for _, i := range ctx.tx.GetInputs() {
 		// Do we have a record of our own vote
-		if _, ok := votes[i.Address]; !ok {
-			votes[i.Address] = ctx.tx.VotingWeight
-			ballot.Votes = append(ballot.Votes, &payload.Vote{Address: i.Address, VotingWeight: ctx.tx.VotingWeight})
+		if _, ok := votes[i.Address]; !ok && !ctx.tx.Withdraw {
+			vote := &payload.Vote{Address: i.Address, VotingWeight: ctx.tx.VotingWeight, Choice: ctx.tx.Choice}
+			votes[i.Address] = vote
+			ballot.Votes = append(ballot.Votes, vote)
 		}
 	}
 
-	// Count the number of validators; ensure we have at least half the number of validators
-	// This also means that when running with a single validator, a proposal will run straight away
-	var power uint64
+	// Count the number of accounts voting each way; when running with a single validator, a proposal will run straight
+	// away
+	var votesFor, votesAgainst, votesAbstain uint64
 	for _, v := range votes {
-		if v > 0 {
-			power++
+		if v.VotingWeight <= 0 {
+			continue
+		}
+		switch v.Choice {
+		case payload.Vote_FOR:
+			votesFor++
+		case payload.Vote_AGAINST:
+			votesAgainst++
+		case payload.Vote_ABSTAIN:
+			votesAbstain++
 		}
 	}
+	quorum := ctx.ProposalQuorum
+	if ballot.Proposal.Quorum > quorum {
+		quorum = ballot.Proposal.Quorum
+	}
 
 	stateCache := acmstate.NewCache(ctx.StateWriter)
 
@@ -182,7 +232,9 @@ func (ctx *ProposalContext) Execute(txe *exec.TxExecution, p payload.Payload) er
 		}
 	}
 
-	if power >= ctx.ProposalThreshold {
+	// A proposal passes with enough votes for it, a quorum of votes cast and more votes for than against it
+	if votesFor >= ctx.ProposalThreshold && votesFor+votesAgainst+votesAbstain >= quorum &&
+		votesFor > votesAgainst {
 		ballot.ProposalState = payload.Ballot_EXECUTED
 
 		txe.TxExecutions = make([]*exec.TxExecution, 0)
@@ -235,11 +287,26 @@ func (ctx *ProposalContext) Execute(txe *exec.TxExecution, p payload.Payload) er
 				break
 			}
 		}
+		txe.Proposal(&exec.ProposalEvent{ProposalHash: proposalHash, ProposalState: ballot.ProposalState})
 	}
 
 	return ctx.ProposalReg.UpdateProposal(proposalHash, ballot)
 }
 
+// Whether the current block is past the proposal's expiry height
+func (ctx *ProposalContext) expired(proposal *payload.Proposal) bool {
+	return proposal.ExpiryHeight != 0 && ctx.Blockchain.LastBlockHeight() >= proposal.ExpiryHeight
+}
+
+func (ctx *ProposalContext) isInput(address crypto.Address) bool {
+	for _, i := range ctx.tx.GetInputs() {
+		if i.Address == address {
+			return true
+		}
+	}
+	return false
+}
+
 func validateProposalStrings(proposal *payload.Proposal) error {
 	if len(proposal.Name) == 0 {
 		return errors.ErrorCodef(errors.ErrorCodeInvalidString, "name must not be empty")
@@ -12,6 +12,7 @@ import (
 )
 
 type SendContext struct {
+	Blockchain  Blockchain
 	StateWriter acmstate.ReaderWriter
 	Logger      *logging.Logger
 	tx          *payload.SendTx
@@ -29,14 +30,14 @@ func (ctx *SendContext) Execute(txe *exec.TxExecution, p payload.Payload) error
 	}
 
 	// ensure all inputs have send permissions
-	err = allHavePermission(ctx.StateWriter, permission.Send, accounts, ctx.Logger)
+	err = allHavePermission(ctx.StateWriter, ctx.Blockchain, permission.Send, accounts, ctx.Logger)
 	if err != nil {
 		return errors.Wrap(err, "at least one input lacks permission for SendTx")
 	}
 
 	// add outputs to accounts map
 	// if any outputs don't exist, all inputs must have CreateAccount perm
-	accounts, err = getOrMakeOutputs(ctx.StateWriter, accounts, ctx.tx.Outputs, ctx.Logger)
+	accounts, err = getOrMakeOutputs(ctx.StateWriter, ctx.Blockchain, accounts, ctx.tx.Outputs, ctx.Logger)
 	if err != nil {
 		return err
 	}
@@ -43,7 +43,7 @@ func getInputs(accountGetter acmstate.AccountGetter, ins []*payload.TxInput) (ma
 	return accounts, total, nil
 }
 
-func getOrMakeOutputs(accountGetter acmstate.AccountGetter, accs map[crypto.Address]*acm.Account,
+func getOrMakeOutputs(accountGetter acmstate.AccountGetter, blockchain Blockchain, accs map[crypto.Address]*acm.Account,
 	outs []*payload.TxOutput, logger *logging.Logger) (map[crypto.Address]*acm.Account, error) {
 	if accs == nil {
 		accs = make(map[crypto.Address]*acm.Account)
@@ -51,7 +51,7 @@ func getOrMakeOutputs(accountGetter acmstate.AccountGetter, accs map[crypto.Addr
 	// we should err if an account is being created but the inputs don't have permission
 	var err error
 	for _, out := range outs {
-		accs[out.Address], err = getOrMakeOutput(accountGetter, accs, out.Address, logger)
+		accs[out.Address], err = getOrMakeOutput(accountGetter, blockchain, accs, out.Address, logger)
 		if err != nil {
 			return nil, err
 		}
@@ -59,7 +59,7 @@ func getOrMakeOutputs(accountGetter acmstate.AccountGetter, accs map[crypto.Addr
 	return accs, nil
 }
 
-func getOrMakeOutput(accountGetter acmstate.AccountGetter, accs map[crypto.Address]*acm.Account,
+func getOrMakeOutput(accountGetter acmstate.AccountGetter, blockchain Blockchain, accs map[crypto.Address]*acm.Account,
 	outputAddress crypto.Address, logger *logging.Logger) (*acm.Account, error) {
 
 	// Account shouldn't be duplicated
@@ -72,7 +72,7 @@ func getOrMakeOutput(accountGetter acmstate.AccountGetter, accs map[crypto.Addre
 	}
 	// output account may be nil (new)
 	if acc == nil {
-		if !hasCreateAccountPermission(accountGetter, accs, logger) {
+		if !hasCreateAccountPermission(accountGetter, blockchain, accs, logger) {
 			return nil, fmt.Errorf("at least one input does not have permission to create accounts")
 		}
 		logger.InfoMsg("Account not found so attempting to create it", "address", outputAddress)
@@ -131,8 +131,10 @@ func adjustByOutputs(accs map[crypto.Address]*acm.Account, outs []*payload.TxOut
 
 //---------------------------------------------------------------
 
-// Get permission on an account or fall back to global value
-func HasPermission(accountGetter acmstate.AccountGetter, acc *acm.Account, perm permission.PermFlag, logger *logging.Logger) bool {
+// Get permission on an account or fall back to global value, where the account's permission grants count until they
+// expire at the block being executed
+func HasPermission(accountGetter acmstate.AccountGetter, blockchain Blockchain, acc *acm.Account,
+	perm permission.PermFlag, logger *logging.Logger) bool {
 	if perm > permission.AllPermFlags {
 		logger.InfoMsg(
 			fmt.Sprintf("HasPermission called on invalid permission 0b%b (invalid) > 0b%b (maximum) ",
@@ -142,7 +144,9 @@ func HasPermission(accountGetter acmstate.AccountGetter, acc *acm.Account, perm
 		return false
 	}
 
-	v, err := acc.Permissions.Base.Compose(acmstate.GlobalAccountPermissions(accountGetter).Base).Get(perm)
+	blockHeight, blockTime := nextBlock(blockchain)
+	v, err := acc.Permissions.BaseAt(blockHeight, blockTime).
+		Compose(acmstate.GlobalAccountPermissions(accountGetter).Base).Get(perm)
 	if err != nil {
 		logger.TraceMsg("Error obtaining permission value (will default to false/deny)",
 			"perm_flag", perm.String(),
@@ -161,10 +165,10 @@ func HasPermission(accountGetter acmstate.AccountGetter, acc *acm.Account, perm
 	return v
 }
 
-func allHavePermission(accountGetter acmstate.AccountGetter, perm permission.PermFlag,
+func allHavePermission(accountGetter acmstate.AccountGetter, blockchain Blockchain, perm permission.PermFlag,
 	accs map[crypto.Address]*acm.Account, logger *logging.Logger) error {
 	for _, acc := range accs {
-		if !HasPermission(accountGetter, acc, perm, logger) {
+		if !HasPermission(accountGetter, blockchain, acc, perm, logger) {
 			return errors.PermissionDenied{
 				Address: acc.Address,
 				Perm:    perm,
@@ -174,59 +178,80 @@ func allHavePermission(accountGetter acmstate.AccountGetter, perm permission.Per
 	return nil
 }
 
-func hasProposalPermission(accountGetter acmstate.AccountGetter, acc *acm.Account,
+func hasProposalPermission(accountGetter acmstate.AccountGetter, blockchain Blockchain, acc *acm.Account,
 	logger *logging.Logger) bool {
-	return HasPermission(accountGetter, acc, permission.Proposal, logger)
+	return HasPermission(accountGetter, blockchain, acc, permission.Proposal, logger)
 }
 
-func hasInputPermission(accountGetter acmstate.AccountGetter, acc *acm.Account,
+func hasInputPermission(accountGetter acmstate.AccountGetter, blockchain Blockchain, acc *acm.Account,
 	logger *logging.Logger) bool {
-	return HasPermission(accountGetter, acc, permission.Input, logger)
+	return HasPermission(accountGetter, blockchain, acc, permission.Input, logger)
 }
 
-func hasBatchPermission(accountGetter acmstate.AccountGetter, acc *acm.Account,
+func hasBatchPermission(accountGetter acmstate.AccountGetter, blockchain Blockchain, acc *acm.Account,
 	logger *logging.Logger) bool {
-	return HasPermission(accountGetter, acc, permission.Batch, logger)
+	return HasPermission(accountGetter, blockchain, acc, permission.Batch, logger)
 }
 
-func hasNamePermission(accountGetter acmstate.AccountGetter, acc *acm.Account,
+func hasNamePermission(accountGetter acmstate.AccountGetter, blockchain Blockchain, acc *acm.Account,
 	logger *logging.Logger) bool {
-	return HasPermission(accountGetter, acc, permission.Name, logger)
+	return HasPermission(accountGetter, blockchain, acc, permission.Name, logger)
 }
 
-func hasCallPermission(accountGetter acmstate.AccountGetter, acc *acm.Account,
+func hasCallPermission(accountGetter acmstate.AccountGetter, blockchain Blockchain, acc *acm.Account,
 	logger *logging.Logger) bool {
-	return HasPermission(accountGetter, acc, permission.Call, logger)
+	return HasPermission(accountGetter, blockchain, acc, permission.Call, logger)
 }
 
-func hasCreateContractPermission(accountGetter acmstate.AccountGetter, acc *acm.Account,
+// A callee with a call ACL may only be called by the accounts and role holders it grants (which need not have the Call
+// permission), any other callee by accounts with the Call permission
+func canCall(accountGetter acmstate.AccountGetter, blockchain Blockchain, acc, callee *acm.Account, input []byte,
 	logger *logging.Logger) bool {
-	return HasPermission(accountGetter, acc, permission.CreateContract, logger)
+	if callee == nil || !callee.Permissions.HasCallACL() {
+		return hasCallPermission(accountGetter, blockchain, acc, logger)
+	}
+	permitted := callee.Permissions.PermitsCall(acc.Address, acc.Permissions, input)
+	logger.TraceMsg("Checked call ACL",
+		"account_address", acc.Address,
+		"callee_address", callee.Address,
+		"permitted", permitted)
+	return permitted
 }
 
-func hasCreateAccountPermission(accountGetter acmstate.AccountGetter, accs map[crypto.Address]*acm.Account,
+func hasCreateContractPermission(accountGetter acmstate.AccountGetter, blockchain Blockchain, acc *acm.Account,
 	logger *logging.Logger) bool {
+	return HasPermission(accountGetter, blockchain, acc, permission.CreateContract, logger)
+}
+
+func hasCreateAccountPermission(accountGetter acmstate.AccountGetter, blockchain Blockchain,
+	accs map[crypto.Address]*acm.Account, logger *logging.Logger) bool {
 	for _, acc := range accs {
-		if !HasPermission(accountGetter, acc, permission.CreateAccount, logger) {
+		if !HasPermission(accountGetter, blockchain, acc, permission.CreateAccount, logger) {
 			return false
 		}
 	}
 	return true
 }
 
-func hasBondPermission(accountGetter acmstate.AccountGetter, acc *acm.Account,
+func hasBondPermission(accountGetter acmstate.AccountGetter, blockchain Blockchain, acc *acm.Account,
 	logger *logging.Logger) bool {
-	return HasPermission(accountGetter, acc, permission.Bond, logger)
+	return HasPermission(accountGetter, blockchain, acc, permission.Bond, logger)
 }
 
-func hasBondOrSendPermission(accountGetter acmstate.AccountGetter, accs map[crypto.Address]*acm.Account,
-	logger *logging.Logger) bool {
+func hasBondOrSendPermission(accountGetter acmstate.AccountGetter, blockchain Blockchain,
+	accs map[crypto.Address]*acm.Account, logger *logging.Logger) bool {
 	for _, acc := range accs {
-		if !HasPermission(accountGetter, acc, permission.Bond, logger) {
-			if !HasPermission(accountGetter, acc, permission.Send, logger) {
+		if !HasPermission(accountGetter, blockchain, acc, permission.Bond, logger) {
+			if !HasPermission(accountGetter, blockchain, acc, permission.Send, logger) {
 				return false
 			}
 		}
 	}
 	return true
 }
+
+// The height and time (as a Unix time in seconds) against which the expiry of permission grants is checked, which are
+// those the EVM sees for the block being executed
+func nextBlock(blockchain Blockchain) (uint64, int64) {
+	return blockchain.LastBlockHeight() + 1, blockchain.LastBlockTime().Unix()
+}
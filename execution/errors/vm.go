@@ -21,6 +21,23 @@ func (err PermissionDenied) Error() string {
 	return fmt.Sprintf("Account/contract %v does not have permission %v", err.Address, err.Perm)
 }
 
+type CallNotGranted struct {
+	Caller crypto.Address
+	Callee crypto.Address
+}
+
+func (err CallNotGranted) ErrorCode() Code {
+	return ErrorCodePermissionDenied
+}
+
+func (err CallNotGranted) Error() string {
+	return fmt.Sprintf("Account/contract %v is not granted a call to %v by its call ACL", err.Caller, err.Callee)
+}
+
+func (err CallNotGranted) String() string {
+	return err.Error()
+}
+
 type NestedCallError struct {
 	CodedError
 	Caller     crypto.Address
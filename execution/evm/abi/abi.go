@@ -140,7 +140,7 @@ func (e EVMUint) getGoType() interface{} {
 func (e EVMUint) pack(v interface{}) ([]byte, error) {
 	n := new(big.Int)
 
-	arg := reflect.ValueOf(v)
+	arg := reflect.ValueOf(bigIntString(v))
 	switch arg.Kind() {
 	case reflect.String:
 		_, ok := n.SetString(arg.String(), 0)
@@ -190,6 +190,17 @@ func (e EVMUint) pack(v interface{}) ([]byte, error) {
 	return pad(b, ElementSize, true), nil
 }
 
+// Big integers are packed from their decimal string representation
+func bigIntString(v interface{}) interface{} {
+	switch v := v.(type) {
+	case *big.Int:
+		return v.String()
+	case big.Int:
+		return v.String()
+	}
+	return v
+}
+
 func (e EVMUint) unpack(data []byte, offset int, v interface{}) (int, error) {
 	if len(data)-offset < ElementSize {
 		return 0, fmt.Errorf("not enough data")
@@ -300,7 +311,7 @@ func (e EVMInt) GetSignature() string {
 func (e EVMInt) pack(v interface{}) ([]byte, error) {
 	n := new(big.Int)
 
-	arg := reflect.ValueOf(v)
+	arg := reflect.ValueOf(bigIntString(v))
 	switch arg.Kind() {
 	case reflect.String:
 		_, ok := n.SetString(arg.String(), 0)
@@ -444,6 +455,12 @@ func (e EVMInt) unpack(data []byte, offset int, v interface{}) (int, error) {
 			return 0, fmt.Errorf("value to large for uint16")
 		}
 		*v = int16(binary.BigEndian.Uint16(data[ElementSize-maxLen : ElementSize]))
+	case *int8:
+		maxLen := 1
+		if length > maxLen || (inv[ElementSize-maxLen]&0x80) != 0 {
+			return 0, fmt.Errorf("value to large for int8")
+		}
+		*v = int8(data[ElementSize-1])
 	default:
 		return 0, fmt.Errorf("unable to convert %s to %s", e.GetSignature(), toType)
 	}
@@ -473,26 +490,23 @@ func (e EVMAddress) GetSignature() string {
 }
 
 func (e EVMAddress) pack(v interface{}) ([]byte, error) {
+	var a crypto.Address
 	var err error
-	a, ok := v.(crypto.Address)
-	if !ok {
-		s, ok := v.(string)
-		if ok {
-			a, err = crypto.AddressFromHexString(s)
-			if err != nil {
-				return nil, err
-			}
-		}
-	} else {
-		b, ok := v.([]byte)
-		if !ok {
-			return nil, fmt.Errorf("cannot map to %s to EVM address", reflect.ValueOf(v).Kind().String())
+	switch v := v.(type) {
+	case crypto.Address:
+		a = v
+	case string:
+		a, err = crypto.AddressFromHexString(v)
+		if err != nil {
+			return nil, err
 		}
-
-		a, err = crypto.AddressFromBytes(b)
+	case []byte:
+		a, err = crypto.AddressFromBytes(v)
 		if err != nil {
 			return nil, err
 		}
+	default:
+		return nil, fmt.Errorf("cannot map to %s to EVM address", reflect.ValueOf(v).Kind().String())
 	}
 
 	return pad(a[:], ElementSize, true), nil
@@ -573,6 +587,9 @@ func (e EVMBytes) unpack(data []byte, offset int, v interface{}) (int, error) {
 		return s.unpack(data, offset, v)
 	}
 
+	if len(data)-offset < ElementSize {
+		return 0, fmt.Errorf("not enough data")
+	}
 	v2 := reflect.ValueOf(v).Elem()
 	switch v2.Type().Kind() {
 	case reflect.String:
@@ -587,7 +604,7 @@ func (e EVMBytes) unpack(data []byte, offset int, v interface{}) (int, error) {
 		}
 		v2.SetString(string(data[offset+start : offset+end]))
 	case reflect.Array:
-		fallthrough
+		reflect.Copy(v2, reflect.ValueOf(data[offset:offset+int(e.M)]))
 	case reflect.Slice:
 		v2.SetBytes(data[offset : offset+int(e.M)])
 	default:
@@ -634,18 +651,21 @@ func (e EVMString) pack(v interface{}) ([]byte, error) {
 
 func (e EVMString) unpack(data []byte, offset int, v interface{}) (int, error) {
 	lenType := EVMInt{M: 64}
-	var len int64
-	l, err := lenType.unpack(data, offset, &len)
+	var length int64
+	l, err := lenType.unpack(data, offset, &length)
 	if err != nil {
 		return 0, err
 	}
 	offset += l
+	if length < 0 || length > int64(len(data)-offset) {
+		return 0, fmt.Errorf("length %d of EVM %s out of range", length, e.GetSignature())
+	}
 
 	switch v := v.(type) {
 	case *string:
-		*v = string(data[offset : offset+int(len)])
+		*v = string(data[offset : offset+int(length)])
 	case *[]byte:
-		*v = data[offset : offset+int(len)]
+		*v = data[offset : offset+int(length)]
 	default:
 		return 0, fmt.Errorf("cannot map EVM string to %s", reflect.ValueOf(v).Kind().String())
 	}
@@ -697,6 +717,301 @@ func (e EVMFixed) Dynamic() bool {
 	return false
 }
 
+var _ EVMType = (*EVMArray)(nil)
+
+// EVMArray is an array type used as the element type of an argument that is an array of arrays. The outermost
+// dimension of an argument is described by its IsArray and ArrayLength fields.
+type EVMArray struct {
+	Element EVMType
+	// Zero for dynamically sized arrays
+	Length uint64
+}
+
+func (e EVMArray) GetSignature() string {
+	if e.Length > 0 {
+		return fmt.Sprintf("%s[%d]", e.Element.GetSignature(), e.Length)
+	}
+	return e.Element.GetSignature() + "[]"
+}
+
+func (e EVMArray) getGoType() interface{} {
+	return reflect.New(reflect.SliceOf(reflect.TypeOf(e.Element.getGoType()))).Interface()
+}
+
+func (e EVMArray) pack(v interface{}) ([]byte, error) {
+	values, err := listValues(v)
+	if err != nil {
+		return nil, fmt.Errorf("cannot map to %s: %v", e.GetSignature(), err)
+	}
+	if e.Length > 0 && uint64(len(values)) != e.Length {
+		return nil, fmt.Errorf("%s should have %d elements, not %d", e.GetSignature(), e.Length, len(values))
+	}
+	p, err := packTuple(e.types(len(values)), func(i int) interface{} {
+		return values[i]
+	})
+	if err != nil {
+		return nil, err
+	}
+	if e.Length > 0 {
+		return p, nil
+	}
+	length, err := EVMUint{M: 256}.pack(len(values))
+	if err != nil {
+		return nil, err
+	}
+	return append(length, p...), nil
+}
+
+func (e EVMArray) unpack(data []byte, offset int, v interface{}) (int, error) {
+	length := int(e.Length)
+	if e.Length == 0 {
+		var l int64
+		_, err := EVMInt{M: 64}.unpack(data, offset, &l)
+		if err != nil {
+			return 0, err
+		}
+		offset += ElementSize
+		// The length is read from the data so cannot be trusted, but each element takes up at least one word
+		if l < 0 || l > int64((len(data)-offset)/ElementSize) {
+			return 0, fmt.Errorf("length %d of EVM %s out of range", l, e.GetSignature())
+		}
+		length = int(l)
+	}
+	types := e.types(length)
+
+	switch v := v.(type) {
+	case *string:
+		elements := make([]string, length)
+		err := unpackTuple(types, data, offset, func(i int) interface{} {
+			return &elements[i]
+		})
+		if err != nil {
+			return 0, err
+		}
+		*v = "[" + strings.Join(elements, ",") + "]"
+	case *[]interface{}:
+		// Elements already in place (as for fixed sized arrays) are unpacked into, otherwise new ones are made
+		if len(*v) != length {
+			*v = make([]interface{}, length)
+			for i := range *v {
+				(*v)[i] = e.Element.getGoType()
+			}
+		}
+		err := unpackTuple(types, data, offset, func(i int) interface{} {
+			return (*v)[i]
+		})
+		if err != nil {
+			return 0, err
+		}
+	default:
+		rv := reflect.ValueOf(v)
+		if rv.Kind() != reflect.Ptr || (rv.Elem().Kind() != reflect.Slice && rv.Elem().Kind() != reflect.Array) {
+			return 0, fmt.Errorf("cannot map EVM %s to %s", e.GetSignature(), rv.Kind().String())
+		}
+		elements := rv.Elem()
+		if elements.Kind() == reflect.Slice {
+			elements.Set(reflect.MakeSlice(elements.Type(), length, length))
+		} else if elements.Len() != length {
+			return 0, fmt.Errorf("cannot map EVM %s of %d elements to array of %d", e.GetSignature(), length,
+				elements.Len())
+		}
+		err := unpackTuple(types, data, offset, func(i int) interface{} {
+			return elementAddr(elements.Index(i))
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return headSize(e), nil
+}
+
+func (e EVMArray) Dynamic() bool {
+	return e.Length == 0 || e.Element.Dynamic()
+}
+
+// The elements of an array are encoded as a tuple of that many elements
+func (e EVMArray) types(length int) []EVMType {
+	types := make([]EVMType, length)
+	for i := range types {
+		types[i] = e.Element
+	}
+	return types
+}
+
+var _ EVMType = (*EVMTuple)(nil)
+
+// EVMTuple is a tuple of components such as a Solidity struct passed using ABIEncoderV2. Tuples can be packed from
+// Go structs with a field named for each component (ignoring case and surrounding underscores) or a field in the same
+// position, maps keyed by component name, slices, or strings listing the components between parentheses. They can be
+// unpacked into pointers to structs, maps, slices or strings.
+type EVMTuple struct {
+	Components []Argument
+}
+
+func (e EVMTuple) GetSignature() string {
+	signatures := make([]string, len(e.Components))
+	for i, c := range e.Components {
+		signatures[i] = c.signature()
+	}
+	return "(" + strings.Join(signatures, ",") + ")"
+}
+
+func (e EVMTuple) getGoType() interface{} {
+	return new(map[string]interface{})
+}
+
+func (e EVMTuple) pack(v interface{}) ([]byte, error) {
+	var values []interface{}
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+	switch rv.Kind() {
+	case reflect.Struct:
+		fields, err := e.fields(rv)
+		if err != nil {
+			return nil, err
+		}
+		values = make([]interface{}, len(fields))
+		for i, f := range fields {
+			values[i] = f.Interface()
+		}
+	case reflect.Map:
+		if k := rv.Type().Key().Kind(); k != reflect.String && k != reflect.Interface {
+			return nil, fmt.Errorf("cannot map to %s from map with %s keys", e.GetSignature(), k.String())
+		}
+		values = make([]interface{}, len(e.Components))
+		for i := range e.Components {
+			value := rv.MapIndex(reflect.ValueOf(e.componentName(i)))
+			if !value.IsValid() {
+				return nil, fmt.Errorf("no value for component %s of %s", e.componentName(i), e.GetSignature())
+			}
+			values[i] = value.Interface()
+		}
+	default:
+		var err error
+		values, err = listValues(v)
+		if err != nil {
+			return nil, fmt.Errorf("cannot map to %s: %v", e.GetSignature(), err)
+		}
+		if len(values) != len(e.Components) {
+			return nil, fmt.Errorf("%s should have %d components, not %d", e.GetSignature(), len(e.Components),
+				len(values))
+		}
+	}
+
+	return packTuple(e.types(), func(i int) interface{} {
+		return values[i]
+	})
+}
+
+func (e EVMTuple) unpack(data []byte, offset int, v interface{}) (int, error) {
+	var getArg func(int) interface{}
+
+	switch v := v.(type) {
+	case *string:
+		components := make([]string, len(e.Components))
+		err := unpackTuple(e.types(), data, offset, func(i int) interface{} {
+			return &components[i]
+		})
+		if err != nil {
+			return 0, err
+		}
+		*v = "(" + strings.Join(components, ",") + ")"
+		return headSize(e), nil
+	case *[]interface{}:
+		if len(*v) != len(e.Components) {
+			*v = make([]interface{}, len(e.Components))
+			for i, c := range e.Components {
+				(*v)[i] = c.evmType().getGoType()
+			}
+		}
+		getArg = func(i int) interface{} {
+			return (*v)[i]
+		}
+	case *map[string]interface{}:
+		// Components with an entry in the map already are unpacked into it
+		if *v == nil {
+			*v = make(map[string]interface{})
+		}
+		for i, c := range e.Components {
+			if _, ok := (*v)[e.componentName(i)]; !ok {
+				(*v)[e.componentName(i)] = c.evmType().getGoType()
+			}
+		}
+		getArg = func(i int) interface{} {
+			return (*v)[e.componentName(i)]
+		}
+	default:
+		rv := reflect.ValueOf(v)
+		if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Struct {
+			return 0, fmt.Errorf("cannot map EVM %s to %s", e.GetSignature(), rv.Kind().String())
+		}
+		fields, err := e.fields(rv.Elem())
+		if err != nil {
+			return 0, err
+		}
+		getArg = func(i int) interface{} {
+			return elementAddr(fields[i])
+		}
+	}
+
+	err := unpackTuple(e.types(), data, offset, getArg)
+	if err != nil {
+		return 0, err
+	}
+	return headSize(e), nil
+}
+
+func (e EVMTuple) Dynamic() bool {
+	for _, c := range e.Components {
+		if c.evmType().Dynamic() {
+			return true
+		}
+	}
+	return false
+}
+
+func (e EVMTuple) types() []EVMType {
+	types := make([]EVMType, len(e.Components))
+	for i, c := range e.Components {
+		types[i] = c.evmType()
+	}
+	return types
+}
+
+// Unnamed components are keyed by their position
+func (e EVMTuple) componentName(i int) string {
+	if e.Components[i].Name == "" {
+		return strconv.Itoa(i)
+	}
+	return e.Components[i].Name
+}
+
+// Returns the field of a struct for each component
+func (e EVMTuple) fields(rv reflect.Value) ([]reflect.Value, error) {
+	fields := make([]reflect.Value, len(e.Components))
+	for i, c := range e.Components {
+		name := strings.Trim(c.Name, "_")
+		fields[i] = rv.FieldByNameFunc(func(field string) bool {
+			return name != "" && strings.EqualFold(field, name)
+		})
+		if !fields[i].IsValid() {
+			if rv.NumField() != len(e.Components) {
+				return nil, fmt.Errorf("%s has no field for component %s of %s", rv.Type(), e.componentName(i),
+					e.GetSignature())
+			}
+			fields[i] = rv.Field(i)
+		}
+		if !fields[i].CanInterface() {
+			return nil, fmt.Errorf("field of %s for component %s of %s is not exported", rv.Type(),
+				e.componentName(i), e.GetSignature())
+		}
+	}
+	return fields, nil
+}
+
 type Argument struct {
 	Name        string
 	EVM         EVMType
@@ -706,6 +1021,29 @@ type Argument struct {
 	ArrayLength uint64
 }
 
+// The type of an argument including any outermost array dimension
+func (a Argument) evmType() EVMType {
+	if a.IsArray {
+		return EVMArray{Element: a.EVM, Length: a.ArrayLength}
+	}
+	return a.EVM
+}
+
+func (a Argument) signature() string {
+	return a.evmType().GetSignature()
+}
+
+// Makes an argument of type t, describing an outermost array dimension by IsArray and ArrayLength
+func newArgument(name string, t EVMType) Argument {
+	arg := Argument{Name: name, EVM: t}
+	if array, ok := t.(EVMArray); ok {
+		arg.IsArray = true
+		arg.ArrayLength = array.Length
+		arg.EVM = array.Element
+	}
+	return arg
+}
+
 const FunctionIDSize = 4
 
 type FunctionID [FunctionIDSize]byte
@@ -754,106 +1092,119 @@ type AbiSpecJSON struct {
 	Anonymous       bool
 }
 
+var (
+	arrayType = regexp.MustCompile(`^(.*)\[([0-9]*)\]$`)
+	mType     = regexp.MustCompile("^(bytes|uint|int)([0-9]+)$")
+	mxnType   = regexp.MustCompile("^(fixed|ufixed)([0-9]+)x([0-9]+)$")
+)
+
 func readArgSpec(argsJ []ArgumentJSON) ([]Argument, error) {
 	args := make([]Argument, len(argsJ))
-	var err error
 
 	for i, a := range argsJ {
-		args[i].Name = a.Name
+		t, err := readType(a.Type, a.Components)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = newArgument(a.Name, t)
 		args[i].Indexed = a.Indexed
+	}
+
+	return args, nil
+}
 
-		baseType := a.Type
-		isArray := regexp.MustCompile("(.*)\\[([0-9]+)\\]")
-		m := isArray.FindStringSubmatch(a.Type)
-		if m != nil {
-			args[i].IsArray = true
-			args[i].ArrayLength, err = strconv.ParseUint(m[2], 10, 32)
+// readType reads the EVM type named typ, where the components of a tuple type are described separately
+func readType(typ string, components []ArgumentJSON) (EVMType, error) {
+	// The last dimension of an array type is outermost, so uint[2][] is a dynamic array of uint[2]
+	m := arrayType.FindStringSubmatch(typ)
+	if m != nil {
+		element, err := readType(m[1], components)
+		if err != nil {
+			return nil, err
+		}
+		var length uint64
+		if m[2] != "" {
+			length, err = strconv.ParseUint(m[2], 10, 32)
 			if err != nil {
 				return nil, err
 			}
-			baseType = m[1]
-		} else if strings.HasSuffix(a.Type, "[]") {
-			args[i].IsArray = true
-			baseType = strings.TrimSuffix(a.Type, "[]")
 		}
+		return EVMArray{Element: element, Length: length}, nil
+	}
 
-		isM := regexp.MustCompile("(bytes|uint|int)([0-9]+)")
-		m = isM.FindStringSubmatch(baseType)
-		if m != nil {
-			M, err := strconv.ParseUint(m[2], 10, 32)
-			if err != nil {
-				return nil, err
-			}
-			switch m[1] {
-			case "bytes":
-				if M < 1 || M > 32 {
-					return nil, fmt.Errorf("bytes%d is not valid type", M)
-				}
-				args[i].EVM = EVMBytes{M}
-			case "uint":
-				if M < 8 || M > 256 || (M%8) != 0 {
-					return nil, fmt.Errorf("uint%d is not valid type", M)
-				}
-				args[i].EVM = EVMUint{M}
-			case "int":
-				if M < 8 || M > 256 || (M%8) != 0 {
-					return nil, fmt.Errorf("uint%d is not valid type", M)
-				}
-				args[i].EVM = EVMInt{M}
-			}
-			continue
+	if typ == "tuple" {
+		args, err := readArgSpec(components)
+		if err != nil {
+			return nil, err
 		}
+		return EVMTuple{Components: args}, nil
+	}
 
-		isMxN := regexp.MustCompile("(fixed|ufixed)([0-9]+)x([0-9]+)")
-		m = isMxN.FindStringSubmatch(baseType)
-		if m != nil {
-			M, err := strconv.ParseUint(m[2], 10, 32)
-			if err != nil {
-				return nil, err
-			}
-			N, err := strconv.ParseUint(m[3], 10, 32)
-			if err != nil {
-				return nil, err
+	m = mType.FindStringSubmatch(typ)
+	if m != nil {
+		M, err := strconv.ParseUint(m[2], 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		switch m[1] {
+		case "bytes":
+			if M < 1 || M > 32 {
+				return nil, fmt.Errorf("bytes%d is not valid type", M)
 			}
+			return EVMBytes{M}, nil
+		case "uint":
 			if M < 8 || M > 256 || (M%8) != 0 {
-				return nil, fmt.Errorf("%s is not valid type", baseType)
-			}
-			if N <= 0 || N > 80 {
-				return nil, fmt.Errorf("%s is not valid type", baseType)
+				return nil, fmt.Errorf("uint%d is not valid type", M)
 			}
-			if m[1] == "fixed" {
-				args[i].EVM = EVMFixed{N: N, M: M, signed: true}
-			} else if m[1] == "ufixed" {
-				args[i].EVM = EVMFixed{N: N, M: M, signed: false}
-			} else {
-				panic(m[1])
-			}
-			continue
-		}
-		switch baseType {
-		case "uint":
-			args[i].EVM = EVMUint{M: 256}
-		case "int":
-			args[i].EVM = EVMInt{M: 256}
-		case "address":
-			args[i].EVM = EVMAddress{}
-		case "bool":
-			args[i].EVM = EVMBool{}
-		case "fixed":
-			args[i].EVM = EVMFixed{M: 128, N: 8, signed: true}
-		case "ufixed":
-			args[i].EVM = EVMFixed{M: 128, N: 8, signed: false}
-		case "bytes":
-			args[i].EVM = EVMBytes{M: 0}
-		case "string":
-			args[i].EVM = EVMString{}
+			return EVMUint{M}, nil
 		default:
-			// Assume it is a type of Contract
-			args[i].EVM = EVMAddress{}
+			if M < 8 || M > 256 || (M%8) != 0 {
+				return nil, fmt.Errorf("int%d is not valid type", M)
+			}
+			return EVMInt{M}, nil
 		}
 	}
 
-	return args, nil
+	m = mxnType.FindStringSubmatch(typ)
+	if m != nil {
+		M, err := strconv.ParseUint(m[2], 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		N, err := strconv.ParseUint(m[3], 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		if M < 8 || M > 256 || (M%8) != 0 {
+			return nil, fmt.Errorf("%s is not valid type", typ)
+		}
+		if N <= 0 || N > 80 {
+			return nil, fmt.Errorf("%s is not valid type", typ)
+		}
+		return EVMFixed{N: N, M: M, signed: m[1] == "fixed"}, nil
+	}
+
+	switch typ {
+	case "uint":
+		return EVMUint{M: 256}, nil
+	case "int":
+		return EVMInt{M: 256}, nil
+	case "address":
+		return EVMAddress{}, nil
+	case "bool":
+		return EVMBool{}, nil
+	case "fixed":
+		return EVMFixed{M: 128, N: 8, signed: true}, nil
+	case "ufixed":
+		return EVMFixed{M: 128, N: 8, signed: false}, nil
+	case "bytes":
+		return EVMBytes{M: 0}, nil
+	case "string":
+		return EVMString{}, nil
+	default:
+		// Assume it is a type of Contract
+		return EVMAddress{}, nil
+	}
 }
 
 func ReadAbiSpec(specBytes []byte) (*AbiSpec, error) {
@@ -895,9 +1246,11 @@ func ReadAbiSpec(specBytes []byte) (*AbiSpec, error) {
 			// Get signature before we deal with hashed types
 			sig := Signature(s.Name, inputs)
 			for i := range inputs {
-				if inputs[i].Indexed && inputs[i].EVM.Dynamic() {
-					// For Dynamic types, the hash is stored in stead
+				if inputs[i].Indexed && !isValueType(inputs[i].evmType()) {
+					// For Dynamic types, arrays and tuples, the hash is stored in stead
 					inputs[i].EVM = EVMBytes{M: 32}
+					inputs[i].IsArray = false
+					inputs[i].ArrayLength = 0
 					inputs[i].Hashed = true
 				}
 			}
@@ -922,6 +1275,15 @@ func ReadAbiSpec(specBytes []byte) (*AbiSpec, error) {
 	return &abiSpec, nil
 }
 
+// Only value types are stored in topics as themselves when indexed
+func isValueType(t EVMType) bool {
+	switch t.(type) {
+	case EVMArray, EVMTuple:
+		return false
+	}
+	return !t.Dynamic()
+}
+
 func ReadAbiSpecFile(filename string) (*AbiSpec, error) {
 	specBytes, err := ioutil.ReadFile(filename)
 	if err != nil {
@@ -958,37 +1320,44 @@ func MergeAbiSpec(abiSpec []*AbiSpec) *AbiSpec {
 }
 
 func EVMTypeFromReflect(v reflect.Type) Argument {
-	arg := Argument{Name: v.Name()}
+	return newArgument(v.Name(), evmTypeFromReflect(v))
+}
 
-	if v == reflect.TypeOf(crypto.Address{}) {
-		arg.EVM = EVMAddress{}
-	} else if v == reflect.TypeOf(big.Int{}) {
-		arg.EVM = EVMInt{M: 256}
-	} else {
-		if v.Kind() == reflect.Array {
-			arg.IsArray = true
-			arg.ArrayLength = uint64(v.Len())
-			v = v.Elem()
-		} else if v.Kind() == reflect.Slice {
-			arg.IsArray = true
-			v = v.Elem()
-		}
-
-		switch v.Kind() {
-		case reflect.Bool:
-			arg.EVM = EVMBool{}
-		case reflect.String:
-			arg.EVM = EVMString{}
-		case reflect.Uint64:
-			arg.EVM = EVMUint{M: 64}
-		case reflect.Int64:
-			arg.EVM = EVMInt{M: 64}
-		default:
-			panic(fmt.Sprintf("no mapping for type %v", v.Kind()))
-		}
+// Structs other than big.Int map to tuples of their fields
+func evmTypeFromReflect(v reflect.Type) EVMType {
+	switch {
+	case v == reflect.TypeOf(crypto.Address{}):
+		return EVMAddress{}
+	case v == reflect.TypeOf(big.Int{}):
+		return EVMInt{M: 256}
+	case v.Kind() == reflect.Array && v.Elem().Kind() == reflect.Uint8:
+		// Such as a FunctionID
+		return EVMBytes{M: uint64(v.Len())}
 	}
 
-	return arg
+	switch v.Kind() {
+	case reflect.Array:
+		return EVMArray{Element: evmTypeFromReflect(v.Elem()), Length: uint64(v.Len())}
+	case reflect.Slice:
+		return EVMArray{Element: evmTypeFromReflect(v.Elem())}
+	case reflect.Struct:
+		components := make([]Argument, v.NumField())
+		for i := range components {
+			f := v.Field(i)
+			components[i] = newArgument(f.Name, evmTypeFromReflect(f.Type))
+		}
+		return EVMTuple{Components: components}
+	case reflect.Bool:
+		return EVMBool{}
+	case reflect.String:
+		return EVMString{}
+	case reflect.Uint64:
+		return EVMUint{M: 64}
+	case reflect.Int64:
+		return EVMInt{M: 64}
+	default:
+		panic(fmt.Sprintf("no mapping for type %v", v.Kind()))
+	}
 }
 
 // SpecFromStructReflect generates a FunctionSpec where the arguments and return values are
@@ -1045,14 +1414,7 @@ func Signature(name string, args []Argument) (sig string) {
 		if i > 0 {
 			sig += ","
 		}
-		sig += a.EVM.GetSignature()
-		if a.IsArray {
-			if a.ArrayLength > 0 {
-				sig += fmt.Sprintf("[%d]", a.ArrayLength)
-			} else {
-				sig += "[]"
-			}
-		}
+		sig += a.signature()
 	}
 	sig += ")"
 	return
@@ -1233,109 +1595,135 @@ func Pack(argSpec []Argument, args ...interface{}) ([]byte, error) {
 }
 
 func pack(argSpec []Argument, getArg func(int) interface{}) ([]byte, error) {
-	packed := make([]byte, 0)
-	packedDynamic := []byte{}
-	fixedSize := 0
-	// Anything dynamic is stored after the "fixed" block. For the dynamic types, the fixed
-	// block contains byte offsets to the data. We need to know the length of the fixed
-	// block, so we can calcute the offsets
-	for _, a := range argSpec {
-		if a.IsArray {
-			if a.ArrayLength > 0 {
-				fixedSize += ElementSize * int(a.ArrayLength)
-			} else {
-				fixedSize += ElementSize
-			}
-		} else {
-			fixedSize += ElementSize
-		}
+	types := make([]EVMType, len(argSpec))
+	for i, a := range argSpec {
+		types[i] = a.evmType()
 	}
 
-	addArg := func(v interface{}, a Argument) error {
-		var b []byte
-		var err error
-		if a.EVM.Dynamic() {
-			offset := EVMUint{M: 256}
-			b, _ = offset.pack(fixedSize)
-			d, err := a.EVM.pack(v)
+	packed, err := packTuple(types, getArg)
+	if err != nil {
+		return nil, err
+	}
+	return packed, nil
+}
+
+// packTuple packs a sequence of values as a tuple. The values of static types are stored in order in the "head" of
+// the tuple, followed by the values of dynamic types. For the dynamic types the head contains byte offsets from the
+// start of the tuple to their values.
+func packTuple(types []EVMType, getArg func(int) interface{}) ([]byte, error) {
+	headLength := 0
+	for _, t := range types {
+		headLength += headSize(t)
+	}
+
+	head := make([]byte, 0, headLength)
+	var tail []byte
+	for i, t := range types {
+		b, err := t.pack(getArg(i))
+		if err != nil {
+			return nil, fmt.Errorf("argument %d: %v", i, err)
+		}
+		if t.Dynamic() {
+			offset, err := EVMUint{M: 256}.pack(headLength + len(tail))
 			if err != nil {
-				return err
+				return nil, err
 			}
-			fixedSize += len(d)
-			packedDynamic = append(packedDynamic, d...)
+			head = append(head, offset...)
+			tail = append(tail, b...)
 		} else {
-			b, err = a.EVM.pack(v)
-			if err != nil {
-				return err
-			}
+			head = append(head, b...)
 		}
-		packed = append(packed, b...)
-		return nil
 	}
 
-	for i, as := range argSpec {
-		a := getArg(i)
-		if as.IsArray {
-			s, ok := a.(string)
-			if ok && s[0:1] == "[" && s[len(s)-1:] == "]" {
-				a = strings.Split(s[1:len(s)-1], ",")
-			}
+	return append(head, tail...), nil
+}
 
-			val := reflect.ValueOf(a)
-			if val.Kind() != reflect.Slice && val.Kind() != reflect.Array {
-				return nil, fmt.Errorf("argument %d should be array or slice, not %s", i, val.Kind().String())
-			}
+// headSize is the number of bytes a value of type t takes up in the head of a tuple containing it
+func headSize(t EVMType) int {
+	if t.Dynamic() {
+		return ElementSize
+	}
+	switch t := t.(type) {
+	case EVMArray:
+		return int(t.Length) * headSize(t.Element)
+	case EVMTuple:
+		size := 0
+		for _, c := range t.Components {
+			size += headSize(c.evmType())
+		}
+		return size
+	}
+	return ElementSize
+}
 
-			if as.ArrayLength > 0 {
-				if as.ArrayLength != uint64(val.Len()) {
-					return nil, fmt.Errorf("argumment %d should be array of %d, not %d", i, as.ArrayLength, val.Len())
-				}
+// listValues returns the elements of an array or slice, or of a string listing them between brackets or
+// parentheses separated by commas
+func listValues(v interface{}) ([]interface{}, error) {
+	if s, ok := v.(string); ok {
+		elements, err := SplitList(s)
+		if err != nil {
+			return nil, err
+		}
+		values := make([]interface{}, len(elements))
+		for i, e := range elements {
+			values[i] = e
+		}
+		return values, nil
+	}
 
-				for n := 0; n < val.Len(); n++ {
-					err := addArg(val.Index(n).Interface(), as)
-					if err != nil {
-						return nil, err
-					}
-				}
-			} else {
-				// dynamic array
-				offset := EVMUint{M: 256}
-				b, _ := offset.pack(fixedSize)
-				packed = append(packed, b...)
-				fixedSize += len(b)
-
-				// store length
-				b, _ = offset.pack(val.Len())
-				packedDynamic = append(packedDynamic, b...)
-				for n := 0; n < val.Len(); n++ {
-					d, err := as.EVM.pack(val.Index(n).Interface())
-					if err != nil {
-						return nil, err
-					}
-					packedDynamic = append(packedDynamic, d...)
-				}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil, fmt.Errorf("should be array, slice or string, not %s", rv.Kind().String())
+	}
+	values := make([]interface{}, rv.Len())
+	for i := range values {
+		values[i] = rv.Index(i).Interface()
+	}
+	return values, nil
+}
+
+// SplitList splits a string such as "[1,[2,3],(a,b)]", as arrays and tuples are rendered when unpacked into strings,
+// into its top level elements "1", "[2,3]" and "(a,b)"
+func SplitList(s string) ([]string, error) {
+	s = strings.TrimSpace(s)
+	if len(s) < 2 || !(s[0] == '[' && s[len(s)-1] == ']' || s[0] == '(' && s[len(s)-1] == ')') {
+		return nil, fmt.Errorf("%s should be a list in brackets or parentheses", s)
+	}
+	s = s[1 : len(s)-1]
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+
+	var elements []string
+	depth := 0
+	start := 0
+	for i, c := range s {
+		switch c {
+		case '[', '(':
+			depth++
+		case ']', ')':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("unbalanced brackets in %s", s)
 			}
-		} else {
-			err := addArg(a, as)
-			if err != nil {
-				return nil, err
+		case ',':
+			if depth == 0 {
+				elements = append(elements, strings.TrimSpace(s[start:i]))
+				start = i + 1
 			}
 		}
 	}
-
-	return append(packed, packedDynamic...), nil
+	if depth != 0 {
+		return nil, fmt.Errorf("unbalanced brackets in %s", s)
+	}
+	return append(elements, strings.TrimSpace(s[start:])), nil
 }
 
 func GetPackingTypes(args []Argument) []interface{} {
 	res := make([]interface{}, len(args))
 
 	for i, a := range args {
-		if a.IsArray {
-			t := reflect.TypeOf(a.EVM.getGoType())
-			res[i] = reflect.MakeSlice(reflect.SliceOf(t), int(a.ArrayLength), 0).Interface()
-		} else {
-			res[i] = a.EVM.getGoType()
-		}
+		res[i] = a.evmType().getGoType()
 	}
 
 	return res
@@ -1355,131 +1743,60 @@ func Unpack(argSpec []Argument, data []byte, args ...interface{}) error {
 }
 
 func unpack(argSpec []Argument, data []byte, getArg func(int) interface{}) error {
-	offset := 0
-	offType := EVMInt{M: 64}
+	// Indexed arguments are stored in topics rather than data
+	var types []EVMType
+	var indices []int
+	for i, a := range argSpec {
+		if !a.Indexed {
+			types = append(types, a.evmType())
+			indices = append(indices, i)
+		}
+	}
 
-	getPrimitive := func(e interface{}, a Argument) error {
-		if a.EVM.Dynamic() {
+	return unpackTuple(types, data, 0, func(i int) interface{} {
+		return getArg(indices[i])
+	})
+}
+
+// unpackTuple unpacks a tuple packed by packTuple starting at offset in data
+func unpackTuple(types []EVMType, data []byte, offset int, getArg func(int) interface{}) error {
+	position := offset
+	for i, t := range types {
+		if t.Dynamic() {
 			var o int64
-			l, err := offType.unpack(data, offset, &o)
+			_, err := EVMInt{M: 64}.unpack(data, position, &o)
 			if err != nil {
 				return err
 			}
-			offset += l
-			l, err = a.EVM.unpack(data, int(o), e)
-			if err != nil {
-				return err
+			if o < 0 || offset+int(o) > len(data) {
+				return fmt.Errorf("offset %d of argument %d out of range", o, i)
 			}
-		} else {
-			l, err := a.EVM.unpack(data, offset, e)
+			_, err = t.unpack(data, offset+int(o), getArg(i))
 			if err != nil {
 				return err
 			}
-			offset += l
-		}
-
-		return nil
-	}
-
-	for i, a := range argSpec {
-		if a.Indexed {
-			continue
-		}
-
-		arg := getArg(i)
-		if a.IsArray {
-			var array *[]interface{}
-
-			array, ok := arg.(*[]interface{})
-			if !ok {
-				if _, ok := arg.(*string); ok {
-					// We have been asked to return the value as a string; make intermediate
-					// array of strings; we will concatenate after
-					intermediate := make([]interface{}, a.ArrayLength)
-					for i, _ := range intermediate {
-						intermediate[i] = new(string)
-					}
-					array = &intermediate
-				} else {
-					return fmt.Errorf("argument %d should be array, slice or string", i)
-				}
-			}
-
-			if a.ArrayLength > 0 {
-				if int(a.ArrayLength) != len(*array) {
-					return fmt.Errorf("argument %d should be array or slice of %d elements", i, a.ArrayLength)
-				}
-
-				for n := 0; n < len(*array); n++ {
-					err := getPrimitive((*array)[n], a)
-					if err != nil {
-						return err
-					}
-				}
-			} else {
-				var o int64
-				var length int64
-
-				l, err := offType.unpack(data, offset, &o)
-				if err != nil {
-					return err
-				}
-
-				offset += l
-				s, err := offType.unpack(data, int(o), &length)
-				if err != nil {
-					return err
-				}
-				o += int64(s)
-
-				intermediate := make([]interface{}, length)
-
-				if _, ok := arg.(*string); ok {
-					// We have been asked to return the value as a string; make intermediate
-					// array of strings; we will concatenate after
-					for i, _ := range intermediate {
-						intermediate[i] = new(string)
-					}
-				} else {
-					for i, _ := range intermediate {
-						intermediate[i] = a.EVM.getGoType()
-					}
-				}
-
-				for i := 0; i < int(length); i++ {
-					l, err = a.EVM.unpack(data, int(o), intermediate[i])
-					if err != nil {
-						return err
-					}
-					o += int64(l)
-				}
-
-				array = &intermediate
-			}
-
-			// If we were supposed to return a string, convert it back
-			if ret, ok := arg.(*string); ok {
-				s := "["
-				for i, e := range *array {
-					if i > 0 {
-						s += ","
-					}
-					s += *(e.(*string))
-				}
-				s += "]"
-				*ret = s
-			}
 		} else {
-			err := getPrimitive(arg, a)
+			_, err := t.unpack(data, position, getArg(i))
 			if err != nil {
 				return err
 			}
 		}
+		position += headSize(t)
 	}
 
 	return nil
 }
 
+// elementAddr returns a pointer to unpack an element of a slice or a field of a struct into. Elements that are
+// themselves pointers (such as *big.Int) are allocated and unpacked into directly.
+func elementAddr(element reflect.Value) interface{} {
+	if element.Kind() == reflect.Ptr {
+		element.Set(reflect.New(element.Type().Elem()))
+		return element.Interface()
+	}
+	return element.Addr().Interface()
+}
+
 // quick helper padding
 func pad(input []byte, size int, left bool) []byte {
 	if len(input) >= size {
@@ -3,10 +3,13 @@ package abi
 import (
 	"bytes"
 	"encoding/hex"
+	"fmt"
 	"math/big"
+	"reflect"
 	"strings"
 	"testing"
 
+	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -467,6 +470,232 @@ func TestUnpacker(t *testing.T) {
 	}
 }
 
+func TestPackUnpackTyped(t *testing.T) {
+	spec, err := ReadAbiSpec([]byte(`[{"constant":false,"inputs":[{"name":"who","type":"address"},
+		{"name":"amount","type":"uint256"},{"name":"delta","type":"int256"},{"name":"ids","type":"uint64[]"}],
+		"name":"typed","outputs":[{"name":"who","type":"address"},{"name":"amount","type":"uint256"},
+		{"name":"delta","type":"int256"},{"name":"ids","type":"uint64[]"}],"payable":false,"type":"function"},
+		{"constant":false,"inputs":[{"name":"amounts","type":"uint256[2]"}],"name":"bigs",
+		"outputs":[{"name":"amounts","type":"uint256[2]"}],"payable":false,"type":"function"}]`))
+	require.NoError(t, err)
+	address := crypto.Address{1, 2, 3}
+	amount, _ := new(big.Int).SetString("1000000000000000000000", 10)
+	delta := big.NewInt(-7)
+	ids := []uint64{4, 5, 6}
+	packed, _, err := spec.Pack("typed", address, amount, delta, ids)
+	require.NoError(t, err)
+
+	var whoOut crypto.Address
+	amountOut := new(big.Int)
+	deltaOut := new(big.Int)
+	var idsOut []uint64
+	err = Unpack(spec.Functions["typed"].Outputs, packed[4:], &whoOut, amountOut, deltaOut, &idsOut)
+	require.NoError(t, err)
+	assert.Equal(t, address, whoOut)
+	assert.Equal(t, amount.String(), amountOut.String())
+	assert.Equal(t, delta.String(), deltaOut.String())
+	assert.Equal(t, ids, idsOut)
+
+	amounts := []*big.Int{amount, big.NewInt(3)}
+	packed, _, err = spec.Pack("bigs", amounts)
+	require.NoError(t, err)
+	var amountsOut []*big.Int
+	err = Unpack(spec.Functions["bigs"].Outputs, packed[4:], &amountsOut)
+	require.NoError(t, err)
+	require.Len(t, amountsOut, 2)
+	assert.Equal(t, amount.String(), amountsOut[0].String())
+	assert.Equal(t, "3", amountsOut[1].String())
+}
+
+func TestPackNestedArrays(t *testing.T) {
+	// Examples from the Solidity ABI specification
+	spec, err := ReadAbiSpec([]byte(`[{"inputs":[{"name":"","type":"uint256"},{"name":"","type":"uint32[]"},
+		{"name":"","type":"bytes10"},{"name":"","type":"bytes"}],"name":"f","outputs":[],"type":"function"},
+		{"inputs":[{"name":"","type":"uint256[][]"},{"name":"","type":"string[]"}],"name":"g",
+		"outputs":[{"name":"","type":"uint256[][]"},{"name":"","type":"string[]"}],"type":"function"}]`))
+	require.NoError(t, err)
+
+	packed, _, err := spec.Pack("f", "0x123", []interface{}{"0x456", "0x789"}, "1234567890", "Hello, world!")
+	require.NoError(t, err)
+	assert.Equal(t, hexToBytes(t, "8be65246"+
+		"0000000000000000000000000000000000000000000000000000000000000123"+
+		"0000000000000000000000000000000000000000000000000000000000000080"+
+		"3132333435363738393000000000000000000000000000000000000000000000"+
+		"00000000000000000000000000000000000000000000000000000000000000e0"+
+		"0000000000000000000000000000000000000000000000000000000000000002"+
+		"0000000000000000000000000000000000000000000000000000000000000456"+
+		"0000000000000000000000000000000000000000000000000000000000000789"+
+		"000000000000000000000000000000000000000000000000000000000000000d"+
+		"48656c6c6f2c20776f726c642100000000000000000000000000000000000000"), packed)
+
+	packed, _, err = spec.Pack("g", "[[1,2],[3]]", []string{"one", "two", "three"})
+	require.NoError(t, err)
+	assert.Equal(t, hexToBytes(t, "2289b18c"+
+		"0000000000000000000000000000000000000000000000000000000000000040"+
+		"0000000000000000000000000000000000000000000000000000000000000140"+
+		"0000000000000000000000000000000000000000000000000000000000000002"+
+		"0000000000000000000000000000000000000000000000000000000000000040"+
+		"00000000000000000000000000000000000000000000000000000000000000a0"+
+		"0000000000000000000000000000000000000000000000000000000000000002"+
+		"0000000000000000000000000000000000000000000000000000000000000001"+
+		"0000000000000000000000000000000000000000000000000000000000000002"+
+		"0000000000000000000000000000000000000000000000000000000000000001"+
+		"0000000000000000000000000000000000000000000000000000000000000003"+
+		"0000000000000000000000000000000000000000000000000000000000000003"+
+		"0000000000000000000000000000000000000000000000000000000000000060"+
+		"00000000000000000000000000000000000000000000000000000000000000a0"+
+		"00000000000000000000000000000000000000000000000000000000000000e0"+
+		"0000000000000000000000000000000000000000000000000000000000000003"+
+		"6f6e650000000000000000000000000000000000000000000000000000000000"+
+		"0000000000000000000000000000000000000000000000000000000000000003"+
+		"74776f0000000000000000000000000000000000000000000000000000000000"+
+		"0000000000000000000000000000000000000000000000000000000000000005"+
+		"7468726565000000000000000000000000000000000000000000000000000000"), packed)
+
+	var nested [][]uint64
+	var strs []string
+	err = Unpack(spec.Functions["g"].Outputs, packed[4:], &nested, &strs)
+	require.NoError(t, err)
+	assert.Equal(t, [][]uint64{{1, 2}, {3}}, nested)
+	assert.Equal(t, []string{"one", "two", "three"}, strs)
+
+	var str1, str2 string
+	err = Unpack(spec.Functions["g"].Outputs, packed[4:], &str1, &str2)
+	require.NoError(t, err)
+	assert.Equal(t, "[[1,2],[3]]", str1)
+	assert.Equal(t, "[one,two,three]", str2)
+}
+
+func TestUnpackUntrustedLengths(t *testing.T) {
+	spec, err := ReadAbiSpec([]byte(`[{"inputs":[],"name":"g","outputs":[{"name":"","type":"uint256[][]"},
+		{"name":"","type":"string[]"},{"name":"","type":"bytes10"}],"type":"function"}]`))
+	require.NoError(t, err)
+	outputs := spec.Functions["g"].Outputs
+	packed, err := Pack(outputs, "[[1,2],[3]]", []string{"one", "two"}, "1234567890")
+	require.NoError(t, err)
+
+	var nested [][]uint64
+	var strs []string
+	var fixed string
+	require.NoError(t, Unpack(outputs, packed, &nested, &strs, &fixed))
+	assert.Equal(t, [][]uint64{{1, 2}, {3}}, nested)
+	assert.Equal(t, []string{"one", "two"}, strs)
+	assert.Equal(t, "1234567890", fixed)
+
+	// Lengths are read from the data, so must be checked against it rather than trusted, whether they are of an
+	// argument, of an array nested in one or of a string in an array
+	for name, word := range map[string]int{
+		"argument array length": 0x60,
+		"nested array length":   0xc0,
+		"string length":         0x1c0,
+	} {
+		for _, length := range []string{
+			"00000000000000000000000000000000000000000000000000000000ffffffff",
+			"000000000000000000000000000000000000000000000000ffffffffffffffff",
+			"0000000000000000000000000000000000000000000000007fffffffffffffff",
+		} {
+			corrupt := append([]byte(nil), packed...)
+			copy(corrupt[word:], hexToBytes(t, length))
+			assert.Error(t, Unpack(outputs, corrupt, &nested, &strs, &fixed), "%s of 0x%s", name, length)
+		}
+	}
+
+	assert.Error(t, Unpack(outputs[2:], packed[0x40:0x50], &fixed), "truncated fixed bytes")
+}
+
+func TestPackUnpackTuple(t *testing.T) {
+	spec, err := ReadAbiSpec([]byte(`[{"inputs":[{"name":"order","type":"tuple","components":[
+		{"name":"price","type":"uint256"},{"name":"memo","type":"string"},{"name":"parties","type":"address[]"}]},
+		{"name":"flags","type":"tuple[2]","components":[{"name":"code","type":"uint8"},{"name":"set","type":"bool"}]}],
+		"name":"place","outputs":[{"name":"order","type":"tuple","components":[{"name":"price","type":"uint256"},
+		{"name":"memo","type":"string"},{"name":"parties","type":"address[]"}]},{"name":"flags","type":"tuple[2]",
+		"components":[{"name":"code","type":"uint8"},{"name":"set","type":"bool"}]}],"type":"function"}]`))
+	require.NoError(t, err)
+	fn := spec.Functions["place"]
+	assert.Equal(t, "place((uint256,string,address[]),(uint8,bool)[2])", Signature("place", fn.Inputs))
+	assert.Equal(t, GetFunctionID("place((uint256,string,address[]),(uint8,bool)[2])"), fn.FunctionID)
+
+	type order struct {
+		Price   uint64
+		Memo    string
+		Parties []crypto.Address
+	}
+	type flag struct {
+		Code uint8
+		Set  bool
+	}
+	in := order{Price: 100, Memo: "hello", Parties: []crypto.Address{{1}, {2}}}
+	flags := []flag{{Code: 1, Set: true}, {Code: 2}}
+	packed, _, err := spec.Pack("place", in, flags)
+	require.NoError(t, err)
+
+	// Structs are matched by field name
+	var out order
+	var flagsOut [2]struct {
+		Set  bool
+		Code uint8
+	}
+	err = Unpack(fn.Outputs, packed[4:], &out, &flagsOut)
+	require.NoError(t, err)
+	assert.Equal(t, in, out)
+	assert.Equal(t, uint8(1), flagsOut[0].Code)
+	assert.True(t, flagsOut[0].Set)
+	assert.Equal(t, uint8(2), flagsOut[1].Code)
+
+	// Maps by component name and strings in order
+	repacked, _, err := spec.Pack("place",
+		map[string]interface{}{"price": "100", "memo": "hello", "parties": in.Parties},
+		"[(1,true),(2,false)]")
+	require.NoError(t, err)
+	assert.Equal(t, packed, repacked)
+
+	var str1, str2 string
+	err = Unpack(fn.Outputs, packed[4:], &str1, &str2)
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("(100,hello,[%v,%v])", in.Parties[0], in.Parties[1]), str1)
+	assert.Equal(t, "[(1,true),(2,false)]", str2)
+
+	values := GetPackingTypes(fn.Outputs)
+	err = Unpack(fn.Outputs, packed[4:], values...)
+	require.NoError(t, err)
+	fields := *values[0].(*map[string]interface{})
+	assert.Equal(t, "100", fields["price"].(*big.Int).String())
+	assert.Equal(t, "hello", *fields["memo"].(*string))
+
+	// Static tuples are stored in place
+	spec, err = ReadAbiSpec([]byte(`[{"inputs":[{"name":"","type":"tuple","components":[
+		{"name":"a","type":"uint256"},{"name":"b","type":"bool"}]}],"name":"s","outputs":[],"type":"function"}]`))
+	require.NoError(t, err)
+	packed, _, err = spec.Pack("s", []interface{}{1, true})
+	require.NoError(t, err)
+	assert.Equal(t, append(pad([]byte{1}, 32, true), pad([]byte{1}, 32, true)...), packed[4:])
+}
+
+func TestSpecFromStructReflectTuple(t *testing.T) {
+	type point struct {
+		X, Y int64
+	}
+	type args struct {
+		Name   string
+		Points []point
+		Grid   [][2]uint64
+	}
+	type rets struct {
+		Origin point
+	}
+	spec := SpecFromStructReflect("draw", reflect.TypeOf(args{}), reflect.TypeOf(rets{}))
+	assert.Equal(t, "draw(string,(int64,int64)[],uint64[2][])", Signature("draw", spec.Inputs))
+	assert.Equal(t, "(int64,int64)", spec.Outputs[0].EVM.GetSignature())
+
+	in := args{Name: "shape", Points: []point{{1, 2}, {-3, 4}}, Grid: [][2]uint64{{5, 6}}}
+	packed, err := PackIntoStruct(spec.Inputs, in)
+	require.NoError(t, err)
+	out := new(args)
+	err = UnpackIntoStruct(spec.Inputs, packed, out)
+	require.NoError(t, err)
+	assert.Equal(t, in, *out)
+}
+
 func hexToBytes(t testing.TB, hexString string) []byte {
 	bs, err := hex.DecodeString(hexString)
 	require.NoError(t, err)
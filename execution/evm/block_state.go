@@ -0,0 +1,36 @@
+package evm
+
+import (
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/permission"
+)
+
+// Reads state as of the block being executed, so that accounts' base permissions include their permission grants until
+// they expire
+type blockState struct {
+	Interface
+	blockHeight uint64
+	blockTime   int64
+}
+
+func newBlockState(st Interface, blockHeight uint64, blockTime int64) *blockState {
+	if bs, ok := st.(*blockState); ok {
+		st = bs.Interface
+	}
+	return &blockState{
+		Interface:   st,
+		blockHeight: blockHeight,
+		blockTime:   blockTime,
+	}
+}
+
+func (bs *blockState) GetPermissions(address crypto.Address) permission.AccountPermissions {
+	perms := bs.Interface.GetPermissions(address)
+	perms.Base = perms.BaseAt(bs.blockHeight, bs.blockTime)
+	return perms
+}
+
+func (bs *blockState) NewCache(cacheOptions ...acmstate.CacheOption) Interface {
+	return newBlockState(bs.Interface.NewCache(cacheOptions...), bs.blockHeight, bs.blockTime)
+}
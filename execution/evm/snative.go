@@ -169,6 +169,71 @@ func SNativeContracts() map[string]*SNativeContractDescription {
 				Arguments: reflect.TypeOf(setGlobalArgs{}),
 				Returns:   reflect.TypeOf(setGlobalRets{}),
 				F:         setGlobal},
+
+			&SNativeFunctionDescription{Comment: `
+			* @notice Grants an account the right to call a contract, after which only accounts and roles granted calls may call it
+			* @param Contract contract address
+			* @param Account account address
+			* @param Function selector of the function the account may call, or zero for any function
+			* @return result whether the grant was added
+			`,
+				Name:      "grantCall",
+				PermFlag:  permission.GrantCall,
+				Arguments: reflect.TypeOf(grantCallArgs{}),
+				Returns:   reflect.TypeOf(grantCallRets{}),
+				F:         grantCall},
+
+			&SNativeFunctionDescription{Comment: `
+			* @notice Grants the holders of a role the right to call a contract, after which only accounts and roles granted calls may call it
+			* @param Contract contract address
+			* @param Role role name
+			* @param Function selector of the function the role holders may call, or zero for any function
+			* @return result whether the grant was added
+			`,
+				Name:      "grantRoleCall",
+				PermFlag:  permission.GrantCall,
+				Arguments: reflect.TypeOf(grantRoleCallArgs{}),
+				Returns:   reflect.TypeOf(grantRoleCallRets{}),
+				F:         grantRoleCall},
+
+			&SNativeFunctionDescription{Comment: `
+			* @notice Revokes a call grant to an account. A contract with no remaining grants may be called by any account with the call permission.
+			* @param Contract contract address
+			* @param Account account address
+			* @param Function selector of the function granted, or zero for any function
+			* @return result whether the grant was removed
+			`,
+				Name:      "revokeCall",
+				PermFlag:  permission.RevokeCall,
+				Arguments: reflect.TypeOf(revokeCallArgs{}),
+				Returns:   reflect.TypeOf(revokeCallRets{}),
+				F:         revokeCall},
+
+			&SNativeFunctionDescription{Comment: `
+			* @notice Revokes a call grant to a role. A contract with no remaining grants may be called by any account with the call permission.
+			* @param Contract contract address
+			* @param Role role name
+			* @param Function selector of the function granted, or zero for any function
+			* @return result whether the grant was removed
+			`,
+				Name:      "revokeRoleCall",
+				PermFlag:  permission.RevokeCall,
+				Arguments: reflect.TypeOf(revokeRoleCallArgs{}),
+				Returns:   reflect.TypeOf(revokeRoleCallRets{}),
+				F:         revokeRoleCall},
+
+			&SNativeFunctionDescription{Comment: `
+			* @notice Indicates whether an account may call a contract
+			* @param Contract contract address
+			* @param Account account address
+			* @param Function selector of the function to call, or zero for a call with no function selector
+			* @return result whether the account may call the function
+			`,
+				Name:      "canCall",
+				PermFlag:  permission.CanCall,
+				Arguments: reflect.TypeOf(canCallArgs{}),
+				Returns:   reflect.TypeOf(canCallRets{}),
+				F:         canCall},
 		),
 	}
 
@@ -493,3 +558,150 @@ func removeRole(stateWriter Interface, caller crypto.Address, gas *uint64, logge
 		"role_removed", roleRemoved)
 	return removeRoleRets{Result: roleRemoved}, nil
 }
+
+type grantCallArgs struct {
+	Contract crypto.Address
+	Account  crypto.Address
+	Function abi.FunctionID
+}
+
+type grantCallRets struct {
+	Result bool
+}
+
+func grantCall(stateWriter Interface, caller crypto.Address, gas *uint64, logger *logging.Logger,
+	a interface{}) (interface{}, error) {
+	args := a.(*grantCallArgs)
+	result, err := addCallGrant(stateWriter, logger, args.Contract, permission.AccountCallGrant(args.Account,
+		functionSelector(args.Function)))
+	if err != nil {
+		return grantCallRets{}, err
+	}
+	return grantCallRets{Result: result}, nil
+}
+
+type grantRoleCallArgs struct {
+	Contract crypto.Address
+	Role     string
+	Function abi.FunctionID
+}
+
+type grantRoleCallRets struct {
+	Result bool
+}
+
+func grantRoleCall(stateWriter Interface, caller crypto.Address, gas *uint64, logger *logging.Logger,
+	a interface{}) (interface{}, error) {
+	args := a.(*grantRoleCallArgs)
+	result, err := addCallGrant(stateWriter, logger, args.Contract, permission.RoleCallGrant(args.Role,
+		functionSelector(args.Function)))
+	if err != nil {
+		return grantRoleCallRets{}, err
+	}
+	return grantRoleCallRets{Result: result}, nil
+}
+
+type revokeCallArgs struct {
+	Contract crypto.Address
+	Account  crypto.Address
+	Function abi.FunctionID
+}
+
+type revokeCallRets struct {
+	Result bool
+}
+
+func revokeCall(stateWriter Interface, caller crypto.Address, gas *uint64, logger *logging.Logger,
+	a interface{}) (interface{}, error) {
+	args := a.(*revokeCallArgs)
+	result, err := removeCallGrant(stateWriter, logger, args.Contract, permission.AccountCallGrant(args.Account,
+		functionSelector(args.Function)))
+	if err != nil {
+		return revokeCallRets{}, err
+	}
+	return revokeCallRets{Result: result}, nil
+}
+
+type revokeRoleCallArgs struct {
+	Contract crypto.Address
+	Role     string
+	Function abi.FunctionID
+}
+
+type revokeRoleCallRets struct {
+	Result bool
+}
+
+func revokeRoleCall(stateWriter Interface, caller crypto.Address, gas *uint64, logger *logging.Logger,
+	a interface{}) (interface{}, error) {
+	args := a.(*revokeRoleCallArgs)
+	result, err := removeCallGrant(stateWriter, logger, args.Contract, permission.RoleCallGrant(args.Role,
+		functionSelector(args.Function)))
+	if err != nil {
+		return revokeRoleCallRets{}, err
+	}
+	return revokeRoleCallRets{Result: result}, nil
+}
+
+type canCallArgs struct {
+	Contract crypto.Address
+	Account  crypto.Address
+	Function abi.FunctionID
+}
+
+type canCallRets struct {
+	Result bool
+}
+
+func canCall(st Interface, caller crypto.Address, gas *uint64, logger *logging.Logger,
+	a interface{}) (interface{}, error) {
+	args := a.(*canCallArgs)
+
+	if !st.Exists(args.Contract) {
+		return canCallRets{}, fmt.Errorf("unknown account %s", args.Contract)
+	}
+	permitted := CanCall(st, args.Account, args.Contract, functionSelector(args.Function))
+	logger.Trace.Log("function", "canCall",
+		"contract", args.Contract.String(),
+		"address", args.Account.String(),
+		"function", functionSelector(args.Function),
+		"can_call", permitted)
+	return canCallRets{Result: permitted}, nil
+}
+
+func addCallGrant(stateWriter Interface, logger *logging.Logger, contract crypto.Address,
+	grant permission.CallGrant) (bool, error) {
+	if !stateWriter.Exists(contract) {
+		return false, fmt.Errorf("unknown account %s", contract)
+	}
+	if err := grant.EnsureValid(); err != nil {
+		return false, err
+	}
+	grantAdded := stateWriter.AddCallGrant(contract, grant)
+	logger.Trace.Log("function", "addCallGrant",
+		"contract", contract.String(),
+		"grant", grant.String(),
+		"grant_added", grantAdded)
+	return grantAdded, nil
+}
+
+func removeCallGrant(stateWriter Interface, logger *logging.Logger, contract crypto.Address,
+	grant permission.CallGrant) (bool, error) {
+	if !stateWriter.Exists(contract) {
+		return false, fmt.Errorf("unknown account %s", contract)
+	}
+	grantRemoved := stateWriter.RemoveCallGrant(contract, grant)
+	logger.Trace.Log("function", "removeCallGrant",
+		"contract", contract.String(),
+		"grant", grant.String(),
+		"grant_removed", grantRemoved)
+	return grantRemoved, nil
+}
+
+// A zero function ID passed to an SNative stands for any function (or no function)
+func functionSelector(id abi.FunctionID) []byte {
+	if id == (abi.FunctionID{}) {
+		return nil
+	}
+	return id[:]
+}
@@ -46,6 +46,11 @@ dbd4a8ea setBase(address,uint64,bool)
 b7d4dc0d unsetBase(address,uint64)
 225b6574 hasBase(address,uint64)
 c4bc7b70 setGlobal(uint64,bool)
+56fa94a3 grantCall(address,address,bytes4)
+fd70f5d6 grantRoleCall(address,string,bytes4)
+7197d736 revokeCall(address,address,bytes4)
+cc340ab0 revokeRoleCall(address,string,bytes4)
+b7009613 canCall(address,address,bytes4)
 `
 
 func TestPermissionsContractSignatures(t *testing.T) {
@@ -102,6 +107,51 @@ func TestSNativeContractDescription_Dispatch(t *testing.T) {
 	assert.Equal(t, retValue, LeftPadBytes([]byte{1}, 32))
 }
 
+func TestSNativeCallGrants(t *testing.T) {
+	contract := SNativeContracts()["Permissions"]
+	st := newAppState()
+	caller := &acm.Account{
+		Address:     crypto.Address{1, 1, 1},
+		Permissions: allAccountPermissions(),
+	}
+	callee := &acm.Account{
+		Address: crypto.Address{2, 2, 2},
+		Code:    acm.Bytecode{0},
+	}
+	require.NoError(t, st.UpdateAccount(caller))
+	require.NoError(t, st.UpdateAccount(callee))
+	cache := NewState(st, blockHashGetter)
+	selector := []byte{1, 2, 3, 4}
+	gas := uint64(1000)
+
+	dispatch := func(name string, args ...interface{}) []byte {
+		function, err := contract.FunctionByName(name)
+		require.NoError(t, err)
+		funcID := function.Abi.FunctionID
+		ret, err := contract.Dispatch(cache, caller.Address, bc.MustSplice(append([]interface{}{funcID[:]},
+			args...)...), &gas, logger)
+		require.NoError(t, err)
+		return ret
+	}
+	canCall := func() []byte {
+		return dispatch("canCall", callee.Address.Word256(), caller.Address.Word256(), RightPadWord256(selector))
+	}
+	yes, no := LeftPadBytes([]byte{1}, 32), LeftPadBytes([]byte{0}, 32)
+
+	assert.Equal(t, yes, canCall())
+	assert.Equal(t, yes, dispatch("grantRoleCall", callee.Address.Word256(), Int64ToWord256(0x60),
+		RightPadWord256(selector), Int64ToWord256(7), RightPadWord256([]byte("partner"))))
+	assert.Equal(t, no, canCall())
+	assert.Equal(t, yes, dispatch("grantCall", callee.Address.Word256(), caller.Address.Word256(), Zero256))
+	assert.Equal(t, no, dispatch("grantCall", callee.Address.Word256(), caller.Address.Word256(), Zero256))
+	assert.Equal(t, yes, canCall())
+	assert.Equal(t, yes, dispatch("revokeCall", callee.Address.Word256(), caller.Address.Word256(), Zero256))
+	assert.Equal(t, no, canCall())
+
+	assert.Equal(t, []permission.CallGrant{permission.RoleCallGrant("partner", selector)},
+		cache.GetPermissions(callee.Address).CallACL)
+}
+
 func TestSNativeContractDescription_Address(t *testing.T) {
 	contract := NewSNativeContract("A comment",
 		"CoolButVeryLongNamedContractOfDoom")
@@ -45,6 +45,8 @@ type Writer interface {
 	UnsetPermission(address crypto.Address, permFlag permission.PermFlag)
 	AddRole(address crypto.Address, role string) bool
 	RemoveRole(address crypto.Address, role string) bool
+	AddCallGrant(address crypto.Address, grant permission.CallGrant) bool
+	RemoveCallGrant(address crypto.Address, grant permission.CallGrant) bool
 }
 
 type State struct {
@@ -226,6 +228,7 @@ func (st *State) SetPermission(address crypto.Address, permFlag permission.PermF
 	if acc == nil {
 		return
 	}
+	acc.Permissions.RevokeGrants(permFlag)
 	st.PushError(acc.Permissions.Base.Set(permFlag, value))
 	st.updateAccount(acc)
 }
@@ -235,6 +238,7 @@ func (st *State) UnsetPermission(address crypto.Address, permFlag permission.Per
 	if acc == nil {
 		return
 	}
+	acc.Permissions.RevokeGrants(permFlag)
 	st.PushError(acc.Permissions.Base.Unset(permFlag))
 	st.updateAccount(acc)
 }
@@ -259,6 +263,26 @@ func (st *State) RemoveRole(address crypto.Address, role string) bool {
 	return removed
 }
 
+func (st *State) AddCallGrant(address crypto.Address, grant permission.CallGrant) bool {
+	acc := st.mustAccount(address)
+	if acc == nil {
+		return false
+	}
+	added := acc.Permissions.AddCallGrant(grant)
+	st.updateAccount(acc)
+	return added
+}
+
+func (st *State) RemoveCallGrant(address crypto.Address, grant permission.CallGrant) bool {
+	acc := st.mustAccount(address)
+	if acc == nil {
+		return false
+	}
+	removed := acc.Permissions.RemoveCallGrant(grant)
+	st.updateAccount(acc)
+	return removed
+}
+
 func (st *State) GetBlockHash(height uint64) (binary.Word256, error) {
 	hash := st.blockHashGetter(height)
 	if len(hash) == 0 {
@@ -115,6 +115,27 @@ func EnsurePermission(st Interface, address crypto.Address, perm permission.Perm
 	}
 }
 
+// A callee with a call ACL may only be called by the accounts and role holders it grants (which need not have the Call
+// permission), any other callee by accounts with the Call permission
+func CanCall(st Interface, caller, callee crypto.Address, input []byte) bool {
+	calleePerms := st.GetPermissions(callee)
+	if !calleePerms.HasCallACL() {
+		return HasPermission(st, caller, permission.Call)
+	}
+	return calleePerms.PermitsCall(caller, st.GetPermissions(caller), input)
+}
+
+func EnsureCallPermission(st Interface, caller, callee crypto.Address, input []byte) {
+	if !st.GetPermissions(callee).HasCallACL() {
+		EnsurePermission(st, caller, permission.Call)
+	} else if !CanCall(st, caller, callee, input) {
+		st.PushError(errors.CallNotGranted{
+			Caller: caller,
+			Callee: callee,
+		})
+	}
+}
+
 func (vm *VM) fireCallEvent(eventSink EventSink, callType exec.CallType, errProvider errors.Provider, output *[]byte,
 	callerAddress, calleeAddress crypto.Address, input []byte, value uint64, gas *uint64, errSink errors.Sink) {
 	// fire the post call event (including exception if applicable)
@@ -143,6 +164,8 @@ func (vm *VM) fireCallEvent(eventSink EventSink, callType exec.CallType, errProv
 func (vm *VM) Call(callState Interface, eventSink EventSink, caller, callee crypto.Address, code,
 	input []byte, value uint64, gas *uint64) (output []byte, err errors.CodedError) {
 
+	// Permission grants count until they expire at the block being executed
+	callState = newBlockState(callState, vm.params.BlockHeight, vm.params.BlockTime)
 	// Always return output - we may have a reverted exception for which the return is meaningful
 	output, err = vm.call(callState, eventSink, caller, callee, code, input, value, gas, exec.CallTypeCall)
 	if err == nil {
@@ -825,10 +848,6 @@ func (vm *VM) execute(callState Interface, eventSink EventSink, caller, callee c
 		case CALL, CALLCODE, DELEGATECALL, STATICCALL: // 0xF1, 0xF2, 0xF4, 0xFA
 			returnData = nil
 
-			EnsurePermission(callState, callee, permission.Call)
-			if callState.Error() != nil {
-				continue
-			}
 			gasLimit := stack.PopU64()
 			address := stack.PopAddress()
 			// NOTE: for DELEGATECALL value is preserved from the original
@@ -849,6 +868,11 @@ func (vm *VM) execute(callState Interface, eventSink EventSink, caller, callee c
 			// Get the arguments from the memory
 			args := memory.Read(inOffset, inSize)
 
+			EnsureCallPermission(callState, callee, address, args)
+			if callState.Error() != nil {
+				continue
+			}
+
 			// Ensure that gasLimit is reasonable
 			if *gas < gasLimit {
 				// EIP150 - the 63/64 rule - rather than errors.CodedError we pass this specified fraction of the total available gas
@@ -1342,6 +1342,90 @@ func TestHasPermission(t *testing.T) {
 	require.NoError(t, cache.Error())
 }
 
+func TestCallACL(t *testing.T) {
+	cache := NewState(newAppState(), blockHashGetter)
+	ourVm := NewVM(newParams(), crypto.ZeroAddress, nil, logger)
+
+	account1 := newAccount(cache, "1")
+	account2 := makeAccountWithCode(cache, "2", nil)
+	account3 := makeAccountWithCode(cache, "3", MustSplice(PUSH1, 1, return1()))
+	require.NoError(t, cache.Sync())
+
+	selector := []byte{1, 2, 3, 4}
+	callCode := func(input []byte) []byte {
+		return MustSplice(PUSH32, RightPadBytes(input, 32), PUSH1, 0, MSTORE, PUSH1, 32, PUSH1, 0, PUSH1,
+			len(input), PUSH1, 0, PUSH1, 0, PUSH20, account3, PUSH2, 0, 0xff, CALL, PUSH1, 32, PUSH1, 0, RETURN)
+	}
+
+	// Without an ACL the Call permission decides
+	txe := runVM(cache.NewCache(), ourVm, account1, account2, callCode(selector), 100000)
+	require.Nil(t, txe.Exception)
+
+	// A grant to a role on a particular function excludes everyone else, even with the Call permission
+	require.True(t, cache.AddCallGrant(account3, permission.RoleCallGrant("partner", selector)))
+	txe = runVM(cache.NewCache(), ourVm, account1, account2, callCode(selector), 100000)
+	assertErrorCode(t, errors.ErrorCodePermissionDenied, txe.Exception)
+
+	// Holding the role, but calling another function
+	require.True(t, cache.AddRole(account2, "partner"))
+	txe = runVM(cache.NewCache(), ourVm, account1, account2, callCode([]byte{4, 3, 2, 1}), 100000)
+	assertErrorCode(t, errors.ErrorCodePermissionDenied, txe.Exception)
+
+	// Granted calls do not need the Call permission
+	cache.SetPermission(account2, permission.Call, false)
+	txe = runVM(cache.NewCache(), ourVm, account1, account2, callCode(selector), 100000)
+	require.Nil(t, txe.Exception)
+	assert.Equal(t, LeftPadBytes([]byte{1}, 32), txe.Result.Return)
+	assert.True(t, CanCall(cache, account2, account3, selector))
+	assert.False(t, CanCall(cache, account1, account3, selector))
+
+	// Revoking the last grant falls back to the Call permission
+	require.True(t, cache.RemoveCallGrant(account3, permission.RoleCallGrant("partner", selector)))
+	txe = runVM(cache.NewCache(), ourVm, account1, account2, callCode(selector), 100000)
+	assert.NotNil(t, txe.Exception)
+	require.NoError(t, cache.Error())
+}
+
+func TestPermissionGrants(t *testing.T) {
+	st := newAppState()
+	cache := NewState(st, blockHashGetter)
+	account1 := newAccount(cache, "1")
+	account2 := makeAccountWithCode(cache, "2", nil)
+	account3 := makeAccountWithCode(cache, "3", MustSplice(PUSH1, 1, return1()))
+	cache.SetPermission(account2, permission.Call, false)
+	require.NoError(t, cache.Sync())
+
+	callCode := MustSplice(PUSH1, 0, PUSH1, 0, PUSH1, 0, PUSH1, 0, PUSH1, 0, PUSH20, account3, PUSH2, 0, 0xff, CALL,
+		PUSH1, 32, PUSH1, 0, RETURN)
+	params := newParams()
+	params.BlockHeight = 10
+
+	txe := runVM(cache.NewCache(), NewVM(params, crypto.ZeroAddress, nil, logger), account1, account2, callCode, 100000)
+	require.NotNil(t, txe.Exception)
+	assert.Contains(t, txe.Exception.Error(), "does not have permission call")
+
+	// Grant Call until block 11
+	acc, err := st.GetAccount(account2)
+	require.NoError(t, err)
+	acc.Permissions.AddGrant(permission.TemporaryGrant(permission.Call, 11, 0), params.BlockHeight, 0)
+	require.NoError(t, st.UpdateAccount(acc))
+	cache = NewState(st, blockHashGetter)
+
+	txe = runVM(cache.NewCache(), NewVM(params, crypto.ZeroAddress, nil, logger), account1, account2, callCode, 100000)
+	require.Nil(t, txe.Exception)
+	assert.True(t, HasPermission(newBlockState(cache, params.BlockHeight, 0), account2, permission.Call))
+
+	params.BlockHeight = 11
+	txe = runVM(cache.NewCache(), NewVM(params, crypto.ZeroAddress, nil, logger), account1, account2, callCode, 100000)
+	require.NotNil(t, txe.Exception)
+	assert.Contains(t, txe.Exception.Error(), "does not have permission call")
+
+	// Setting the permission outright drops the grant
+	cache.SetPermission(account2, permission.Call, false)
+	assert.Empty(t, cache.GetPermissions(account2).Grants)
+	require.NoError(t, cache.Error())
+}
+
 func TestDataStackOverflow(t *testing.T) {
 	st := newAppState()
 	cache := NewState(st, blockHashGetter)
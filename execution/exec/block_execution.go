@@ -31,6 +31,12 @@ func (be *BlockExecution) StreamEvents() StreamEvents {
 	for _, txe := range be.TxExecutions {
 		ses = append(ses, txe.StreamEvents()...)
 	}
+	// Events outside any transaction follow the block's transactions
+	for _, ev := range be.Events {
+		ses = append(ses, &StreamEvent{
+			Event: ev,
+		})
+	}
 	return append(ses, &StreamEvent{
 		EndBlock: &EndBlock{
 			Height: be.Height,
@@ -60,6 +66,19 @@ func (be *BlockExecution) Tx(txEnv *txs.Envelope) *TxExecution {
 	return txe
 }
 
+// Emit an event from the block itself rather than one of its transactions
+func (be *BlockExecution) Proposal(proposal *ProposalEvent) {
+	be.Events = append(be.Events, &Event{
+		Header: &Header{
+			EventType: TypeProposal,
+			EventID:   EventStringProposal(proposal.ProposalHash),
+			Height:    be.Height,
+			Index:     uint64(len(be.Events)),
+		},
+		Proposal: proposal,
+	})
+}
+
 func (be *BlockExecution) AppendTxs(tail ...*TxExecution) {
 	for i, txe := range tail {
 		txe.Index = uint64(len(be.TxExecutions) + i)
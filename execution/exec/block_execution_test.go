@@ -3,6 +3,7 @@ package exec
 import (
 	"testing"
 
+	"github.com/hyperledger/burrow/txs/payload"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/abci/types"
 )
@@ -20,3 +21,21 @@ func TestBlockExecution_Marshal(t *testing.T) {
 	beOut := new(BlockExecution)
 	require.NoError(t, beOut.Unmarshal(bs))
 }
+
+func TestBlockExecution_StreamEvents(t *testing.T) {
+	be := &BlockExecution{Height: 3}
+	be.Proposal(&ProposalEvent{ProposalHash: []byte{1, 2, 3}, ProposalState: payload.Ballot_EXPIRED})
+	ses := be.StreamEvents()
+	require.Len(t, ses, 3)
+
+	beOut, err := ConsumeBlockExecution(&ses)
+	require.NoError(t, err)
+	require.Equal(t, be.Events, beOut.Events)
+	require.Equal(t, uint64(3), beOut.Events[0].Header.Height)
+
+	// Transaction consumers skip events of the block itself
+	var stack TxStack
+	for _, ev := range be.StreamEvents() {
+		require.Nil(t, stack.Consume(ev))
+	}
+}
@@ -28,6 +28,7 @@ const (
 	TypeEnvelope
 	TypeEndTx
 	TypeEndBlock
+	TypeProposal
 )
 
 var nameFromType = map[EventType]string{
@@ -41,6 +42,7 @@ var nameFromType = map[EventType]string{
 	TypeGovernAccount:  "GovernAccountEvent",
 	TypeBeginBlock:     "BeginBlockEvent",
 	TypeEndBlock:       "EndBlockEvent",
+	TypeProposal:       "ProposalEvent",
 }
 
 var typeFromName = make(map[string]EventType)
@@ -95,6 +97,9 @@ func (ev *Event) Body() string {
 	if ev.Call != nil {
 		return ev.Call.String()
 	}
+	if ev.Proposal != nil {
+		return ev.Proposal.String()
+	}
 	return "<empty>"
 }
 
@@ -24,6 +24,7 @@
 		LogEvent
 		CallEvent
 		GovernAccountEvent
+		ProposalEvent
 		InputEvent
 		OutputEvent
 		CallData
@@ -42,6 +43,7 @@ import names "github.com/hyperledger/burrow/execution/names"
 import txs "github.com/hyperledger/burrow/txs"
 import permission "github.com/hyperledger/burrow/permission"
 import spec "github.com/hyperledger/burrow/genesis/spec"
+import payload "github.com/hyperledger/burrow/txs/payload"
 
 import github_com_hyperledger_burrow_txs "github.com/hyperledger/burrow/txs"
 import github_com_hyperledger_burrow_binary "github.com/hyperledger/burrow/binary"
@@ -306,6 +308,8 @@ type BlockExecution struct {
 	Height       uint64         `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
 	Header       *types.Header  `protobuf:"bytes,2,opt,name=Header" json:"Header,omitempty"`
 	TxExecutions []*TxExecution `protobuf:"bytes,3,rep,name=TxExecutions" json:"TxExecutions,omitempty"`
+	// Events that do not belong to a transaction, such as proposals expiring at the end of the block
+	Events []*Event `protobuf:"bytes,4,rep,name=Events" json:"Events,omitempty"`
 }
 
 func (m *BlockExecution) Reset()                    { *m = BlockExecution{} }
@@ -334,6 +338,13 @@ func (m *BlockExecution) GetTxExecutions() []*TxExecution {
 	return nil
 }
 
+func (m *BlockExecution) GetEvents() []*Event {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
 func (*BlockExecution) XXX_MessageName() string {
 	return "exec.BlockExecution"
 }
@@ -520,6 +531,7 @@ type Event struct {
 	Call          *CallEvent          `protobuf:"bytes,4,opt,name=Call" json:"Call,omitempty"`
 	Log           *LogEvent           `protobuf:"bytes,5,opt,name=Log" json:"Log,omitempty"`
 	GovernAccount *GovernAccountEvent `protobuf:"bytes,6,opt,name=GovernAccount" json:"GovernAccount,omitempty"`
+	Proposal      *ProposalEvent      `protobuf:"bytes,7,opt,name=Proposal" json:"Proposal,omitempty"`
 }
 
 func (m *Event) Reset()                    { *m = Event{} }
@@ -568,6 +580,13 @@ func (m *Event) GetGovernAccount() *GovernAccountEvent {
 	return nil
 }
 
+func (m *Event) GetProposal() *ProposalEvent {
+	if m != nil {
+		return m.Proposal
+	}
+	return nil
+}
+
 func (*Event) XXX_MessageName() string {
 	return "exec.Event"
 }
@@ -694,6 +713,28 @@ func (*GovernAccountEvent) XXX_MessageName() string {
 	return "exec.GovernAccountEvent"
 }
 
+// Emitted when a proposal is created and each time its state changes
+type ProposalEvent struct {
+	ProposalHash  github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,1,opt,name=ProposalHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"ProposalHash"`
+	ProposalState payload.Ballot_ProposalState                  `protobuf:"varint,2,opt,name=ProposalState,proto3,enum=payload.Ballot_ProposalState" json:"ProposalState,omitempty"`
+}
+
+func (m *ProposalEvent) Reset()                    { *m = ProposalEvent{} }
+func (m *ProposalEvent) String() string            { return proto.CompactTextString(m) }
+func (*ProposalEvent) ProtoMessage()               {}
+func (*ProposalEvent) Descriptor() ([]byte, []int) { return fileDescriptorExec, []int{16} }
+
+func (m *ProposalEvent) GetProposalState() payload.Ballot_ProposalState {
+	if m != nil {
+		return m.ProposalState
+	}
+	return payload.Ballot_PROPOSED
+}
+
+func (*ProposalEvent) XXX_MessageName() string {
+	return "exec.ProposalEvent"
+}
+
 type InputEvent struct {
 	Address github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
 }
@@ -701,7 +742,7 @@ type InputEvent struct {
 func (m *InputEvent) Reset()                    { *m = InputEvent{} }
 func (m *InputEvent) String() string            { return proto.CompactTextString(m) }
 func (*InputEvent) ProtoMessage()               {}
-func (*InputEvent) Descriptor() ([]byte, []int) { return fileDescriptorExec, []int{16} }
+func (*InputEvent) Descriptor() ([]byte, []int) { return fileDescriptorExec, []int{17} }
 
 func (*InputEvent) XXX_MessageName() string {
 	return "exec.InputEvent"
@@ -714,7 +755,7 @@ type OutputEvent struct {
 func (m *OutputEvent) Reset()                    { *m = OutputEvent{} }
 func (m *OutputEvent) String() string            { return proto.CompactTextString(m) }
 func (*OutputEvent) ProtoMessage()               {}
-func (*OutputEvent) Descriptor() ([]byte, []int) { return fileDescriptorExec, []int{17} }
+func (*OutputEvent) Descriptor() ([]byte, []int) { return fileDescriptorExec, []int{18} }
 
 func (*OutputEvent) XXX_MessageName() string {
 	return "exec.OutputEvent"
@@ -731,7 +772,7 @@ type CallData struct {
 func (m *CallData) Reset()                    { *m = CallData{} }
 func (m *CallData) String() string            { return proto.CompactTextString(m) }
 func (*CallData) ProtoMessage()               {}
-func (*CallData) Descriptor() ([]byte, []int) { return fileDescriptorExec, []int{18} }
+func (*CallData) Descriptor() ([]byte, []int) { return fileDescriptorExec, []int{19} }
 
 func (m *CallData) GetValue() uint64 {
 	if m != nil {
@@ -783,6 +824,8 @@ func init() {
 	golang_proto.RegisterType((*CallEvent)(nil), "exec.CallEvent")
 	proto.RegisterType((*GovernAccountEvent)(nil), "exec.GovernAccountEvent")
 	golang_proto.RegisterType((*GovernAccountEvent)(nil), "exec.GovernAccountEvent")
+	proto.RegisterType((*ProposalEvent)(nil), "exec.ProposalEvent")
+	golang_proto.RegisterType((*ProposalEvent)(nil), "exec.ProposalEvent")
 	proto.RegisterType((*InputEvent)(nil), "exec.InputEvent")
 	golang_proto.RegisterType((*InputEvent)(nil), "exec.InputEvent")
 	proto.RegisterType((*OutputEvent)(nil), "exec.OutputEvent")
@@ -1119,6 +1162,18 @@ func (m *BlockExecution) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.Events) > 0 {
+		for _, msg := range m.Events {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintExec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -1393,6 +1448,16 @@ func (m *Event) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n28
 	}
+	if m.Proposal != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintExec(dAtA, i, uint64(m.Proposal.Size()))
+		n29, err := m.Proposal.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n29
+	}
 	return i, nil
 }
 
@@ -1426,21 +1491,21 @@ func (m *Result) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintExec(dAtA, i, uint64(m.NameEntry.Size()))
-		n29, err := m.NameEntry.MarshalTo(dAtA[i:])
+		n30, err := m.NameEntry.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n29
+		i += n30
 	}
 	if m.PermArgs != nil {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintExec(dAtA, i, uint64(m.PermArgs.Size()))
-		n30, err := m.PermArgs.MarshalTo(dAtA[i:])
+		n31, err := m.PermArgs.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n30
+		i += n31
 	}
 	return i, nil
 }
@@ -1463,19 +1528,19 @@ func (m *LogEvent) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintExec(dAtA, i, uint64(m.Address.Size()))
-	n31, err := m.Address.MarshalTo(dAtA[i:])
+	n32, err := m.Address.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n31
+	i += n32
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintExec(dAtA, i, uint64(m.Data.Size()))
-	n32, err := m.Data.MarshalTo(dAtA[i:])
+	n33, err := m.Data.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n32
+	i += n33
 	if len(m.Topics) > 0 {
 		for _, msg := range m.Topics {
 			dAtA[i] = 0x1a
@@ -1510,20 +1575,20 @@ func (m *CallEvent) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintExec(dAtA, i, uint64(m.CallData.Size()))
-		n33, err := m.CallData.MarshalTo(dAtA[i:])
+		n34, err := m.CallData.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n33
+		i += n34
 	}
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintExec(dAtA, i, uint64(m.Origin.Size()))
-	n34, err := m.Origin.MarshalTo(dAtA[i:])
+	n35, err := m.Origin.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n34
+	i += n35
 	if m.StackDepth != 0 {
 		dAtA[i] = 0x18
 		i++
@@ -1532,11 +1597,11 @@ func (m *CallEvent) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x22
 	i++
 	i = encodeVarintExec(dAtA, i, uint64(m.Return.Size()))
-	n35, err := m.Return.MarshalTo(dAtA[i:])
+	n36, err := m.Return.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n35
+	i += n36
 	if m.CallType != 0 {
 		dAtA[i] = 0x28
 		i++
@@ -1564,11 +1629,42 @@ func (m *GovernAccountEvent) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintExec(dAtA, i, uint64(m.AccountUpdate.Size()))
-		n36, err := m.AccountUpdate.MarshalTo(dAtA[i:])
+		n37, err := m.AccountUpdate.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n36
+		i += n37
+	}
+	return i, nil
+}
+
+func (m *ProposalEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalEvent) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintExec(dAtA, i, uint64(m.ProposalHash.Size()))
+	n38, err := m.ProposalHash.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n38
+	if m.ProposalState != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintExec(dAtA, i, uint64(m.ProposalState))
 	}
 	return i, nil
 }
@@ -1591,11 +1687,11 @@ func (m *InputEvent) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintExec(dAtA, i, uint64(m.Address.Size()))
-	n37, err := m.Address.MarshalTo(dAtA[i:])
+	n39, err := m.Address.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n37
+	i += n39
 	return i, nil
 }
 
@@ -1617,11 +1713,11 @@ func (m *OutputEvent) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintExec(dAtA, i, uint64(m.Address.Size()))
-	n38, err := m.Address.MarshalTo(dAtA[i:])
+	n40, err := m.Address.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n38
+	i += n40
 	return i, nil
 }
 
@@ -1643,27 +1739,27 @@ func (m *CallData) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintExec(dAtA, i, uint64(m.Caller.Size()))
-	n39, err := m.Caller.MarshalTo(dAtA[i:])
+	n41, err := m.Caller.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n39
+	i += n41
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintExec(dAtA, i, uint64(m.Callee.Size()))
-	n40, err := m.Callee.MarshalTo(dAtA[i:])
+	n42, err := m.Callee.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n40
+	i += n42
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintExec(dAtA, i, uint64(m.Data.Size()))
-	n41, err := m.Data.MarshalTo(dAtA[i:])
+	n43, err := m.Data.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n41
+	i += n43
 	if m.Value != 0 {
 		dAtA[i] = 0x20
 		i++
@@ -1813,6 +1909,12 @@ func (m *BlockExecution) Size() (n int) {
 			n += 1 + l + sovExec(uint64(l))
 		}
 	}
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovExec(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1927,6 +2029,10 @@ func (m *Event) Size() (n int) {
 		l = m.GovernAccount.Size()
 		n += 1 + l + sovExec(uint64(l))
 	}
+	if m.Proposal != nil {
+		l = m.Proposal.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
 	return n
 }
 
@@ -1997,6 +2103,17 @@ func (m *GovernAccountEvent) Size() (n int) {
 	return n
 }
 
+func (m *ProposalEvent) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ProposalHash.Size()
+	n += 1 + l + sovExec(uint64(l))
+	if m.ProposalState != 0 {
+		n += 1 + sovExec(uint64(m.ProposalState))
+	}
+	return n
+}
+
 func (m *InputEvent) Size() (n int) {
 	var l int
 	_ = l
@@ -2109,6 +2226,9 @@ func (this *Event) GetValue() interface{} {
 	if this.GovernAccount != nil {
 		return this.GovernAccount
 	}
+	if this.Proposal != nil {
+		return this.Proposal
+	}
 	return nil
 }
 
@@ -2126,6 +2246,8 @@ func (this *Event) SetValue(value interface{}) bool {
 		this.Log = vt
 	case *GovernAccountEvent:
 		this.GovernAccount = vt
+	case *ProposalEvent:
+		this.Proposal = vt
 	default:
 		return false
 	}
@@ -3149,6 +3271,37 @@ func (m *BlockExecution) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, &Event{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExec(dAtA[iNdEx:])
@@ -4039,6 +4192,39 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proposal == nil {
+				m.Proposal = &ProposalEvent{}
+			}
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExec(dAtA[iNdEx:])
@@ -4632,6 +4818,105 @@ func (m *GovernAccountEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ProposalEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProposalHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalState", wireType)
+			}
+			m.ProposalState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalState |= (payload.Ballot_ProposalState(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *InputEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5079,83 +5364,88 @@ func init() { proto.RegisterFile("exec.proto", fileDescriptorExec) }
 func init() { golang_proto.RegisterFile("exec.proto", fileDescriptorExec) }
 
 var fileDescriptorExec = []byte{
-	// 1241 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x4f, 0x73, 0xdb, 0x44,
-	0x14, 0xaf, 0x6c, 0xf9, 0xdf, 0xda, 0x29, 0x61, 0x27, 0x30, 0x9e, 0x1c, 0xec, 0xa0, 0x42, 0x29,
-	0xa1, 0x95, 0x3b, 0x81, 0xf0, 0x27, 0xcc, 0x30, 0x13, 0x37, 0x99, 0x24, 0x34, 0xb4, 0xb0, 0x75,
-	0xcb, 0xc0, 0xc0, 0x41, 0x96, 0x16, 0x59, 0x53, 0x5b, 0xd2, 0x48, 0xeb, 0x20, 0x7f, 0x01, 0x0e,
-	0x0c, 0x07, 0xb8, 0x95, 0x0b, 0xd3, 0xef, 0xc1, 0x85, 0x63, 0x6e, 0xf4, 0xdc, 0x83, 0x61, 0xd2,
-	0x4f, 0xc0, 0x70, 0x22, 0x27, 0x66, 0x77, 0xdf, 0xca, 0x12, 0x4d, 0x93, 0x0e, 0xce, 0x81, 0x8b,
-	0x66, 0xdf, 0x7b, 0xbf, 0x7d, 0x7a, 0xef, 0xed, 0xef, 0xbd, 0x5d, 0x84, 0x68, 0x42, 0x6d, 0x33,
-	0x8c, 0x02, 0x16, 0x60, 0x9d, 0xaf, 0x97, 0xaf, 0xb9, 0x1e, 0x1b, 0x8c, 0xfb, 0xa6, 0x1d, 0x8c,
-	0x3a, 0x6e, 0xe0, 0x06, 0x1d, 0x61, 0xec, 0x8f, 0xbf, 0x16, 0x92, 0x10, 0xc4, 0x4a, 0x6e, 0x5a,
-	0x7e, 0x37, 0x03, 0x67, 0xd4, 0x77, 0x68, 0x34, 0xf2, 0x7c, 0x96, 0x5d, 0x5a, 0x7d, 0xdb, 0xeb,
-	0xb0, 0x49, 0x48, 0x63, 0xf9, 0x85, 0x8d, 0x6d, 0x37, 0x08, 0xdc, 0x21, 0x9d, 0xb9, 0x67, 0xde,
-	0x88, 0xc6, 0xcc, 0x1a, 0x85, 0x00, 0x68, 0xd0, 0x28, 0x0a, 0x22, 0x05, 0xaf, 0xfb, 0xd6, 0x28,
-	0xdd, 0x5b, 0x63, 0x89, 0x5a, 0x2e, 0x86, 0xfc, 0x37, 0x71, 0xec, 0x05, 0x3e, 0x68, 0x50, 0x1c,
-	0xaa, 0x94, 0x8c, 0x5f, 0x0a, 0xa8, 0x7e, 0x87, 0x45, 0xd4, 0x1a, 0x6d, 0x1f, 0x50, 0x9f, 0xe1,
-	0xeb, 0x08, 0x75, 0xa9, 0xeb, 0xf9, 0xdd, 0x61, 0x60, 0xdf, 0x6f, 0x6a, 0x2b, 0xda, 0x95, 0xfa,
-	0xda, 0xa2, 0x29, 0x6a, 0x30, 0xd3, 0x93, 0x0c, 0x06, 0xbf, 0x8e, 0x2a, 0x42, 0xea, 0x25, 0xcd,
-	0x82, 0x80, 0x2f, 0x64, 0xe0, 0xbd, 0x84, 0x28, 0x2b, 0xfe, 0x1c, 0x55, 0xb7, 0xfd, 0x03, 0x3a,
-	0x0c, 0x42, 0xda, 0x2c, 0x02, 0x92, 0x87, 0xa9, 0x94, 0x5d, 0xf3, 0xf1, 0xb4, 0xbd, 0x9a, 0xa9,
-	0xd6, 0x60, 0x12, 0xd2, 0x68, 0x48, 0x1d, 0x97, 0x46, 0x9d, 0xfe, 0x38, 0x8a, 0x82, 0x6f, 0x3a,
-	0x59, 0x3c, 0x49, 0xdd, 0xe1, 0x57, 0x50, 0x49, 0x84, 0xdf, 0xd4, 0x85, 0xdf, 0xba, 0x8c, 0x40,
-	0xa8, 0x88, 0xb4, 0x08, 0x88, 0xef, 0xf4, 0x92, 0x66, 0x29, 0x07, 0xe1, 0x2a, 0x22, 0x2d, 0x78,
-	0x95, 0x07, 0xe8, 0xc8, 0xcc, 0xcb, 0x02, 0x75, 0x31, 0x45, 0xc9, 0xbc, 0x53, 0xfb, 0x86, 0x7e,
-	0xf8, 0xb0, 0xad, 0x19, 0xef, 0xa3, 0x9a, 0x2c, 0xde, 0x4d, 0x3a, 0xc1, 0x2f, 0xa3, 0xf2, 0x2e,
-	0xf5, 0xdc, 0x01, 0x13, 0x65, 0xd3, 0x09, 0x48, 0x78, 0x09, 0x95, 0xf6, 0x7c, 0x87, 0xca, 0xf2,
-	0xe8, 0x44, 0x0a, 0xc6, 0xcd, 0x6c, 0xa1, 0x9f, 0xb9, 0xf7, 0x35, 0xae, 0xb7, 0x1c, 0x1a, 0xa5,
-	0xb5, 0x95, 0x0c, 0x91, 0x4a, 0x02, 0x46, 0xc3, 0x98, 0x45, 0xfe, 0x2c, 0x57, 0xc6, 0xf7, 0x5a,
-	0x7a, 0x50, 0x3c, 0xd3, 0x5e, 0x02, 0x8e, 0xb5, 0x6c, 0xa6, 0x4a, 0x4b, 0x52, 0x3b, 0x7e, 0x15,
-	0x95, 0x09, 0x8d, 0xc7, 0x43, 0x06, 0x21, 0x34, 0x24, 0x52, 0xea, 0x08, 0xd8, 0x70, 0x07, 0xd5,
-	0xb6, 0x13, 0x9b, 0x86, 0xcc, 0x0b, 0x7c, 0x38, 0x85, 0x17, 0x4d, 0xe0, 0x67, 0x6a, 0x20, 0x33,
-	0x8c, 0x71, 0x0f, 0xce, 0x03, 0x7f, 0x8c, 0xca, 0xbd, 0x64, 0xd7, 0x8a, 0x07, 0x82, 0x14, 0x8d,
-	0xee, 0xfa, 0xe1, 0xb4, 0x7d, 0xe1, 0xf1, 0xb4, 0x7d, 0xed, 0x74, 0x26, 0xf4, 0x3d, 0xdf, 0x8a,
-	0x26, 0xe6, 0x2e, 0x4d, 0xba, 0x13, 0x46, 0x63, 0x02, 0x4e, 0x8c, 0xbf, 0xb5, 0x59, 0x6e, 0xf8,
-	0x23, 0xee, 0xbb, 0x37, 0x09, 0xa9, 0xc8, 0x72, 0xa1, 0xbb, 0x76, 0x3c, 0x6d, 0x9b, 0x67, 0x32,
-	0xac, 0x13, 0x5a, 0x93, 0x61, 0x60, 0x39, 0x26, 0xdf, 0x49, 0xc0, 0x43, 0x26, 0xce, 0xc2, 0x39,
-	0xc4, 0x99, 0x39, 0xa6, 0xe2, 0xc9, 0x6c, 0xd1, 0x33, 0x6c, 0xe1, 0x87, 0x70, 0x3b, 0xf2, 0x5c,
-	0xcf, 0x07, 0xfa, 0xc2, 0x21, 0x48, 0x1d, 0x01, 0x9b, 0xf1, 0xad, 0x86, 0x2e, 0x0a, 0x12, 0x6c,
-	0x27, 0xd4, 0x1e, 0xf3, 0x32, 0xcf, 0x49, 0x2c, 0xbc, 0x8e, 0x1a, 0xbd, 0x24, 0xf5, 0x16, 0x37,
-	0x8b, 0x2b, 0x45, 0x79, 0xb2, 0x92, 0x2c, 0xa9, 0x85, 0xe4, 0x60, 0xc6, 0x9f, 0x05, 0x54, 0xcf,
-	0x28, 0xf0, 0xd5, 0xf4, 0x6f, 0x27, 0xb2, 0xad, 0xab, 0x3f, 0x9a, 0xb6, 0xb5, 0xf4, 0xa7, 0xd9,
-	0x41, 0x51, 0x3e, 0xdf, 0x41, 0x71, 0x09, 0x95, 0xc5, 0x38, 0x88, 0x9b, 0x15, 0x91, 0x49, 0x6e,
-	0x52, 0x80, 0x29, 0xc3, 0xf8, 0xea, 0x29, 0x8c, 0xbf, 0x8c, 0x2a, 0x84, 0xda, 0xd4, 0x0b, 0x59,
-	0xb3, 0x06, 0x30, 0xfe, 0x53, 0xd0, 0x11, 0x65, 0xcc, 0x77, 0x06, 0x3a, 0xbb, 0x33, 0x9e, 0xaa,
-	0x79, 0xfd, 0xf9, 0x6a, 0xfe, 0x9d, 0xa6, 0x38, 0x82, 0x9b, 0xa8, 0x72, 0x63, 0x60, 0x79, 0xfe,
-	0xde, 0x96, 0xa8, 0x77, 0x8d, 0x28, 0x31, 0x43, 0x87, 0xc2, 0xc9, 0xac, 0x2b, 0x66, 0x59, 0xf7,
-	0x1e, 0xd2, 0x7b, 0xde, 0x88, 0x42, 0x3f, 0x2f, 0x9b, 0xf2, 0x42, 0x32, 0xd5, 0x85, 0x64, 0xf6,
-	0xd4, 0x85, 0xd4, 0xad, 0xf2, 0x66, 0xf8, 0xe1, 0xf7, 0xb6, 0x46, 0xc4, 0x0e, 0xe3, 0xb7, 0x82,
-	0x3a, 0xf1, 0xff, 0x73, 0x0f, 0xbe, 0x89, 0x6a, 0xe2, 0xc8, 0x45, 0x74, 0x45, 0x11, 0xdd, 0xc2,
-	0xf1, 0xb4, 0x3d, 0x53, 0x92, 0xd9, 0x92, 0x17, 0x55, 0x08, 0x7b, 0x5b, 0xa2, 0x1e, 0x35, 0xa2,
-	0xc4, 0x4c, 0x51, 0x4b, 0x27, 0x17, 0xb5, 0x9c, 0x2d, 0x6a, 0x8e, 0x0f, 0x95, 0xb3, 0xf9, 0xb0,
-	0xa1, 0x3f, 0x78, 0xd8, 0xbe, 0x60, 0xfc, 0x58, 0x80, 0x3b, 0x8e, 0xd3, 0x33, 0xd7, 0x4c, 0x40,
-	0xcf, 0x7f, 0x75, 0xee, 0x65, 0xfe, 0xf3, 0x70, 0xac, 0xa6, 0x36, 0xdc, 0xe1, 0x42, 0x05, 0xf7,
-	0xa2, 0x58, 0xe3, 0x37, 0x50, 0xf9, 0xf6, 0x98, 0x71, 0x60, 0x51, 0xc5, 0x22, 0x26, 0x8b, 0xd0,
-	0x41, 0x5f, 0x48, 0x01, 0x5f, 0x42, 0xfa, 0x0d, 0x6b, 0x38, 0x04, 0x3a, 0xbc, 0x20, 0x81, 0x5c,
-	0x23, 0x61, 0xc2, 0x88, 0x57, 0x50, 0x71, 0x3f, 0x70, 0x61, 0x4c, 0x41, 0x9f, 0xef, 0x07, 0xae,
-	0x84, 0x70, 0x13, 0xfe, 0x10, 0x2d, 0xec, 0x04, 0x07, 0x34, 0xf2, 0x37, 0x6d, 0x3b, 0x18, 0xfb,
-	0x0c, 0x7a, 0xbc, 0x29, 0xb1, 0x39, 0x93, 0xdc, 0x95, 0x87, 0x6f, 0x54, 0x79, 0x3d, 0xc4, 0xf5,
-	0xfb, 0x40, 0x53, 0x9d, 0xca, 0xcf, 0x80, 0x50, 0x36, 0x8e, 0x7c, 0x51, 0x94, 0x06, 0x01, 0x89,
-	0x9f, 0xda, 0x8e, 0x15, 0xdf, 0x8d, 0xa9, 0x03, 0x8c, 0x57, 0x22, 0x5e, 0x45, 0xb5, 0x5b, 0xd6,
-	0x88, 0x6e, 0xfb, 0x2c, 0x9a, 0x40, 0xee, 0x0d, 0x53, 0xbe, 0xa1, 0x84, 0x8e, 0xcc, 0xcc, 0xf8,
-	0x3a, 0xaa, 0x7e, 0x42, 0xa3, 0xd1, 0x66, 0xe4, 0xc6, 0x90, 0xfd, 0x92, 0x99, 0x79, 0x56, 0x29,
-	0x1b, 0x49, 0x51, 0xc6, 0x5f, 0x1a, 0xaa, 0xaa, 0xb4, 0xf1, 0x2d, 0x54, 0xd9, 0x74, 0x9c, 0x88,
-	0xc6, 0xb1, 0x8c, 0xae, 0xfb, 0x36, 0xf0, 0xf6, 0xea, 0xe9, 0xbc, 0xb5, 0xa3, 0x49, 0xc8, 0x02,
-	0x13, 0xf6, 0x12, 0xe5, 0x04, 0xef, 0x21, 0x7d, 0xcb, 0x62, 0xd6, 0x7c, 0x4d, 0x20, 0x5c, 0xe0,
-	0x7d, 0x54, 0xee, 0x05, 0xa1, 0x67, 0xcb, 0xd1, 0xfe, 0xdc, 0x91, 0x81, 0xb3, 0xcf, 0x82, 0xc8,
-	0x59, 0x5b, 0x7f, 0x87, 0x80, 0x0f, 0xe3, 0xe7, 0x02, 0xaa, 0xa5, 0x84, 0xe0, 0xaf, 0x0c, 0x2e,
-	0x88, 0x50, 0x73, 0x73, 0x5f, 0x69, 0x49, 0x6a, 0xe7, 0x71, 0xc0, 0x05, 0x57, 0x98, 0xa3, 0x42,
-	0x6a, 0x00, 0xb6, 0x10, 0xba, 0xc3, 0x2c, 0xfb, 0xfe, 0x16, 0x0d, 0xd9, 0x00, 0x66, 0x5a, 0x46,
-	0xc3, 0xe7, 0x08, 0xb0, 0x45, 0x9f, 0x6b, 0x8e, 0x00, 0xc9, 0xae, 0xc8, 0x44, 0xc5, 0x18, 0x29,
-	0x89, 0x31, 0xd2, 0x38, 0x9e, 0xb6, 0x53, 0x1d, 0x49, 0x57, 0xc6, 0xa7, 0x08, 0x3f, 0x4d, 0x70,
-	0xfc, 0x01, 0x5a, 0x00, 0xf9, 0x6e, 0xe8, 0x58, 0x8c, 0x42, 0xb5, 0x5e, 0x32, 0xc5, 0x43, 0xbd,
-	0x47, 0x47, 0xe1, 0xd0, 0x62, 0x14, 0x20, 0x24, 0x8f, 0x35, 0xbe, 0x44, 0x68, 0xd6, 0xd5, 0xe7,
-	0x4d, 0x35, 0xe3, 0x2b, 0x54, 0xcf, 0x8c, 0x82, 0x73, 0x77, 0xff, 0x53, 0x01, 0xe5, 0x38, 0xc0,
-	0xd7, 0x30, 0xd8, 0xfe, 0x33, 0x07, 0xa4, 0x8f, 0xd4, 0x1b, 0x9d, 0x8f, 0x51, 0xd2, 0x47, 0xda,
-	0x72, 0xc5, 0xf9, 0x5b, 0x6e, 0x09, 0x95, 0xee, 0x59, 0xc3, 0x31, 0x55, 0x2f, 0x3c, 0x21, 0xe0,
-	0x45, 0x54, 0xdc, 0xb1, 0x62, 0xb8, 0x41, 0xf8, 0xb2, 0xdb, 0x3d, 0x3c, 0x6a, 0x69, 0x8f, 0x8e,
-	0x5a, 0xda, 0x1f, 0x47, 0x2d, 0xed, 0xd7, 0x27, 0x2d, 0xed, 0xf0, 0x49, 0x4b, 0xfb, 0xe2, 0x8c,
-	0xf0, 0xa9, 0x7a, 0x10, 0x88, 0x55, 0xbf, 0x2c, 0xee, 0xea, 0xb7, 0xfe, 0x09, 0x00, 0x00, 0xff,
-	0xff, 0x91, 0xae, 0x78, 0x84, 0xc6, 0x0e, 0x00, 0x00,
+	// 1319 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4f, 0x6f, 0x1b, 0x55,
+	0x10, 0xef, 0xda, 0x6b, 0xc7, 0x1e, 0xdb, 0xa5, 0x3c, 0x0a, 0x5a, 0x45, 0x22, 0x0e, 0xdb, 0x52,
+	0x4a, 0x69, 0xd7, 0x55, 0xa0, 0xfc, 0x29, 0x12, 0x52, 0xdd, 0x44, 0x6d, 0x68, 0x68, 0xcb, 0xab,
+	0x5b, 0x54, 0x04, 0x87, 0x8d, 0xfd, 0x58, 0xaf, 0xba, 0xde, 0xb7, 0xda, 0x7d, 0x2e, 0xf6, 0x57,
+	0x40, 0x1c, 0x38, 0x96, 0x0b, 0xea, 0x9d, 0x03, 0x1f, 0x80, 0x0b, 0xe2, 0x94, 0x1b, 0x3d, 0xf7,
+	0x60, 0x50, 0xfb, 0x09, 0x10, 0x27, 0x7a, 0x42, 0xef, 0xdf, 0xfa, 0x2d, 0x4d, 0x93, 0x0a, 0xe7,
+	0xc0, 0x25, 0x7a, 0x33, 0xf3, 0x9b, 0xf1, 0xbc, 0x99, 0xdf, 0xcc, 0xdb, 0x00, 0x90, 0x09, 0xe9,
+	0x7b, 0x49, 0x4a, 0x19, 0x45, 0x36, 0x3f, 0x2f, 0x9f, 0x09, 0x42, 0x36, 0x1c, 0x6f, 0x7b, 0x7d,
+	0x3a, 0xea, 0x04, 0x34, 0xa0, 0x1d, 0x61, 0xdc, 0x1e, 0x7f, 0x25, 0x24, 0x21, 0x88, 0x93, 0x74,
+	0x5a, 0x7e, 0xcf, 0x80, 0x33, 0x12, 0x0f, 0x48, 0x3a, 0x0a, 0x63, 0x66, 0x1e, 0xfd, 0xed, 0x7e,
+	0xd8, 0x61, 0xd3, 0x84, 0x64, 0xf2, 0xaf, 0x72, 0x6c, 0x07, 0x94, 0x06, 0x11, 0x99, 0x87, 0x67,
+	0xe1, 0x88, 0x64, 0xcc, 0x1f, 0x25, 0x0a, 0xd0, 0x24, 0x69, 0x4a, 0x53, 0x0d, 0x6f, 0xc4, 0xfe,
+	0x28, 0xf7, 0xad, 0xb3, 0x89, 0x3e, 0x1e, 0x49, 0xf8, 0xcf, 0x64, 0x59, 0x48, 0x63, 0xa5, 0x81,
+	0x2c, 0xd1, 0x57, 0x5a, 0x6e, 0x25, 0xfe, 0x34, 0xa2, 0xfe, 0x40, 0x8a, 0xee, 0xcf, 0x25, 0x68,
+	0xdc, 0x60, 0x29, 0xf1, 0x47, 0x1b, 0x77, 0x49, 0xcc, 0xd0, 0x59, 0x80, 0x2e, 0x09, 0xc2, 0xb8,
+	0x1b, 0xd1, 0xfe, 0x1d, 0xc7, 0x5a, 0xb5, 0x4e, 0x36, 0xd6, 0x8e, 0x78, 0xa2, 0x24, 0x73, 0x3d,
+	0x36, 0x30, 0xe8, 0x0d, 0x58, 0x12, 0x52, 0x6f, 0xe2, 0x94, 0x04, 0xbc, 0x65, 0xc0, 0x7b, 0x13,
+	0xac, 0xad, 0xe8, 0x36, 0xd4, 0x36, 0xe2, 0xbb, 0x24, 0xa2, 0x09, 0x71, 0xca, 0x0a, 0xc9, 0xb3,
+	0xd6, 0xca, 0xae, 0xf7, 0x70, 0xd6, 0x3e, 0x65, 0x14, 0x6f, 0x38, 0x4d, 0x48, 0x1a, 0x91, 0x41,
+	0x40, 0xd2, 0xce, 0xf6, 0x38, 0x4d, 0xe9, 0xd7, 0x1d, 0x13, 0x8f, 0xf3, 0x70, 0xe8, 0x35, 0xa8,
+	0x88, 0xf4, 0x1d, 0x5b, 0xc4, 0x6d, 0xc8, 0x0c, 0x84, 0x0a, 0x4b, 0x8b, 0x80, 0xc4, 0x83, 0xde,
+	0xc4, 0xa9, 0x14, 0x20, 0x5c, 0x85, 0xa5, 0x05, 0x9d, 0xe2, 0x09, 0x0e, 0xe4, 0xcd, 0xab, 0x02,
+	0x75, 0x38, 0x47, 0xc9, 0x7b, 0xe7, 0xf6, 0xf3, 0xf6, 0xce, 0xfd, 0xb6, 0xe5, 0x7e, 0x00, 0x75,
+	0x59, 0xbc, 0x2b, 0x64, 0x8a, 0x5e, 0x81, 0xea, 0x65, 0x12, 0x06, 0x43, 0x26, 0xca, 0x66, 0x63,
+	0x25, 0xa1, 0xa3, 0x50, 0xd9, 0x8c, 0x07, 0x44, 0x96, 0xc7, 0xc6, 0x52, 0x70, 0xaf, 0x98, 0x85,
+	0x7e, 0xa6, 0xef, 0xeb, 0x5c, 0xef, 0x0f, 0x48, 0x9a, 0xd7, 0x56, 0x12, 0x46, 0x2a, 0xb1, 0x32,
+	0xba, 0xee, 0x3c, 0xf3, 0x67, 0x85, 0x72, 0xbf, 0xb5, 0xf2, 0x46, 0xf1, 0x9b, 0xf6, 0x26, 0x2a,
+	0xb0, 0x65, 0xde, 0x54, 0x6b, 0x71, 0x6e, 0x47, 0xc7, 0xa1, 0x8a, 0x49, 0x36, 0x8e, 0x98, 0x4a,
+	0xa1, 0x29, 0x91, 0x52, 0x87, 0x95, 0x0d, 0x75, 0xa0, 0xbe, 0x31, 0xe9, 0x93, 0x84, 0x85, 0x34,
+	0x56, 0x5d, 0x78, 0xd1, 0x53, 0x74, 0xcd, 0x0d, 0x78, 0x8e, 0x71, 0x6f, 0xa9, 0x7e, 0xa0, 0x4f,
+	0xa0, 0xda, 0x9b, 0x5c, 0xf6, 0xb3, 0xa1, 0x20, 0x45, 0xb3, 0x7b, 0x6e, 0x67, 0xd6, 0x3e, 0xf4,
+	0x70, 0xd6, 0x3e, 0xb3, 0x37, 0x13, 0xb6, 0xc3, 0xd8, 0x4f, 0xa7, 0xde, 0x65, 0x32, 0xe9, 0x4e,
+	0x19, 0xc9, 0xb0, 0x0a, 0xe2, 0xfe, 0x6d, 0xcd, 0xef, 0x86, 0x3e, 0xe6, 0xb1, 0x7b, 0xd3, 0x84,
+	0x88, 0x5b, 0xb6, 0xba, 0x6b, 0x4f, 0x66, 0x6d, 0x6f, 0x5f, 0x86, 0x75, 0xf4, 0x88, 0x70, 0x4f,
+	0xac, 0x22, 0x18, 0x79, 0x96, 0x0e, 0x20, 0x4f, 0xa3, 0x4d, 0xe5, 0xdd, 0xd9, 0x62, 0x1b, 0x6c,
+	0xe1, 0x4d, 0xb8, 0x96, 0x86, 0x41, 0x18, 0x3b, 0x15, 0xb3, 0x09, 0x52, 0x87, 0x95, 0xcd, 0xfd,
+	0xd1, 0x82, 0xc3, 0x82, 0x04, 0x1b, 0x13, 0xd2, 0x1f, 0xf3, 0x32, 0x2f, 0x48, 0x2c, 0x74, 0x0e,
+	0x9a, 0xbd, 0x49, 0x1e, 0x2d, 0x73, 0xca, 0xab, 0x65, 0xd9, 0x59, 0x49, 0x96, 0xdc, 0x82, 0x0b,
+	0x30, 0x74, 0x0c, 0xaa, 0x62, 0xea, 0x32, 0xc7, 0x5e, 0x2d, 0x1b, 0xd3, 0x26, 0x06, 0x52, 0x99,
+	0xdc, 0x3f, 0x4b, 0xd0, 0x30, 0xbc, 0xd0, 0xe9, 0x3c, 0xa5, 0x5d, 0x29, 0xd9, 0xb5, 0x1f, 0xcc,
+	0xda, 0x56, 0x9e, 0x99, 0xb9, 0x4d, 0xaa, 0x07, 0xbb, 0x4d, 0xe6, 0xd9, 0x2f, 0x3d, 0x33, 0x7b,
+	0x63, 0x2c, 0x6a, 0x7b, 0x8c, 0xc5, 0x09, 0x58, 0xc2, 0xa4, 0x4f, 0xc2, 0x84, 0x39, 0x75, 0x05,
+	0xe3, 0x3f, 0xaa, 0x74, 0x58, 0x1b, 0x8b, 0xe3, 0x03, 0xfb, 0x8f, 0xcf, 0x53, 0x8d, 0x69, 0x3c,
+	0x57, 0x63, 0xdc, 0x6f, 0x2c, 0x4d, 0x24, 0xe4, 0xc0, 0xd2, 0xc5, 0xa1, 0x1f, 0xc6, 0x9b, 0xeb,
+	0xa2, 0xde, 0x75, 0xac, 0x45, 0x83, 0x33, 0xa5, 0xdd, 0xa9, 0x59, 0x36, 0xa9, 0xf9, 0x3e, 0xd8,
+	0xbd, 0x70, 0x44, 0xd4, 0xd0, 0x2f, 0x7b, 0xf2, 0x11, 0xf3, 0xf4, 0x23, 0xe6, 0xf5, 0xf4, 0x23,
+	0xd6, 0xad, 0xf1, 0x89, 0xf9, 0xee, 0xf7, 0xb6, 0x85, 0x85, 0x87, 0xfb, 0x5b, 0x09, 0xaa, 0xff,
+	0xff, 0x41, 0x7d, 0x0b, 0xea, 0xa2, 0xe5, 0x22, 0xbb, 0xb2, 0xc8, 0xae, 0xf5, 0x64, 0xd6, 0x9e,
+	0x2b, 0xf1, 0xfc, 0xc8, 0x8b, 0x2a, 0x84, 0xcd, 0x75, 0x51, 0x8f, 0x3a, 0xd6, 0xa2, 0x51, 0xd4,
+	0xca, 0xee, 0x45, 0xad, 0x9a, 0x45, 0x2d, 0xf0, 0x61, 0x69, 0x7f, 0x3e, 0x9c, 0xb7, 0xef, 0xdd,
+	0x6f, 0x1f, 0x72, 0x7f, 0x2d, 0xa9, 0x87, 0x10, 0x1d, 0xd7, 0xa5, 0x75, 0x2c, 0x93, 0x9e, 0xff,
+	0x1a, 0xef, 0x13, 0xfc, 0xc7, 0x93, 0xb1, 0x5e, 0xed, 0xea, 0xa1, 0x17, 0x2a, 0xf5, 0x78, 0x8a,
+	0x33, 0x7a, 0x13, 0xaa, 0xd7, 0xc6, 0x8c, 0x03, 0xcb, 0x3a, 0x17, 0xb1, 0x7e, 0xc6, 0x2c, 0x47,
+	0x2a, 0x00, 0x3a, 0x06, 0xf6, 0x45, 0x3f, 0x8a, 0x14, 0x1d, 0x5e, 0x90, 0x40, 0xae, 0x91, 0x30,
+	0x61, 0x44, 0xab, 0x50, 0xde, 0xa2, 0x81, 0x53, 0x31, 0xe7, 0x7c, 0x8b, 0x06, 0x12, 0xc2, 0x4d,
+	0xe8, 0x23, 0x68, 0x5d, 0xa2, 0x77, 0x49, 0x1a, 0x5f, 0xe8, 0xf7, 0xe9, 0x38, 0x66, 0x6a, 0xc6,
+	0x1d, 0x89, 0x2d, 0x98, 0xa4, 0x57, 0x11, 0x8e, 0x3a, 0x50, 0xbb, 0x9e, 0xd2, 0x84, 0x66, 0x7e,
+	0xa4, 0xea, 0xf7, 0x92, 0x74, 0xd5, 0x5a, 0xe9, 0x95, 0x83, 0xce, 0xd7, 0x78, 0x01, 0xc5, 0xa3,
+	0x7e, 0xcf, 0xd2, 0xa3, 0xcd, 0x9b, 0x86, 0x09, 0x1b, 0xa7, 0xb1, 0xa8, 0x62, 0x13, 0x2b, 0x89,
+	0xb7, 0xf9, 0x92, 0x9f, 0xdd, 0xcc, 0xc8, 0x40, 0x8d, 0x88, 0x16, 0xd1, 0x29, 0xa8, 0x5f, 0xf5,
+	0x47, 0x64, 0x23, 0x66, 0xe9, 0x54, 0x15, 0xab, 0xe9, 0xc9, 0x0f, 0x35, 0xa1, 0xc3, 0x73, 0x33,
+	0x3a, 0x0b, 0xb5, 0xeb, 0x24, 0x1d, 0x5d, 0x48, 0x83, 0x4c, 0x95, 0xeb, 0xa8, 0x67, 0x7c, 0xbb,
+	0x69, 0x1b, 0xce, 0x51, 0xee, 0x5f, 0x16, 0xd4, 0x74, 0x9d, 0xd0, 0x55, 0x58, 0xba, 0x30, 0x18,
+	0xa4, 0x24, 0xcb, 0x64, 0x76, 0xdd, 0x77, 0x14, 0xd1, 0x4f, 0xef, 0x4d, 0xf4, 0x7e, 0x3a, 0x4d,
+	0x18, 0xf5, 0x94, 0x2f, 0xd6, 0x41, 0xd0, 0x26, 0xd8, 0xeb, 0x3e, 0xf3, 0x17, 0x9b, 0x1a, 0x11,
+	0x02, 0x6d, 0x41, 0xb5, 0x47, 0x93, 0xb0, 0x2f, 0x1f, 0x8c, 0xe7, 0xce, 0x4c, 0x05, 0xfb, 0x8c,
+	0xa6, 0x83, 0xb5, 0x73, 0xef, 0x62, 0x15, 0xc3, 0xfd, 0xa1, 0x04, 0xf5, 0x9c, 0x41, 0xfc, 0xdb,
+	0x85, 0x0b, 0x22, 0xd5, 0xc2, 0x43, 0xa1, 0xb5, 0x38, 0xb7, 0xa3, 0x2d, 0xbd, 0xed, 0xd4, 0xa5,
+	0xfe, 0x5b, 0x85, 0xf4, 0xc6, 0x5c, 0x01, 0xb8, 0xc1, 0xfc, 0xfe, 0x9d, 0x75, 0x92, 0xb0, 0xa1,
+	0x5a, 0x82, 0x86, 0x86, 0x2f, 0x1e, 0xc5, 0x16, 0x7b, 0xa1, 0xc5, 0xa3, 0x48, 0x76, 0x52, 0x5e,
+	0x54, 0xec, 0x9d, 0x8a, 0xd8, 0x3b, 0xcd, 0x27, 0xb3, 0x76, 0xae, 0xc3, 0xf9, 0xc9, 0xfd, 0x14,
+	0xd0, 0xd3, 0x13, 0x81, 0x3e, 0x84, 0x96, 0x92, 0x6f, 0x26, 0x03, 0x9f, 0x11, 0x55, 0xad, 0x97,
+	0x3d, 0xf1, 0xdf, 0x40, 0x8f, 0x8c, 0x92, 0xc8, 0x67, 0x44, 0x41, 0x70, 0x11, 0xeb, 0xfe, 0x64,
+	0x41, 0xab, 0x30, 0x2a, 0xe8, 0x36, 0x34, 0xb5, 0x42, 0x2c, 0x57, 0x6b, 0x91, 0x3b, 0x16, 0x42,
+	0xa1, 0x8b, 0xf3, 0xdf, 0xba, 0xc1, 0x78, 0xa6, 0xbc, 0x5b, 0x87, 0xd7, 0x5e, 0xf5, 0xf4, 0x7e,
+	0xef, 0xfa, 0x51, 0x44, 0x99, 0x57, 0x00, 0xe1, 0xa2, 0x8f, 0xfb, 0x05, 0xc0, 0x7c, 0x71, 0x1d,
+	0xf4, 0x70, 0xb8, 0x5f, 0x42, 0xc3, 0xd8, 0x76, 0x07, 0x1e, 0xfe, 0xfb, 0x12, 0x14, 0x58, 0xcb,
+	0xcf, 0x24, 0x5d, 0x28, 0xb6, 0x8a, 0x91, 0x47, 0x23, 0x8b, 0xcd, 0x80, 0x8c, 0x91, 0x2f, 0x89,
+	0xf2, 0xe2, 0x4b, 0xe2, 0x28, 0x54, 0x6e, 0xf9, 0xd1, 0x98, 0xe8, 0x2f, 0x5d, 0x21, 0xa0, 0x23,
+	0x50, 0xbe, 0xe4, 0x67, 0xea, 0x91, 0xe4, 0xc7, 0x6e, 0x77, 0xe7, 0xd1, 0x8a, 0xf5, 0xe0, 0xd1,
+	0x8a, 0xf5, 0xc7, 0xa3, 0x15, 0xeb, 0x97, 0xc7, 0x2b, 0xd6, 0xce, 0xe3, 0x15, 0xeb, 0xf3, 0x7d,
+	0xd2, 0x27, 0xfa, 0x9b, 0x47, 0x9c, 0xb6, 0xab, 0xe2, 0x73, 0xe4, 0xed, 0x7f, 0x06, 0x00, 0x2b,
+	0x7b, 0x17, 0x5c, 0xdd, 0x0f, 0x00, 0x00,
 }
@@ -47,6 +47,8 @@ func (ba *BlockAccumulator) Consume(ev *StreamEvent) *BlockExecution {
 			Height: ev.BeginBlock.Height,
 			Header: ev.BeginBlock.Header,
 		}
+	case ev.Event != nil && len(ba.stack) == 0:
+		ba.block.Events = append(ba.block.Events, ev.Event)
 	case ev.BeginTx != nil, ev.Envelope != nil, ev.Event != nil, ev.EndTx != nil:
 		txe := ba.stack.Consume(ev)
 		if txe != nil {
@@ -88,6 +90,10 @@ func (stack *TxStack) Consume(ev *StreamEvent) *TxExecution {
 		txe.Envelope = ev.Envelope
 		txe.Receipt = txe.Envelope.Tx.GenerateReceipt()
 	case ev.Event != nil:
+		if len(*stack) == 0 {
+			// Events of the block itself rather than a transaction
+			return nil
+		}
 		txe := stack.Peek()
 		txe.Events = append(txe.Events, ev.Event)
 	case ev.EndTx != nil:
@@ -58,6 +58,7 @@ type ExecutorState interface {
 	Update(updater func(ws state.Updatable) error) (hash []byte, version int64, err error)
 	names.Reader
 	proposal.Reader
+	proposal.ExpiryIterable
 	acmstate.IterableReader
 	validator.IterableReader
 }
@@ -90,6 +91,7 @@ type executor struct {
 	proposalRegCache *proposal.Cache
 	validatorCache   *validator.Cache
 	emitter          *event.Emitter
+	blockchain       contexts.Blockchain
 	block            *exec.BlockExecution
 	logger           *logging.Logger
 	vmOptions        []func(*evm.VM)
@@ -99,12 +101,14 @@ type executor struct {
 type Params struct {
 	ChainID           string
 	ProposalThreshold uint64
+	ProposalQuorum    uint64
 }
 
 func ParamsFromGenesis(genesisDoc *genesis.GenesisDoc) Params {
 	return Params{
 		ChainID:           genesisDoc.ChainID(),
 		ProposalThreshold: genesisDoc.Params.ProposalThreshold,
+		ProposalQuorum:    genesisDoc.Params.ProposalQuorum,
 	}
 }
 
@@ -137,6 +141,7 @@ func newExecutor(name string, runCall bool, params Params, backend ExecutorState
 		proposalRegCache: proposal.NewCache(backend),
 		validatorCache:   validator.NewCache(backend),
 		emitter:          emitter,
+		blockchain:       blockchain,
 		block: &exec.BlockExecution{
 			Height: blockchain.LastBlockHeight() + 1,
 		},
@@ -148,6 +153,7 @@ func newExecutor(name string, runCall bool, params Params, backend ExecutorState
 
 	baseContexts := map[payload.Type]contexts.Context{
 		payload.TypeSend: &contexts.SendContext{
+			Blockchain:  blockchain,
 			StateWriter: exe.stateCache,
 			Logger:      exe.logger,
 		},
@@ -165,10 +171,12 @@ func newExecutor(name string, runCall bool, params Params, backend ExecutorState
 			Logger:      exe.logger,
 		},
 		payload.TypePermissions: &contexts.PermissionsContext{
+			Blockchain:  blockchain,
 			StateWriter: exe.stateCache,
 			Logger:      exe.logger,
 		},
 		payload.TypeGovernance: &contexts.GovernanceContext{
+			Blockchain:   blockchain,
 			ValidatorSet: exe.validatorCache,
 			StateWriter:  exe.stateCache,
 			Logger:       exe.logger,
@@ -179,6 +187,8 @@ func newExecutor(name string, runCall bool, params Params, backend ExecutorState
 		payload.TypeProposal: &contexts.ProposalContext{
 			ChainID:           params.ChainID,
 			ProposalThreshold: params.ProposalThreshold,
+			ProposalQuorum:    params.ProposalQuorum,
+			Blockchain:        blockchain,
 			StateWriter:       exe.stateCache,
 			ProposalReg:       exe.proposalRegCache,
 			Logger:            exe.logger,
@@ -234,7 +244,7 @@ func (exe *executor) Execute(txEnv *txs.Envelope) (txe *exec.TxExecution, err er
 		}()
 
 		// Validate inputs and check sequence numbers
-		err = validateInputs(txEnv.Tx, exe.stateCache)
+		err = validateInputs(txEnv.Tx, exe.stateCache, exe.blockchain)
 		if err != nil {
 			logger.InfoMsg("Transaction validate failed", structure.ErrorKey, err)
 			txe.PushError(err)
@@ -261,7 +271,7 @@ func (exe *executor) Execute(txEnv *txs.Envelope) (txe *exec.TxExecution, err er
 	return nil, fmt.Errorf("unknown transaction type: %v", txEnv.Tx.Type())
 }
 
-func validateInputs(tx *txs.Tx, getter acmstate.AccountGetter) error {
+func validateInputs(tx *txs.Tx, getter acmstate.AccountGetter, blockchain contexts.Blockchain) error {
 	for _, in := range tx.GetInputs() {
 		acc, err := getter.GetAccount(in.Address)
 		if err != nil {
@@ -284,8 +294,9 @@ func validateInputs(tx *txs.Tx, getter acmstate.AccountGetter) error {
 		if acc.Balance < uint64(in.Amount) {
 			return errors.ErrorCodeInsufficientFunds
 		}
-		// Check for Input permission
-		v, err := acc.Permissions.Base.Compose(acmstate.GlobalAccountPermissions(getter).Base).Get(permission.Input)
+		// Check for Input permission, which may be granted until an expiry
+		base := acc.Permissions.BaseAt(blockchain.LastBlockHeight()+1, blockchain.LastBlockTime().Unix())
+		v, err := base.Compose(acmstate.GlobalAccountPermissions(getter).Base).Get(permission.Input)
 		if err != nil {
 			return err
 		}
@@ -309,6 +320,10 @@ func (exe *executor) Commit(header *abciTypes.Header) (stateHash []byte, err err
 	// Capture height
 	height := exe.block.Height
 	exe.logger.InfoMsg("Executor committing", "height", exe.block.Height)
+	err = exe.expireProposals()
+	if err != nil {
+		return nil, err
+	}
 	// Form BlockExecution for this block from TxExecutions and Tendermint block header
 	blockExecution, err := exe.finaliseBlockExecution(header)
 	if err != nil {
@@ -385,6 +400,36 @@ func (exe *executor) PendingValidators() validator.IterableReader {
 	return exe.validatorCache.Delta
 }
 
+// Expires the proposals that cannot be voted on after this block, emitting an event from the block for each so that
+// proposals expire whether or not anyone votes on them after their expiry height
+func (exe *executor) expireProposals() error {
+	expire := func(proposalHash []byte, ballot *payload.Ballot) error {
+		if ballot == nil || ballot.ProposalState != payload.Ballot_PROPOSED || ballot.Proposal.ExpiryHeight == 0 ||
+			exe.block.Height < ballot.Proposal.ExpiryHeight {
+			return nil
+		}
+		exe.logger.InfoMsg("Proposal has expired",
+			"proposal_hash", binary.HexBytes(proposalHash),
+			"expiry_height", ballot.Proposal.ExpiryHeight)
+		ballot.ProposalState = payload.Ballot_EXPIRED
+		exe.block.Proposal(&exec.ProposalEvent{ProposalHash: proposalHash, ProposalState: ballot.ProposalState})
+		return exe.proposalRegCache.UpdateProposal(proposalHash, ballot)
+	}
+	// Proposals made in earlier blocks are found through the expiry index of state and those made in this block in
+	// the cache, which also holds any ballots of the former changed by this block
+	err := exe.state.IterateExpiringProposals(exe.block.Height, func(proposalHash []byte) error {
+		ballot, err := exe.proposalRegCache.GetProposal(proposalHash)
+		if err != nil {
+			return err
+		}
+		return expire(proposalHash, ballot)
+	})
+	if err != nil {
+		return err
+	}
+	return exe.proposalRegCache.IterateCachedProposals(expire)
+}
+
 func (exe *executor) finaliseBlockExecution(header *abciTypes.Header) (*exec.BlockExecution, error) {
 	if header != nil && uint64(header.Height) != exe.block.Height {
 		return nil, fmt.Errorf("trying to finalise block execution with height %v but passed Tendermint"+
@@ -403,19 +448,29 @@ func (exe *executor) finaliseBlockExecution(header *abciTypes.Header) (*exec.Blo
 
 // Capture public keys and update sequence numbers
 func (exe *executor) updateSignatories(txEnv *txs.Envelope) error {
-	for _, sig := range txEnv.Signatories {
-		// pointer dereferences are safe since txEnv.Validate() is run by txEnv.Verify() above which checks they are
-		// non-nil
-		acc, err := exe.stateCache.GetAccount(*sig.Address)
+	for _, in := range txEnv.Tx.GetInputs() {
+		acc, err := exe.stateCache.GetAccount(in.Address)
 		if err != nil {
-			return fmt.Errorf("error getting account on which to set public key: %v", *sig.Address)
+			return fmt.Errorf("error getting account on which to set public key: %v", in.Address)
 		}
-		// Important that verify has been run against signatories at this point
-		if sig.PublicKey.GetAddress() != acc.Address {
-			return fmt.Errorf("unexpected mismatch between address %v and supplied public key %v",
-				acc.Address, sig.PublicKey)
+		// Multisig accounts are signed for by their keys rather than their own, and once an account's public key is
+		// set (possibly by rotating it) Verify checks signatories use that key
+		if acc.Multisig == nil && !acc.PublicKey.IsSet() {
+			for _, sig := range txEnv.Signatories {
+				// pointer dereferences are safe since txEnv.Validate() is run by txEnv.Verify() above which checks
+				// they are non-nil
+				if *sig.Address != acc.Address {
+					continue
+				}
+				// Important that verify has been run against signatories at this point
+				if sig.PublicKey.GetAddress() != acc.Address {
+					return fmt.Errorf("unexpected mismatch between address %v and supplied public key %v",
+						acc.Address, sig.PublicKey)
+				}
+				acc.PublicKey = *sig.PublicKey
+				break
+			}
 		}
-		acc.PublicKey = *sig.PublicKey
 
 		exe.logger.TraceMsg("Incrementing sequence number Tx signatory/input",
 			"height", exe.block.Height,
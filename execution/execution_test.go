@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"runtime/debug"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -35,10 +36,12 @@ import (
 
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/acm/balance"
 	"github.com/hyperledger/burrow/bcm"
 	. "github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/event"
+	"github.com/hyperledger/burrow/execution/contexts"
 	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/evm"
 	. "github.com/hyperledger/burrow/execution/evm/asm"
@@ -46,6 +49,7 @@ import (
 	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/execution/names"
 	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/genesis/spec"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/permission"
 	"github.com/hyperledger/burrow/txs"
@@ -1193,6 +1197,67 @@ func TestCreates(t *testing.T) {
 	}
 }
 
+func TestCreateWithContractMeta(t *testing.T) {
+	st, privAccounts := makeGenesisState(3, true, 1000, 1, true, 1000)
+	exe := makeExecutor(st)
+
+	contractCode := []byte{0x60}
+	contractMeta := `[{"type":"event","name":"Thing","inputs":[],"anonymous":false}]`
+
+	tx, err := payload.NewCallTx(exe.stateCache, privAccounts[0].GetPublicKey(), nil,
+		wrapContractForCreate(contractCode), 1, 100000, 0)
+	require.NoError(t, err)
+	tx.ContractMeta = contractMeta
+	require.NoError(t, exe.signExecuteCommit(tx, privAccounts[0]))
+
+	contractAddress := crypto.NewContractAddress(tx.Input.Address, txHash(tx))
+	contractAcc := getAccount(st, contractAddress)
+	require.NotNil(t, contractAcc)
+	assert.Equal(t, acm.Bytecode(contractCode), contractAcc.Code)
+	assert.Equal(t, contractMeta, contractAcc.ContractMeta)
+
+	create := func(gasLimit uint64, meta string) (*exec.TxExecution, crypto.Address) {
+		tx, err := payload.NewCallTx(exe.stateCache, privAccounts[0].GetPublicKey(), nil,
+			wrapContractForCreate(contractCode), 1, gasLimit, 0)
+		require.NoError(t, err)
+		tx.ContractMeta = meta
+		txEnv := txs.Enclose(testChainID, tx)
+		require.NoError(t, txEnv.Sign(privAccounts[0]))
+		txe, err := exe.Execute(txEnv)
+		require.NoError(t, err)
+		_, err = exe.Commit(nil)
+		require.NoError(t, err)
+		return txe, crypto.NewContractAddress(tx.Input.Address, txHash(tx))
+	}
+
+	// Storing metadata uses a unit of gas per byte
+	txe, _ := create(100000, "")
+	createGas := txe.Result.GasUsed
+	txe, address := create(createGas+uint64(len(contractMeta))-1, contractMeta)
+	require.NotNil(t, txe.Exception)
+	assert.Equal(t, errors.ErrorCodeInsufficientGas, txe.Exception.ErrorCode())
+	assert.Nil(t, getAccount(st, address), "contract should not be created without the gas to store its metadata")
+	txe, address = create(createGas+uint64(len(contractMeta)), contractMeta)
+	require.Nil(t, txe.Exception)
+	assert.Equal(t, createGas+uint64(len(contractMeta)), txe.Result.GasUsed)
+	assert.Equal(t, contractMeta, getAccount(st, address).ContractMeta)
+
+	// Metadata is limited in size
+	tx, err = payload.NewCallTx(exe.stateCache, privAccounts[0].GetPublicKey(), nil,
+		wrapContractForCreate(contractCode), 1, 100000, 0)
+	require.NoError(t, err)
+	tx.ContractMeta = strings.Repeat("x", contexts.MaxContractMetaLength+1)
+	assert.Error(t, exe.signExecuteCommit(tx, privAccounts[0]))
+
+	// And can only be stored when creating a contract
+	tx, err = payload.NewCallTx(exe.stateCache, privAccounts[0].GetPublicKey(), &contractAddress, nil, 1, 100000,
+		0)
+	require.NoError(t, err)
+	tx.ContractMeta = `[]`
+	assert.Error(t, exe.signExecuteCommit(tx, privAccounts[0]))
+	assert.Equal(t, contractMeta, getAccount(st, contractAddress).ContractMeta)
+}
+
 func TestContractSend(t *testing.T) {
 	st, privAccounts := makeGenesisState(3, true, 1000, 1, true, 1000)
 	/*
@@ -1494,6 +1559,393 @@ func TestSelfDestruct(t *testing.T) {
 	}
 }
 
+func TestMultisig(t *testing.T) {
+	stateDB := dbm.NewDB("state", dbBackend, dbDir)
+	defer stateDB.Close()
+	genDoc := newBaseGenDoc(permission.ZeroAccountPermissions, permission.ZeroAccountPermissions)
+	genDoc.Accounts[0].Permissions.Base.Set(permission.Root|permission.Input|permission.CreateAccount, true)
+	st, err := state.MakeGenesisState(stateDB, &genDoc)
+	require.NoError(t, err)
+	err = st.InitialCommit()
+	require.NoError(t, err)
+	exe := makeExecutor(st)
+
+	officers := users[5:8]
+	multisig, err := acm.NewMultisig(2, officers[0].GetPublicKey(), officers[1].GetPublicKey(),
+		officers[2].GetPublicKey())
+	require.NoError(t, err)
+	govTx := &payload.GovTx{
+		Inputs: []*payload.TxInput{{Address: users[0].GetAddress(), Sequence: 1}},
+		AccountUpdates: []*spec.TemplateAccount{{
+			Multisig:    multisig,
+			Amounts:     balance.New().Native(1000),
+			Permissions: []string{permission.InputString, permission.SendString},
+		}},
+	}
+	require.NoError(t, exe.signExecuteCommit(govTx, users[0]))
+	treasury := exe.getAccount(t, multisig.Address())
+	require.NotNil(t, treasury)
+	assert.Equal(t, multisig, treasury.Multisig)
+
+	send := func(sequence uint64, signers ...acm.AddressableSigner) error {
+		tx := payload.NewSendTx()
+		tx.Inputs = []*payload.TxInput{{Address: treasury.Address, Amount: 100, Sequence: sequence}}
+		tx.AddOutput(users[4].GetAddress(), 100)
+		txEnv := txs.Enclose(testChainID, tx)
+		err := txEnv.SignMultisig(exe.stateCache, signers...)
+		if err != nil {
+			return err
+		}
+		_, err = exe.Execute(txEnv)
+		if err != nil {
+			return err
+		}
+		_, err = exe.Commit(nil)
+		return err
+	}
+
+	require.NoError(t, send(1, officers[0], officers[2]))
+	require.NoError(t, send(2, officers[2], officers[1], officers[0]))
+	assert.Error(t, send(3, officers[1]), "one officer should not be able to sign alone")
+	assert.Error(t, send(3, users[1], users[2]), "non-officers should not be able to sign")
+	treasury = exe.getAccount(t, treasury.Address)
+	assert.Equal(t, uint64(800), treasury.Balance)
+	assert.Equal(t, uint64(2), treasury.Sequence)
+
+	// Officers' signatures cannot be replayed out of order or twice
+	tx := payload.NewSendTx()
+	tx.Inputs = []*payload.TxInput{{Address: treasury.Address, Amount: 100, Sequence: 3}}
+	tx.AddOutput(users[4].GetAddress(), 100)
+	txEnv := txs.Enclose(testChainID, tx)
+	require.NoError(t, txEnv.SignMultisig(exe.stateCache, officers[0], officers[1]))
+	txEnv.Signatories[0], txEnv.Signatories[1] = txEnv.Signatories[1], txEnv.Signatories[0]
+	_, err = exe.Execute(txEnv)
+	assert.Error(t, err)
+	txEnv.Signatories[0] = txEnv.Signatories[1]
+	_, err = exe.Execute(txEnv)
+	assert.Error(t, err)
+}
+
+func TestRotateKey(t *testing.T) {
+	stateDB := dbm.NewDB("state", dbBackend, dbDir)
+	defer stateDB.Close()
+	genDoc := newBaseGenDoc(permission.ZeroAccountPermissions, permission.ZeroAccountPermissions)
+	genDoc.Accounts[0].Permissions.Base.Set(permission.Root|permission.Input, true)
+	genDoc.Accounts[1].Permissions.Base.Set(permission.Input|permission.Send, true)
+	genDoc.Accounts[2].Permissions.Base.Set(permission.Input|permission.Send, true)
+	st, err := state.MakeGenesisState(stateDB, &genDoc)
+	require.NoError(t, err)
+	err = st.InitialCommit()
+	require.NoError(t, err)
+	exe := makeExecutor(st)
+
+	input := func(address crypto.Address) *payload.TxInput {
+		return &payload.TxInput{Address: address, Sequence: exe.getAccount(t, address).Sequence + 1}
+	}
+	rotate := func(signer acm.AddressableSigner, address crypto.Address, publicKey crypto.PublicKey) error {
+		return exe.signExecuteCommit(&payload.GovTx{
+			Inputs:         []*payload.TxInput{input(signer.GetAddress())},
+			AccountUpdates: []*spec.TemplateAccount{{Address: &address, PublicKey: &publicKey, RotateKey: true}},
+		}, signer)
+	}
+	send := func(signer acm.AddressableSigner) error {
+		tx := payload.NewSendTx()
+		in := input(signer.GetAddress())
+		in.Amount = 10
+		tx.Inputs = []*payload.TxInput{in}
+		tx.AddOutput(users[4].GetAddress(), 10)
+		return exe.signExecuteCommit(tx, signer)
+	}
+
+	// An account rotates its own key
+	before := exe.getAccount(t, users[1].GetAddress())
+	require.NoError(t, rotate(users[1], users[1].GetAddress(), users[8].GetPublicKey()))
+	after := exe.getAccount(t, users[1].GetAddress())
+	assert.Equal(t, users[8].GetPublicKey(), after.PublicKey)
+	assert.Equal(t, before.Balance, after.Balance)
+	assert.Equal(t, before.Permissions, after.Permissions)
+
+	assert.Error(t, send(users[1]), "old key should no longer sign for the account")
+	require.NoError(t, send(acm.RotatedSigner(users[1].GetAddress(), users[8])))
+	require.NoError(t, rotate(acm.RotatedSigner(users[1].GetAddress(), users[8]), users[1].GetAddress(),
+		users[7].GetPublicKey()))
+	require.NoError(t, send(acm.RotatedSigner(users[1].GetAddress(), users[7])))
+
+	// Without root an account may only rotate its own key
+	assert.Error(t, rotate(users[2], users[3].GetAddress(), users[9].GetPublicKey()))
+	publicKey := users[9].GetPublicKey()
+	nativeUpdate := &spec.TemplateAccount{
+		Address:   addressPtr(exe.getAccount(t, users[2].GetAddress())),
+		PublicKey: &publicKey,
+		RotateKey: true,
+		Amounts:   balance.New().Native(1000000000),
+	}
+	assert.Error(t, exe.signExecuteCommit(&payload.GovTx{
+		Inputs:         []*payload.TxInput{input(users[2].GetAddress())},
+		AccountUpdates: []*spec.TemplateAccount{nativeUpdate},
+	}, users[2]))
+
+	// Root may rotate any account's key
+	require.NoError(t, rotate(users[0], users[2].GetAddress(), users[9].GetPublicKey()))
+	require.NoError(t, send(acm.RotatedSigner(users[2].GetAddress(), users[9])))
+	assert.Equal(t, users[2].GetAddress(), exe.getAccount(t, users[2].GetAddress()).Address)
+
+	// A validator's power is held by its key so the key cannot be rotated while it has power
+	assert.Error(t, rotate(users[0], users[0].GetAddress(), users[6].GetPublicKey()))
+	assert.Equal(t, users[0].GetPublicKey(), exe.getAccount(t, users[0].GetAddress()).PublicKey)
+}
+
+func TestProposalVoting(t *testing.T) {
+	stateDB := dbm.NewDB("state", dbBackend, dbDir)
+	defer stateDB.Close()
+	genDoc := newBaseGenDoc(permission.ZeroAccountPermissions, permission.ZeroAccountPermissions)
+	for i := range genDoc.Accounts {
+		genDoc.Accounts[i].Permissions.Base.Set(permission.Input|permission.Send|permission.Proposal|permission.Batch,
+			true)
+	}
+	st, err := state.MakeGenesisState(stateDB, &genDoc)
+	require.NoError(t, err)
+	err = st.InitialCommit()
+	require.NoError(t, err)
+	exe := makeExecutor(st)
+	params := ParamsFromGenesis(testGenesisDoc)
+	params.ProposalThreshold = 2
+	params.ProposalQuorum = 3
+	exe.executor = newExecutor("makeExecutorCache", true, params, st, exe.Blockchain, nil, logger)
+
+	input := func(address crypto.Address) *payload.TxInput {
+		return &payload.TxInput{Address: address, Sequence: exe.getAccount(t, address).Sequence + 1}
+	}
+	newProposal := func(name string, expiryHeight uint64) *payload.Proposal {
+		send := payload.NewSendTx()
+		in := input(users[3].GetAddress())
+		in.Amount = 10
+		send.Inputs = []*payload.TxInput{in}
+		send.AddOutput(users[2].GetAddress(), 10)
+		return &payload.Proposal{
+			Name:         name,
+			Description:  "Pay users[2]",
+			ExpiryHeight: expiryHeight,
+			BatchTx: &payload.BatchTx{
+				Inputs: []*payload.TxInput{input(users[4].GetAddress())},
+				Txs:    []*payload.Any{send.Any()},
+			},
+		}
+	}
+	// Returns the states of the proposal events emitted
+	propose := func(signer acm.AddressableSigner, tx *payload.ProposalTx) ([]payload.Ballot_ProposalState, error) {
+		tx.Input = input(signer.GetAddress())
+		tx.VotingWeight = 1
+		txEnv := txs.Enclose(testChainID, tx)
+		err := txEnv.Sign(signer)
+		require.NoError(t, err)
+		txe, err := exe.Execute(txEnv)
+		if err != nil {
+			return nil, err
+		}
+		_, err = exe.Commit(nil)
+		require.NoError(t, err)
+		var states []payload.Ballot_ProposalState
+		for _, ev := range txe.Events {
+			if ev.Proposal != nil {
+				states = append(states, ev.Proposal.ProposalState)
+			}
+		}
+		return states, nil
+	}
+	vote := func(signer acm.AddressableSigner, hash []byte, choice payload.Vote_VoteChoice) (
+		[]payload.Ballot_ProposalState, error) {
+		proposalHash := HexBytes(hash)
+		return propose(signer, &payload.ProposalTx{ProposalHash: &proposalHash, Choice: choice})
+	}
+	withdraw := func(signer acm.AddressableSigner, hash []byte) error {
+		proposalHash := HexBytes(hash)
+		_, err := propose(signer, &payload.ProposalTx{ProposalHash: &proposalHash, Withdraw: true})
+		return err
+	}
+	ballot := func(hash []byte) *payload.Ballot {
+		b, err := exe.proposalRegCache.GetProposal(hash)
+		require.NoError(t, err)
+		return b
+	}
+
+	proposal := newProposal("pay", exe.LastBlockHeight()+20)
+	hash := proposal.Hash()
+	states, err := propose(users[0], &payload.ProposalTx{Proposal: proposal})
+	require.NoError(t, err)
+	assert.Equal(t, []payload.Ballot_ProposalState{payload.Ballot_PROPOSED}, states)
+
+	// Two votes for meet the threshold but not the quorum
+	_, err = vote(users[1], hash, payload.Vote_FOR)
+	require.NoError(t, err)
+	assert.Equal(t, payload.Ballot_PROPOSED, ballot(hash).ProposalState)
+
+	require.NoError(t, withdraw(users[1], hash))
+	assert.Len(t, ballot(hash).Votes, 1)
+	assert.Error(t, withdraw(users[1], hash))
+
+	_, err = vote(users[1], hash, payload.Vote_AGAINST)
+	require.NoError(t, err)
+	_, err = vote(users[2], hash, payload.Vote_ABSTAIN)
+	require.NoError(t, err)
+	assert.Equal(t, payload.Ballot_PROPOSED, ballot(hash).ProposalState)
+	_, err = vote(users[0], hash, payload.Vote_FOR)
+	assert.Error(t, err, "already voted")
+
+	// Changing a vote against to one for executes the proposal
+	require.NoError(t, withdraw(users[1], hash))
+	balanceBefore := exe.getAccount(t, users[2].GetAddress()).Balance
+	states, err = vote(users[1], hash, payload.Vote_FOR)
+	require.NoError(t, err)
+	assert.Equal(t, []payload.Ballot_ProposalState{payload.Ballot_EXECUTED}, states)
+	assert.Equal(t, payload.Ballot_EXECUTED, ballot(hash).ProposalState)
+	assert.Equal(t, balanceBefore+10, exe.getAccount(t, users[2].GetAddress()).Balance)
+	_, err = vote(users[3], hash, payload.Vote_FOR)
+	assert.Error(t, err)
+
+	// Returns the states of the proposal events emitted by the block itself when it is committed
+	commit := func() []payload.Ballot_ProposalState {
+		block := exe.block
+		_, err := exe.Commit(nil)
+		require.NoError(t, err)
+		var states []payload.Ballot_ProposalState
+		for _, ev := range block.Events {
+			if ev.Proposal != nil {
+				states = append(states, ev.Proposal.ProposalState)
+			}
+		}
+		return states
+	}
+
+	// A proposal expires at the end of the block at its expiry height
+	proposal = newProposal("expiring", exe.LastBlockHeight()+3)
+	hash = proposal.Hash()
+	_, err = propose(users[0], &payload.ProposalTx{Proposal: proposal})
+	require.NoError(t, err)
+	_, err = vote(users[1], hash, payload.Vote_AGAINST)
+	require.NoError(t, err)
+	assert.Equal(t, payload.Ballot_PROPOSED, ballot(hash).ProposalState)
+	assert.Equal(t, []payload.Ballot_ProposalState{payload.Ballot_EXPIRED}, commit())
+	assert.Equal(t, payload.Ballot_EXPIRED, ballot(hash).ProposalState)
+	assert.Len(t, ballot(hash).Votes, 2)
+	_, err = vote(users[2], hash, payload.Vote_FOR)
+	assert.Error(t, err)
+
+	// Even when nobody votes on it after it is proposed
+	proposal = newProposal("unvoted", exe.LastBlockHeight()+3)
+	hash = proposal.Hash()
+	_, err = propose(users[0], &payload.ProposalTx{Proposal: proposal})
+	require.NoError(t, err)
+	assert.Empty(t, commit())
+	assert.Equal(t, payload.Ballot_PROPOSED, ballot(hash).ProposalState)
+	assert.Equal(t, []payload.Ballot_ProposalState{payload.Ballot_EXPIRED}, commit())
+	assert.Equal(t, payload.Ballot_EXPIRED, ballot(hash).ProposalState)
+	assert.Empty(t, commit())
+
+	_, err = propose(users[0], &payload.ProposalTx{Proposal: newProposal("expired", exe.LastBlockHeight())})
+	assert.Error(t, err)
+}
+
+func TestCallACL(t *testing.T) {
+	stateDB := dbm.NewDB("state", dbBackend, dbDir)
+	defer stateDB.Close()
+	genDoc := newBaseGenDoc(permission.ZeroAccountPermissions, permission.ZeroAccountPermissions)
+	genDoc.Accounts[0].Permissions.Base.Set(permission.Input|permission.Call, true)
+	genDoc.Accounts[1].Permissions.Base.Set(permission.Input, true)
+	genDoc.Accounts[1].Permissions.AddRole("partner")
+	st, err := state.MakeGenesisState(stateDB, &genDoc)
+	require.NoError(t, err)
+	err = st.InitialCommit()
+	require.NoError(t, err)
+	exe := makeExecutor(st)
+
+	contract := exe.getAccount(t, users[4].GetAddress())
+	// Returns 1
+	contract.Code = hex.MustDecodeString("600160005260206000F3")
+	exe.updateAccounts(t, contract)
+	call := func(user acm.AddressableSigner) error {
+		tx, err := payload.NewCallTx(exe.stateCache, user.GetPublicKey(), &contract.Address, nil, 10, 10000, 1)
+		require.NoError(t, err)
+		return exe.signExecuteCommit(tx, user)
+	}
+
+	require.NoError(t, call(users[0]))
+	require.Error(t, call(users[1]), "no Call permission")
+
+	grant := permission.RoleCallGrant("partner", nil)
+	testSNativeTxExpectPass(t, exe, permission.GrantCall, permission.GrantCallArgs(contract.Address, grant))
+	assert.Equal(t, []permission.CallGrant{grant}, exe.getAccount(t, contract.Address).Permissions.CallACL)
+	require.NoError(t, call(users[1]), "granted by the call ACL")
+	require.Error(t, call(users[0]), "Call permission does not extend to a contract with a call ACL")
+
+	testSNativeTxExpectFail(t, exe, permission.RevokeCallArgs(contract.Address, grant))
+	testSNativeTxExpectPass(t, exe, permission.RevokeCall, permission.RevokeCallArgs(contract.Address, grant))
+	require.NoError(t, call(users[0]))
+	require.Error(t, call(users[1]))
+}
+
+func TestPermissionGrants(t *testing.T) {
+	stateDB := dbm.NewDB("state", dbBackend, dbDir)
+	defer stateDB.Close()
+	genDoc := newBaseGenDoc(permission.ZeroAccountPermissions, permission.ZeroAccountPermissions)
+	genDoc.Accounts[1].Permissions.Base.Set(permission.Input, true)
+	genDoc.Accounts[2].Permissions.Base.Set(permission.Input|permission.Call|permission.Delegate, true)
+	genDoc.Accounts[3].Permissions.Base.Set(permission.Input, true)
+	st, err := state.MakeGenesisState(stateDB, &genDoc)
+	require.NoError(t, err)
+	err = st.InitialCommit()
+	require.NoError(t, err)
+	exe := makeExecutor(st)
+
+	contract := exe.getAccount(t, users[4].GetAddress())
+	// Returns 1
+	contract.Code = hex.MustDecodeString("600160005260206000F3")
+	exe.updateAccounts(t, contract)
+	call := func(user acm.AddressableSigner) error {
+		tx, err := payload.NewCallTx(exe.stateCache, user.GetPublicKey(), &contract.Address, nil, 10, 10000, 1)
+		require.NoError(t, err)
+		return exe.signExecuteCommit(tx, user)
+	}
+	delegate := func(user acm.AddressableSigner, args permission.PermArgs) error {
+		tx, err := payload.NewPermsTx(exe.stateCache, user.GetPublicKey(), args)
+		require.NoError(t, err)
+		return exe.signExecuteCommit(tx, user)
+	}
+
+	require.Error(t, call(users[1]), "no Call permission")
+
+	// Granted until two blocks after the one that grants it
+	expiryHeight := exe.Blockchain.LastBlockHeight() + 3
+	testSNativeTxExpectPass(t, exe, permission.SetBase,
+		permission.SetBaseUntilArgs(users[1].GetAddress(), permission.Call, expiryHeight, 0))
+	require.NoError(t, call(users[1]))
+	require.NoError(t, call(users[1]))
+	require.Equal(t, expiryHeight, exe.Blockchain.LastBlockHeight()+1)
+	require.Error(t, call(users[1]), "grant has expired")
+
+	// Unsetting the permission revokes any grant of it
+	testSNativeTxExpectPass(t, exe, permission.SetBase,
+		permission.SetBaseUntilArgs(users[1].GetAddress(), permission.Call, 0, time.Now().Add(time.Hour).Unix()))
+	require.NoError(t, call(users[1]))
+	testSNativeTxExpectPass(t, exe, permission.UnsetBase, permission.UnsetBaseArgs(users[1].GetAddress(), permission.Call))
+	assert.Empty(t, exe.getAccount(t, users[1].GetAddress()).Permissions.Grants)
+	require.Error(t, call(users[1]))
+
+	// Delegation needs an expiry and only extends to permissions the delegating account holds itself
+	require.Error(t, delegate(users[2], permission.DelegateArgs(users[3].GetAddress(), permission.Call, 0, 0)))
+	require.Error(t, delegate(users[2], permission.DelegateArgs(users[3].GetAddress(), permission.CreateContract,
+		exe.Blockchain.LastBlockHeight()+3, 0)))
+	expiryHeight = exe.Blockchain.LastBlockHeight() + 3
+	require.NoError(t, delegate(users[2], permission.DelegateArgs(users[3].GetAddress(), permission.Call, expiryHeight, 0)))
+	assert.Equal(t, []permission.PermissionGrant{
+		permission.DelegatedGrant(users[2].GetAddress(), permission.Call, expiryHeight, 0),
+	}, exe.getAccount(t, users[3].GetAddress()).Permissions.Grants)
+	require.Error(t, delegate(users[3], permission.DelegateArgs(users[1].GetAddress(), permission.Call, expiryHeight, 0)),
+		"delegated permissions cannot be delegated again")
+	require.NoError(t, call(users[3]))
+	require.Error(t, call(users[3]), "delegation has expired")
+}
+
 //-------------------------------------------------------------------------------------
 // helpers
 
@@ -40,6 +40,10 @@ type proposalInfo struct {
 
 type ProposalHash [sha256.Size]byte
 
+func (h ProposalHash) Bytes() []byte {
+	return append([]byte(nil), h[:]...)
+}
+
 type ProposalHashArray []ProposalHash
 
 func (p ProposalHashArray) Len() int {
@@ -116,6 +120,33 @@ func (cache *Cache) RemoveProposal(proposalHash []byte) error {
 	return nil
 }
 
+// Iterates over the proposals read or written through the cache and not removed, in order of their hashes
+func (cache *Cache) IterateCachedProposals(consumer func(proposalHash []byte, proposal *payload.Ballot) error) error {
+	cache.RLock()
+	var hashes ProposalHashArray
+	for hash := range cache.proposals {
+		hashes = append(hashes, hash)
+	}
+	cache.RUnlock()
+	sort.Stable(hashes)
+
+	for _, hash := range hashes {
+		proposalHash := hash.Bytes()
+		ballot, err := cache.GetProposal(proposalHash)
+		if err != nil {
+			return err
+		}
+		if ballot == nil {
+			continue
+		}
+		err = consumer(proposalHash, ballot)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Writes whatever is in the cache to the output Writer state. Does not flush the cache, to do that call Reset()
 // after Sync or use Flusth if your wish to use the output state as your next backend
 func (cache *Cache) Sync(state Writer) error {
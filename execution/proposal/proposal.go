@@ -24,6 +24,11 @@ type Iterable interface {
 	IterateProposals(consumer func(proposalHash []byte, proposal *payload.Ballot) error) (err error)
 }
 
+// Iterates over the proposals open to votes that expire by a block height
+type ExpiryIterable interface {
+	IterateExpiringProposals(height uint64, consumer func(proposalHash []byte) error) error
+}
+
 type IterableReader interface {
 	Iterable
 	Reader
@@ -8,6 +8,7 @@ import (
 )
 
 var _ proposal.IterableReader = &State{}
+var _ proposal.ExpiryIterable = &State{}
 
 func (s *ReadState) GetProposal(proposalHash []byte) (*payload.Ballot, error) {
 	tree, err := s.Forest.Reader(keys.Proposal.Prefix())
@@ -33,7 +34,7 @@ func (ws *writeState) UpdateProposal(proposalHash []byte, p *payload.Ballot) err
 	}
 
 	tree.Set(keys.Proposal.KeyNoPrefix(proposalHash), bs)
-	return nil
+	return ws.indexProposalExpiry(proposalHash, p)
 }
 
 func (ws *writeState) RemoveProposal(proposalHash []byte) error {
@@ -41,7 +42,38 @@ func (ws *writeState) RemoveProposal(proposalHash []byte) error {
 	if err != nil {
 		return err
 	}
-	tree.Delete(keys.Proposal.KeyNoPrefix(proposalHash))
+	key := keys.Proposal.KeyNoPrefix(proposalHash)
+	if bs := tree.Get(key); len(bs) > 0 {
+		ballot, err := payload.DecodeBallot(bs)
+		if err != nil {
+			return err
+		}
+		// Removing the ballot closes it to votes
+		ballot.ProposalState = payload.Ballot_FAILED
+		err = ws.indexProposalExpiry(proposalHash, ballot)
+		if err != nil {
+			return err
+		}
+	}
+	tree.Delete(key)
+	return nil
+}
+
+// Keeps a proposal in the expiry index for as long as it is open to votes and has an expiry height
+func (ws *writeState) indexProposalExpiry(proposalHash []byte, ballot *payload.Ballot) error {
+	if ballot.Proposal == nil || ballot.Proposal.ExpiryHeight == 0 {
+		return nil
+	}
+	tree, err := ws.forest.Writer(keys.ProposalExpiry.Prefix())
+	if err != nil {
+		return err
+	}
+	key := keys.ProposalExpiry.KeyNoPrefix(ballot.Proposal.ExpiryHeight, proposalHash)
+	if ballot.ProposalState == payload.Ballot_PROPOSED {
+		tree.Set(key, proposalHash)
+	} else {
+		tree.Delete(key)
+	}
 	return nil
 }
 
@@ -58,3 +90,14 @@ func (s *ReadState) IterateProposals(consumer func(proposalHash []byte, proposal
 		return consumer(key, entry)
 	})
 }
+
+// Iterates over the hashes of the proposals open to votes that expire at or before height in order of expiry height
+func (s *ReadState) IterateExpiringProposals(height uint64, consumer func(proposalHash []byte) error) error {
+	tree, err := s.Forest.Reader(keys.ProposalExpiry.Prefix())
+	if err != nil {
+		return err
+	}
+	return tree.Iterate(nil, keys.ProposalExpiry.KeyNoPrefix(height+1), true, func(_ []byte, value []byte) error {
+		return consumer(value)
+	})
+}
@@ -0,0 +1,61 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/db"
+)
+
+func TestState_IterateExpiringProposals(t *testing.T) {
+	s := NewState(db.NewMemDB())
+	ballot := func(name string, expiryHeight uint64) ([]byte, *payload.Ballot) {
+		p := &payload.Proposal{Name: name, ExpiryHeight: expiryHeight}
+		return p.Hash(), &payload.Ballot{Proposal: p, ProposalState: payload.Ballot_PROPOSED}
+	}
+	hashA, ballotA := ballot("A", 10)
+	hashB, ballotB := ballot("B", 20)
+	hashC, ballotC := ballot("C", 10)
+	hashD, ballotD := ballot("D", 0)
+	_, _, err := s.Update(func(ws Updatable) error {
+		for hash, b := range map[string]*payload.Ballot{
+			string(hashA): ballotA,
+			string(hashB): ballotB,
+			string(hashC): ballotC,
+			string(hashD): ballotD,
+		} {
+			err := ws.UpdateProposal([]byte(hash), b)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	expiring := func(height uint64) [][]byte {
+		var hashes [][]byte
+		require.NoError(t, s.IterateExpiringProposals(height, func(proposalHash []byte) error {
+			hashes = append(hashes, proposalHash)
+			return nil
+		}))
+		return hashes
+	}
+	assert.Empty(t, expiring(9))
+	assert.ElementsMatch(t, [][]byte{hashA, hashC}, expiring(10))
+	assert.Len(t, expiring(20), 3)
+
+	// Proposals leave the index once they are closed to votes
+	_, _, err = s.Update(func(ws Updatable) error {
+		ballotA.ProposalState = payload.Ballot_EXECUTED
+		err := ws.UpdateProposal(hashA, ballotA)
+		if err != nil {
+			return err
+		}
+		return ws.RemoveProposal(hashB)
+	})
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{hashC}, expiring(20))
+}
@@ -49,13 +49,14 @@ var _ names.IterableReader = &State{}
 var _ Updatable = &writeState{}
 
 type KeyFormatStore struct {
-	Account   *storage.MustKeyFormat
-	Storage   *storage.MustKeyFormat
-	Name      *storage.MustKeyFormat
-	Proposal  *storage.MustKeyFormat
-	Validator *storage.MustKeyFormat
-	Event     *storage.MustKeyFormat
-	TxHash    *storage.MustKeyFormat
+	Account        *storage.MustKeyFormat
+	Storage        *storage.MustKeyFormat
+	Name           *storage.MustKeyFormat
+	Proposal       *storage.MustKeyFormat
+	ProposalExpiry *storage.MustKeyFormat
+	Validator      *storage.MustKeyFormat
+	Event          *storage.MustKeyFormat
+	TxHash         *storage.MustKeyFormat
 }
 
 var keys = KeyFormatStore{
@@ -67,6 +68,8 @@ var keys = KeyFormatStore{
 	Name: storage.NewMustKeyFormat("n", storage.VariadicSegmentLength),
 	// ProposalHash -> Proposal
 	Proposal: storage.NewMustKeyFormat("p", sha256.Size),
+	// ExpiryHeight, ProposalHash -> ProposalHash for proposals still open to votes
+	ProposalExpiry: storage.NewMustKeyFormat("pe", uint64Length, sha256.Size),
 	// ValidatorAddress -> Power
 	Validator: storage.NewMustKeyFormat("v", crypto.AddressLength),
 	// Height, EventIndex -> StreamEvent
@@ -64,6 +64,8 @@ const DefaultProposalThreshold uint64 = 3
 
 type params struct {
 	ProposalThreshold uint64
+	// The number of accounts that must vote for, against or abstain on a proposal before it can execute
+	ProposalQuorum uint64 `json:",omitempty" toml:",omitempty"`
 }
 
 type GenesisDoc struct {
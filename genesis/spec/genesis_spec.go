@@ -34,6 +34,7 @@ type GenesisSpec struct {
 
 type params struct {
 	ProposalThreshold uint64 `json:",omitempty" toml:",omitempty"`
+	ProposalQuorum    uint64 `json:",omitempty" toml:",omitempty"`
 }
 
 func (gs *GenesisSpec) RealiseKeys(keyClient keys.KeyClient) error {
@@ -64,6 +65,7 @@ func (gs *GenesisSpec) GenesisDoc(keyClient keys.KeyClient, generateNodeKeys boo
 	if gs.Params.ProposalThreshold != 0 {
 		genesisDoc.Params.ProposalThreshold = DefaultProposalThreshold
 	}
+	genesisDoc.Params.ProposalQuorum = gs.Params.ProposalQuorum
 
 	if len(gs.GlobalPermissions) == 0 {
 		genesisDoc.GlobalPermissions = permission.DefaultAccountPermissions.Clone()
@@ -19,6 +19,7 @@ import math "math"
 import _ "github.com/gogo/protobuf/gogoproto"
 import crypto "github.com/hyperledger/burrow/crypto"
 import balance "github.com/hyperledger/burrow/acm/balance"
+import acm "github.com/hyperledger/burrow/acm"
 
 import github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
 import github_com_hyperledger_burrow_acm "github.com/hyperledger/burrow/acm"
@@ -46,6 +47,10 @@ type TemplateAccount struct {
 	Permissions []string                                      `protobuf:"bytes,6,rep,name=Permissions" json:",omitempty" toml:",omitempty"`
 	Roles       []string                                      `protobuf:"bytes,7,rep,name=Roles" json:",omitempty" toml:",omitempty"`
 	Code        *github_com_hyperledger_burrow_acm.Bytecode   `protobuf:"bytes,8,opt,name=Code,proto3,customtype=github.com/hyperledger/burrow/acm.Bytecode" json:"Code,omitempty"`
+	// Makes the account a multisig account
+	Multisig *acm.Multisig `protobuf:"bytes,9,opt,name=Multisig" json:",omitempty" toml:",omitempty"`
+	// Replaces the public key of the account at Address with PublicKey, keeping its address
+	RotateKey bool `protobuf:"varint,10,opt,name=RotateKey,proto3" json:",omitempty" toml:",omitempty"`
 }
 
 func (m *TemplateAccount) Reset()                    { *m = TemplateAccount{} }
@@ -88,6 +93,20 @@ func (m *TemplateAccount) GetRoles() []string {
 	return nil
 }
 
+func (m *TemplateAccount) GetMultisig() *acm.Multisig {
+	if m != nil {
+		return m.Multisig
+	}
+	return nil
+}
+
+func (m *TemplateAccount) GetRotateKey() bool {
+	if m != nil {
+		return m.RotateKey
+	}
+	return false
+}
+
 func (*TemplateAccount) XXX_MessageName() string {
 	return "spec.TemplateAccount"
 }
@@ -198,6 +217,26 @@ func (m *TemplateAccount) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n4
 	}
+	if m.Multisig != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintSpec(dAtA, i, uint64(m.Multisig.Size()))
+		n5, err := m.Multisig.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if m.RotateKey {
+		dAtA[i] = 0x50
+		i++
+		if m.RotateKey {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -251,6 +290,13 @@ func (m *TemplateAccount) Size() (n int) {
 		l = m.Code.Size()
 		n += 1 + l + sovSpec(uint64(l))
 	}
+	if m.Multisig != nil {
+		l = m.Multisig.Size()
+		n += 1 + l + sovSpec(uint64(l))
+	}
+	if m.RotateKey {
+		n += 2
+	}
 	return n
 }
 
@@ -543,6 +589,59 @@ func (m *TemplateAccount) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multisig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSpec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Multisig == nil {
+				m.Multisig = &acm.Multisig{}
+			}
+			if err := m.Multisig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RotateKey", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RotateKey = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSpec(dAtA[iNdEx:])
@@ -673,31 +772,34 @@ func init() { proto.RegisterFile("spec.proto", fileDescriptorSpec) }
 func init() { golang_proto.RegisterFile("spec.proto", fileDescriptorSpec) }
 
 var fileDescriptorSpec = []byte{
-	// 411 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0x31, 0x6f, 0xd4, 0x30,
-	0x14, 0x3e, 0x73, 0x77, 0xbd, 0x9e, 0xaf, 0x08, 0xea, 0x29, 0xea, 0x10, 0x47, 0xc7, 0x40, 0x84,
-	0x4a, 0x22, 0x1d, 0x13, 0x9d, 0xb8, 0x20, 0x58, 0x90, 0xaa, 0x2a, 0xed, 0xc4, 0x96, 0x38, 0x8f,
-	0x34, 0x52, 0x1c, 0x47, 0xb6, 0x23, 0x94, 0xbf, 0xc0, 0xc4, 0xc8, 0xdc, 0x5f, 0xc2, 0x98, 0x91,
-	0xb9, 0x43, 0x84, 0xae, 0x1b, 0x23, 0xbf, 0x00, 0x9d, 0x73, 0xa1, 0x37, 0x41, 0x96, 0x4e, 0x7e,
-	0x9f, 0x9f, 0xbe, 0xef, 0x7b, 0x7a, 0x9f, 0x8d, 0xb1, 0x2a, 0x81, 0x79, 0xa5, 0x14, 0x5a, 0x90,
-	0xc9, 0xb6, 0x3e, 0x79, 0x99, 0x66, 0xfa, 0xba, 0x8a, 0x3d, 0x26, 0xb8, 0x9f, 0x8a, 0x54, 0xf8,
-	0xa6, 0x19, 0x57, 0x9f, 0x0c, 0x32, 0xc0, 0x54, 0x1d, 0xe9, 0xe4, 0x88, 0xc9, 0xba, 0xd4, 0x3d,
-	0x7a, 0x1c, 0x47, 0x79, 0x54, 0x30, 0xe8, 0xe0, 0xf2, 0xcb, 0x14, 0x3f, 0xb9, 0x02, 0x5e, 0xe6,
-	0x91, 0x86, 0x35, 0x63, 0xa2, 0x2a, 0x34, 0x21, 0x78, 0x72, 0x1e, 0x71, 0xb0, 0x90, 0x83, 0xdc,
-	0x79, 0x68, 0x6a, 0xc2, 0xf1, 0x6c, 0x9d, 0x24, 0x12, 0x94, 0xb2, 0x1e, 0x39, 0xc8, 0x3d, 0x0a,
-	0x2e, 0x6f, 0x5b, 0x7a, 0xba, 0x37, 0xc8, 0x75, 0x5d, 0x82, 0xcc, 0x21, 0x49, 0x41, 0xfa, 0x71,
-	0x25, 0xa5, 0xf8, 0xec, 0xef, 0x7c, 0x77, 0xbc, 0x5f, 0x2d, 0xc5, 0xa7, 0x82, 0x67, 0x1a, 0x78,
-	0xa9, 0xeb, 0xdf, 0x2d, 0x3d, 0xd6, 0x82, 0xe7, 0x67, 0xcb, 0xfb, 0xbb, 0x65, 0xd8, 0x7b, 0x90,
-	0x0a, 0x2f, 0xce, 0x45, 0x02, 0xbd, 0xe5, 0xf8, 0xe1, 0x2c, 0xf7, 0x7d, 0xc8, 0x15, 0x9e, 0x5f,
-	0x54, 0x71, 0x9e, 0xb1, 0x0f, 0x50, 0x5b, 0x13, 0x07, 0xb9, 0x8b, 0xd5, 0xb1, 0xb7, 0xd3, 0xfc,
-	0xdb, 0x08, 0x9e, 0x0d, 0xd1, 0xbd, 0x17, 0x22, 0x97, 0x78, 0xb6, 0xe6, 0xdb, 0xcd, 0x2a, 0x6b,
-	0xea, 0x8c, 0xdd, 0xc5, 0xea, 0xa9, 0xd7, 0x87, 0x10, 0x74, 0x67, 0xf0, 0xbc, 0x69, 0xe9, 0x68,
-	0xd8, 0x86, 0x3a, 0x25, 0xf2, 0x0e, 0x2f, 0x2e, 0x40, 0xf2, 0x4c, 0xa9, 0x4c, 0x14, 0xca, 0x3a,
-	0x70, 0xc6, 0xee, 0x7c, 0xd8, 0x64, 0xfb, 0x3c, 0xf2, 0x1a, 0x4f, 0x43, 0x91, 0x83, 0xb2, 0x66,
-	0xc3, 0x05, 0x3a, 0x06, 0x79, 0x8f, 0x27, 0x6f, 0x45, 0x02, 0xd6, 0xa1, 0x09, 0x67, 0xd5, 0xb4,
-	0x14, 0xdd, 0xb6, 0xf4, 0xc5, 0xbf, 0x03, 0x8a, 0x18, 0xf7, 0x82, 0x5a, 0x03, 0x13, 0x09, 0x84,
-	0x86, 0x7f, 0x76, 0xf8, 0xf5, 0x86, 0x8e, 0xbe, 0xdd, 0xd0, 0x51, 0xf0, 0xa6, 0xd9, 0xd8, 0xe8,
-	0xc7, 0xc6, 0x46, 0x3f, 0x37, 0x36, 0xfa, 0x7e, 0x67, 0xa3, 0xe6, 0xce, 0x46, 0x1f, 0xff, 0xa3,
-	0x98, 0x42, 0x01, 0x2a, 0x53, 0xfe, 0xf6, 0x6b, 0xc4, 0x07, 0xe6, 0x55, 0xbf, 0xfa, 0x13, 0x00,
-	0x00, 0xff, 0xff, 0xcf, 0x67, 0x15, 0x5d, 0x35, 0x03, 0x00, 0x00,
+	// 451 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0xcf, 0x91, 0x34, 0x89, 0x2f, 0xad, 0xa0, 0x37, 0x59, 0x1d, 0x6c, 0x2b, 0x0c, 0x58, 0xa8,
+	0xd8, 0x52, 0x98, 0xe8, 0x44, 0x8c, 0x60, 0x41, 0x44, 0x95, 0xdb, 0x89, 0xcd, 0x3e, 0x3f, 0x5c,
+	0x4b, 0xbe, 0x9c, 0x75, 0x77, 0x16, 0xf2, 0xb7, 0x60, 0x64, 0x61, 0xe9, 0x27, 0x61, 0xcc, 0xc8,
+	0xdc, 0xc1, 0x42, 0xe9, 0xc6, 0xc8, 0x27, 0x40, 0x3e, 0xc7, 0x69, 0x26, 0xf0, 0xc2, 0xe4, 0xf7,
+	0xee, 0xfc, 0xfb, 0xe3, 0xf7, 0xf3, 0xc3, 0x58, 0x16, 0x40, 0xbd, 0x42, 0x70, 0xc5, 0xc9, 0xa8,
+	0xa9, 0xcf, 0x5e, 0xa4, 0x99, 0xba, 0x29, 0x63, 0x8f, 0x72, 0xe6, 0xa7, 0x3c, 0xe5, 0xbe, 0xbe,
+	0x8c, 0xcb, 0x4f, 0xba, 0xd3, 0x8d, 0xae, 0x5a, 0xd0, 0xd9, 0x31, 0x15, 0x55, 0xa1, 0xba, 0xee,
+	0x24, 0x8e, 0xf2, 0x68, 0x4d, 0x61, 0xd7, 0x1a, 0x11, 0x65, 0x6d, 0x39, 0xff, 0x36, 0xc6, 0x8f,
+	0xaf, 0x81, 0x15, 0x79, 0xa4, 0x60, 0x49, 0x29, 0x2f, 0xd7, 0x8a, 0x10, 0x3c, 0x5a, 0x45, 0x0c,
+	0x4c, 0xe4, 0x20, 0xd7, 0x08, 0x75, 0x4d, 0x18, 0x9e, 0x2c, 0x93, 0x44, 0x80, 0x94, 0xe6, 0x23,
+	0x07, 0xb9, 0xc7, 0xc1, 0xd5, 0x5d, 0x6d, 0x9f, 0x1f, 0x78, 0xba, 0xa9, 0x0a, 0x10, 0x39, 0x24,
+	0x29, 0x08, 0x3f, 0x2e, 0x85, 0xe0, 0x9f, 0xfd, 0x9d, 0x85, 0x1d, 0xee, 0x57, 0x6d, 0xe3, 0x73,
+	0xce, 0x32, 0x05, 0xac, 0x50, 0xd5, 0xef, 0xda, 0x3e, 0x55, 0x9c, 0xe5, 0x17, 0xf3, 0x87, 0xb3,
+	0x79, 0xd8, 0x69, 0x90, 0x12, 0xcf, 0x56, 0x3c, 0x81, 0x4e, 0x72, 0xf8, 0xff, 0x24, 0x0f, 0x75,
+	0xc8, 0x35, 0x36, 0x2e, 0xcb, 0x38, 0xcf, 0xe8, 0x7b, 0xa8, 0xcc, 0x91, 0x83, 0xdc, 0xd9, 0xe2,
+	0xd4, 0xdb, 0x71, 0xee, 0x2f, 0x82, 0xa7, 0x7d, 0x78, 0x1f, 0x88, 0xc8, 0x15, 0x9e, 0x2c, 0x59,
+	0x33, 0x59, 0x69, 0x1e, 0x39, 0x43, 0x77, 0xb6, 0x78, 0xe2, 0x75, 0x79, 0x04, 0xed, 0x33, 0x78,
+	0xb6, 0xa9, 0xed, 0x41, 0xbf, 0x09, 0xb5, 0x4c, 0xe4, 0x2d, 0x9e, 0x5d, 0x82, 0x60, 0x99, 0x94,
+	0x19, 0x5f, 0x4b, 0x73, 0xec, 0x0c, 0x5d, 0xa3, 0x9f, 0xb3, 0x43, 0x1c, 0x79, 0x85, 0x8f, 0x42,
+	0x9e, 0x83, 0x34, 0x27, 0xfd, 0x09, 0x5a, 0x04, 0x79, 0x87, 0x47, 0x6f, 0x78, 0x02, 0xe6, 0x54,
+	0x87, 0xb3, 0xd8, 0xd4, 0x36, 0xba, 0xab, 0xed, 0xe7, 0x7f, 0x0f, 0xa8, 0xf9, 0xf3, 0x82, 0x4a,
+	0x01, 0xe5, 0x09, 0x84, 0x1a, 0x4f, 0x56, 0x78, 0xfa, 0xa1, 0xcc, 0x55, 0x26, 0xb3, 0xd4, 0x34,
+	0xf4, 0xcc, 0x4f, 0xbc, 0xe6, 0xb5, 0xee, 0xb0, 0x9f, 0xa9, 0x3d, 0x07, 0x59, 0x62, 0x23, 0xe4,
+	0x2a, 0x52, 0xd0, 0x84, 0x88, 0x1d, 0xe4, 0x4e, 0x7b, 0x26, 0xb6, 0x47, 0x5d, 0x4c, 0xbf, 0xdc,
+	0xda, 0x83, 0xaf, 0xb7, 0xf6, 0x20, 0x78, 0xbd, 0xd9, 0x5a, 0xe8, 0xc7, 0xd6, 0x42, 0x3f, 0xb7,
+	0x16, 0xfa, 0x7e, 0x6f, 0xa1, 0xcd, 0xbd, 0x85, 0x3e, 0xfe, 0xe3, 0x23, 0x53, 0x58, 0x83, 0xcc,
+	0xa4, 0xdf, 0x2c, 0x6e, 0x3c, 0xd6, 0x8b, 0xf6, 0xf2, 0xcf, 0x00, 0xc8, 0x2f, 0x9c, 0x66, 0xd3,
+	0x03, 0x00, 0x00,
 }
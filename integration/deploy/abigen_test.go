@@ -0,0 +1,81 @@
+// +build integration
+
+package deploy
+
+import (
+	"context"
+	"io"
+	"math/big"
+	"testing"
+
+	"github.com/hyperledger/burrow/deploy/bind"
+	"github.com/hyperledger/burrow/integration"
+	"github.com/hyperledger/burrow/integration/deploy/bindings"
+	"github.com/hyperledger/burrow/integration/rpctest"
+	"github.com/hyperledger/burrow/rpc/rpcevents"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAbigenBindings(t *testing.T) {
+	kern, shutdown := integration.RunNode(t, rpctest.GenesisDoc, rpctest.PrivateAccounts)
+	defer shutdown()
+
+	ctx := context.Background()
+	tcli := rpctest.NewTransactClient(t, kern.GRPCListenAddress().String())
+	ecli := rpctest.NewExecutionEventsClient(t, kern.GRPCListenAddress().String())
+	opts := &bind.TransactOpts{Input: rpctest.PrivateAccounts[0].GetAddress()}
+
+	t.Run("Call", func(t *testing.T) {
+		zeroReset, _, err := bindings.DeployZeroReset(ctx, opts, tcli, ecli)
+		require.NoError(t, err)
+
+		_, err = zeroReset.SetUint(ctx, opts, big.NewInt(42))
+		require.NoError(t, err)
+		value, _, err := zeroReset.SimulateGetUint(ctx, opts)
+		require.NoError(t, err)
+		assert.Equal(t, "42", value.String())
+
+		_, err = zeroReset.SetInt(ctx, opts, big.NewInt(-3))
+		require.NoError(t, err)
+		signed, _, err := zeroReset.GetInt(ctx, opts)
+		require.NoError(t, err)
+		assert.Equal(t, "-3", signed.String())
+
+		// Bindings can be made to existing contracts
+		bound, err := bindings.NewZeroReset(zeroReset.Address, tcli, ecli)
+		require.NoError(t, err)
+		value, _, err = bound.SimulateGetUint(ctx, opts)
+		require.NoError(t, err)
+		assert.Equal(t, "42", value.String())
+	})
+
+	t.Run("Events", func(t *testing.T) {
+		emitter, _, err := bindings.DeployEventEmitter(ctx, opts, tcli, ecli)
+		require.NoError(t, err)
+		txe, err := emitter.EmitOne(ctx, opts)
+		require.NoError(t, err)
+
+		var events []*bindings.EventEmitterManyTypes
+		err = emitter.FilterManyTypes(ctx, rpcevents.AbsoluteRange(0, txe.Height+1),
+			func(ev *bindings.EventEmitterManyTypes) error {
+				events = append(events, ev)
+				return nil
+			})
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		ev := events[0]
+		assert.Equal(t, txe.TxHash, ev.Header.TxHash)
+		assert.Equal(t, "Downsie!", string(ev.Direction[:8]))
+		assert.True(t, ev.Trueism)
+		assert.Equal(t, int64(102), ev.NewDepth)
+		assert.Equal(t, "42", ev.Bignum.String())
+
+		// Handlers stop filtering by returning io.EOF
+		err = emitter.FilterManyTypes(ctx, rpcevents.AbsoluteRange(0, txe.Height+1),
+			func(ev *bindings.EventEmitterManyTypes) error {
+				return io.EOF
+			})
+		require.NoError(t, err)
+	})
+}
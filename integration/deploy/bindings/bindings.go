@@ -0,0 +1,196 @@
+// Code generated by burrow abigen. DO NOT EDIT.
+
+package bindings
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/deploy/bind"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/rpc/rpcevents"
+	"github.com/hyperledger/burrow/rpc/rpctransact"
+	hex "github.com/tmthrgd/go-hex"
+)
+
+var Abi_ZeroReset = []byte(`[{"constant":true,"inputs":[],"name":"getUint","outputs":[{"name":"retUint","type":"uint256"}],"payable":false,"stateMutability":"view","type":"function"},{"constant":false,"inputs":[{"name":"x","type":"uint256"}],"name":"setUint","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"},{"constant":true,"inputs":[],"name":"getInt","outputs":[{"name":"retInt","type":"int256"}],"payable":false,"stateMutability":"view","type":"function"},{"constant":false,"inputs":[{"name":"x","type":"int256"}],"name":"setInt","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"},{"constant":false,"inputs":[],"name":"setIntToZero","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"},{"constant":false,"inputs":[],"name":"setUintToZero","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"}]`)
+var Bytecode_ZeroReset = hex.MustDecodeString("608060405234801561001057600080fd5b50610195806100206000396000f3fe608060405234801561001057600080fd5b506004361061007e576000357c0100000000000000000000000000000000000000000000000000000000900480620267a4146100835780634ef65c3b146100a157806362738998146100cf578063747586b8146100ed578063987dc8201461011b578063b15a0d5f14610125575b600080fd5b61008b61012f565b6040518082815260200191505060405180910390f35b6100cd600480360360208110156100b757600080fd5b8101908080359060200190929190505050610139565b005b6100d7610143565b6040518082815260200191505060405180910390f35b6101196004803603602081101561010357600080fd5b810190808035906020019092919050505061014c565b005b610123610156565b005b61012d61015f565b005b6000600154905090565b8060018190555050565b60008054905090565b8060008190555050565b60008081905550565b600060018190555056fea165627a7a72305820f425681ba5df6ad8326c87681bfe7f8a84f407dc25e79a4cb790063ac3a8ba1f0029")
+
+// ZeroReset is a binding to an instance of the ZeroReset contract
+type ZeroReset struct {
+	*bind.Contract
+}
+
+// NewZeroReset binds to an instance of ZeroReset deployed at address
+func NewZeroReset(address crypto.Address, transact rpctransact.TransactClient,
+	events rpcevents.ExecutionEventsClient) (*ZeroReset, error) {
+	contract, err := bind.NewContract(address, Abi_ZeroReset, transact, events)
+	if err != nil {
+		return nil, err
+	}
+	return &ZeroReset{contract}, nil
+}
+
+// DeployZeroReset deploys a new instance of ZeroReset and binds to it
+func DeployZeroReset(ctx context.Context, opts *bind.TransactOpts, transact rpctransact.TransactClient,
+	events rpcevents.ExecutionEventsClient) (*ZeroReset, *exec.TxExecution, error) {
+	contract, txe, err := bind.Deploy(ctx, opts, Abi_ZeroReset, Bytecode_ZeroReset, transact, events)
+	if err != nil {
+		return nil, txe, err
+	}
+	return &ZeroReset{contract}, txe, nil
+}
+
+// GetInt sends a transaction calling getInt
+func (c *ZeroReset) GetInt(ctx context.Context, opts *bind.TransactOpts) (*big.Int, *exec.TxExecution, error) {
+	ret0 := new(big.Int)
+	txe, err := c.Contract.Call(ctx, opts, "getInt", []interface{}{}, ret0)
+	return ret0, txe, err
+}
+
+// SimulateGetInt calls getInt against the latest state without sending a transaction
+func (c *ZeroReset) SimulateGetInt(ctx context.Context, opts *bind.TransactOpts) (*big.Int, *exec.TxExecution, error) {
+	ret0 := new(big.Int)
+	txe, err := c.Contract.Simulate(ctx, opts, "getInt", []interface{}{}, ret0)
+	return ret0, txe, err
+}
+
+// GetUint sends a transaction calling getUint
+func (c *ZeroReset) GetUint(ctx context.Context, opts *bind.TransactOpts) (*big.Int, *exec.TxExecution, error) {
+	ret0 := new(big.Int)
+	txe, err := c.Contract.Call(ctx, opts, "getUint", []interface{}{}, ret0)
+	return ret0, txe, err
+}
+
+// SimulateGetUint calls getUint against the latest state without sending a transaction
+func (c *ZeroReset) SimulateGetUint(ctx context.Context, opts *bind.TransactOpts) (*big.Int, *exec.TxExecution, error) {
+	ret0 := new(big.Int)
+	txe, err := c.Contract.Simulate(ctx, opts, "getUint", []interface{}{}, ret0)
+	return ret0, txe, err
+}
+
+// SetInt sends a transaction calling setInt
+func (c *ZeroReset) SetInt(ctx context.Context, opts *bind.TransactOpts, x *big.Int) (*exec.TxExecution, error) {
+	txe, err := c.Contract.Call(ctx, opts, "setInt", []interface{}{x})
+	return txe, err
+}
+
+// SimulateSetInt calls setInt against the latest state without sending a transaction
+func (c *ZeroReset) SimulateSetInt(ctx context.Context, opts *bind.TransactOpts, x *big.Int) (*exec.TxExecution, error) {
+	txe, err := c.Contract.Simulate(ctx, opts, "setInt", []interface{}{x})
+	return txe, err
+}
+
+// SetIntToZero sends a transaction calling setIntToZero
+func (c *ZeroReset) SetIntToZero(ctx context.Context, opts *bind.TransactOpts) (*exec.TxExecution, error) {
+	txe, err := c.Contract.Call(ctx, opts, "setIntToZero", []interface{}{})
+	return txe, err
+}
+
+// SimulateSetIntToZero calls setIntToZero against the latest state without sending a transaction
+func (c *ZeroReset) SimulateSetIntToZero(ctx context.Context, opts *bind.TransactOpts) (*exec.TxExecution, error) {
+	txe, err := c.Contract.Simulate(ctx, opts, "setIntToZero", []interface{}{})
+	return txe, err
+}
+
+// SetUint sends a transaction calling setUint
+func (c *ZeroReset) SetUint(ctx context.Context, opts *bind.TransactOpts, x *big.Int) (*exec.TxExecution, error) {
+	txe, err := c.Contract.Call(ctx, opts, "setUint", []interface{}{x})
+	return txe, err
+}
+
+// SimulateSetUint calls setUint against the latest state without sending a transaction
+func (c *ZeroReset) SimulateSetUint(ctx context.Context, opts *bind.TransactOpts, x *big.Int) (*exec.TxExecution, error) {
+	txe, err := c.Contract.Simulate(ctx, opts, "setUint", []interface{}{x})
+	return txe, err
+}
+
+// SetUintToZero sends a transaction calling setUintToZero
+func (c *ZeroReset) SetUintToZero(ctx context.Context, opts *bind.TransactOpts) (*exec.TxExecution, error) {
+	txe, err := c.Contract.Call(ctx, opts, "setUintToZero", []interface{}{})
+	return txe, err
+}
+
+// SimulateSetUintToZero calls setUintToZero against the latest state without sending a transaction
+func (c *ZeroReset) SimulateSetUintToZero(ctx context.Context, opts *bind.TransactOpts) (*exec.TxExecution, error) {
+	txe, err := c.Contract.Simulate(ctx, opts, "setUintToZero", []interface{}{})
+	return txe, err
+}
+
+var Abi_EventEmitter = []byte(`[{"constant":false,"inputs":[],"name":"EmitOne","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"},{"anonymous":false,"inputs":[{"indexed":true,"name":"direction","type":"bytes32"},{"indexed":false,"name":"trueism","type":"bool"},{"indexed":false,"name":"german","type":"string"},{"indexed":true,"name":"newDepth","type":"int64"},{"indexed":false,"name":"bignum","type":"int256"},{"indexed":true,"name":"hash","type":"string"}],"name":"ManyTypes","type":"event"}]`)
+var Bytecode_EventEmitter = hex.MustDecodeString("6080604052348015600f57600080fd5b506101908061001f6000396000f3fe608060405234801561001057600080fd5b5060043610610048576000357c010000000000000000000000000000000000000000000000000000000090048063e8e49a711461004d575b600080fd5b610055610057565b005b60405180807f68617368000000000000000000000000000000000000000000000000000000008152506004019050604051809103902060667f446f776e736965210000000000000000000000000000000000000000000000007f20aec2a3bcd8050a3a9e852e9d424805bad75ba33b57077464c73ae98d0582696001602a6040518083151515158152602001806020018381526020018281038252605181526020018061011460519139606001935050505060405180910390a456fe446f6e617564616d7066736368696666666168727473656c656b7472697a6974c3a474656e686175707462657472696562737765726b626175756e7465726265616d74656e676573656c6c736368616674a165627a7a7230582043472c03b2946767b21150a9f581b7cee0c585db6817446b4fa045bff32809450029")
+
+// EventEmitter is a binding to an instance of the EventEmitter contract
+type EventEmitter struct {
+	*bind.Contract
+}
+
+// NewEventEmitter binds to an instance of EventEmitter deployed at address
+func NewEventEmitter(address crypto.Address, transact rpctransact.TransactClient,
+	events rpcevents.ExecutionEventsClient) (*EventEmitter, error) {
+	contract, err := bind.NewContract(address, Abi_EventEmitter, transact, events)
+	if err != nil {
+		return nil, err
+	}
+	return &EventEmitter{contract}, nil
+}
+
+// DeployEventEmitter deploys a new instance of EventEmitter and binds to it
+func DeployEventEmitter(ctx context.Context, opts *bind.TransactOpts, transact rpctransact.TransactClient,
+	events rpcevents.ExecutionEventsClient) (*EventEmitter, *exec.TxExecution, error) {
+	contract, txe, err := bind.Deploy(ctx, opts, Abi_EventEmitter, Bytecode_EventEmitter, transact, events)
+	if err != nil {
+		return nil, txe, err
+	}
+	return &EventEmitter{contract}, txe, nil
+}
+
+// EmitOne sends a transaction calling EmitOne
+func (c *EventEmitter) EmitOne(ctx context.Context, opts *bind.TransactOpts) (*exec.TxExecution, error) {
+	txe, err := c.Contract.Call(ctx, opts, "EmitOne", []interface{}{})
+	return txe, err
+}
+
+// SimulateEmitOne calls EmitOne against the latest state without sending a transaction
+func (c *EventEmitter) SimulateEmitOne(ctx context.Context, opts *bind.TransactOpts) (*exec.TxExecution, error) {
+	txe, err := c.Contract.Simulate(ctx, opts, "EmitOne", []interface{}{})
+	return txe, err
+}
+
+// EventEmitterManyTypes is a ManyTypes event emitted by EventEmitter
+type EventEmitterManyTypes struct {
+	Direction []byte
+	Trueism   bool
+	German    string
+	NewDepth  int64
+	Bignum    *big.Int
+	Hash      []byte
+	Log       *exec.LogEvent
+	Header    *exec.Header
+}
+
+// UnpackManyTypes decodes a ManyTypes event from a log emitted by the contract
+func (c *EventEmitter) UnpackManyTypes(log *exec.LogEvent) (*EventEmitterManyTypes, error) {
+	ev := &EventEmitterManyTypes{Log: log}
+	ev.Bignum = new(big.Int)
+	err := c.Contract.UnpackEvent("ManyTypes", log, &ev.Direction, &ev.Trueism, &ev.German, &ev.NewDepth, ev.Bignum, &ev.Hash)
+	if err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
+
+// FilterManyTypes calls handle with each ManyTypes event emitted by the contract within blockRange until the range
+// is exhausted or handle returns an error (io.EOF to stop without error)
+func (c *EventEmitter) FilterManyTypes(ctx context.Context, blockRange *rpcevents.BlockRange,
+	handle func(*EventEmitterManyTypes) error) error {
+	return c.Contract.FilterEvents(ctx, "ManyTypes", blockRange, func(log *exec.LogEvent, header *exec.Header) error {
+		ev, err := c.UnpackManyTypes(log)
+		if err != nil {
+			return err
+		}
+		ev.Header = header
+		return handle(ev)
+	})
+}
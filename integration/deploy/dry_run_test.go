@@ -0,0 +1,122 @@
+// +build integration
+
+package deploy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/solidity"
+	"github.com/hyperledger/burrow/integration"
+	"github.com/hyperledger/burrow/integration/rpctest"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/rpc/rpcquery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hex "github.com/tmthrgd/go-hex"
+)
+
+func TestDryRun(t *testing.T) {
+	kern, shutdown := integration.RunNode(t, rpctest.GenesisDoc, rpctest.PrivateAccounts)
+	defer shutdown()
+
+	logger := logging.NewNoopLogger()
+	qcli := rpctest.NewQueryClient(t, kern.GRPCListenAddress().String())
+	input := rpctest.PrivateAccounts[0].GetAddress().String()
+
+	client := def.NewClient(kern.GRPCListenAddress().String(), "", true, 5*time.Second)
+	client.DryRun = true
+
+	// Deploy a contract
+	tx, err := client.Call(&def.CallArg{
+		Input:  input,
+		Amount: "20",
+		Fee:    "20",
+		Gas:    "1000000",
+		Data:   hex.EncodeUpperToString(solidity.Bytecode_ZeroReset),
+	}, logger)
+	require.NoError(t, err)
+	txe, err := client.SignAndBroadcast(tx, logger)
+	require.NoError(t, err)
+	require.Nil(t, txe.Exception)
+	contractAddress := txe.Receipt.ContractAddress
+
+	// Call it to change its storage
+	data, _, err := abi.EncodeFunctionCall(string(solidity.Abi_ZeroReset), "setUint", logger, 42)
+	require.NoError(t, err)
+	tx, err = client.Call(&def.CallArg{
+		Input:   input,
+		Address: contractAddress.String(),
+		Amount:  "20",
+		Fee:     "20",
+		Gas:     "1000000",
+		Data:    hex.EncodeUpperToString(data),
+	}, logger)
+	require.NoError(t, err)
+	txe, err = client.SignAndBroadcast(tx, logger)
+	require.NoError(t, err)
+	require.Nil(t, txe.Exception)
+
+	// Queries see the forked state
+	data, _, err = abi.EncodeFunctionCall(string(solidity.Abi_ZeroReset), "getUint", logger)
+	require.NoError(t, err)
+	txe, err = client.QueryContract(&def.QueryArg{
+		Input:   input,
+		Address: contractAddress.String(),
+		Data:    hex.EncodeUpperToString(data),
+	}, logger)
+	require.NoError(t, err)
+	vars, err := abi.DecodeFunctionReturn(string(solidity.Abi_ZeroReset), "getUint", txe.Result.Return)
+	require.NoError(t, err)
+	assert.Equal(t, "42", vars[0].Value)
+
+	// Register a name
+	nameTx, err := client.Name(&def.NameArg{
+		Input:  input,
+		Amount: "10000",
+		Name:   "DryRunName",
+		Data:   "Never registered",
+	}, logger)
+	require.NoError(t, err)
+	_, err = client.SignAndBroadcast(nameTx, logger)
+	require.NoError(t, err)
+	entry, err := client.GetName("DryRunName", logger)
+	require.NoError(t, err)
+	assert.Equal(t, "Never registered", entry.Data)
+
+	// Nothing reached the chain
+	acc, err := qcli.GetAccount(context.Background(), &rpcquery.GetAccountParam{Address: contractAddress})
+	require.NoError(t, err)
+	assert.Equal(t, crypto.ZeroAddress, acc.Address)
+	_, err = qcli.GetName(context.Background(), &rpcquery.GetNameParam{Name: "DryRunName"})
+	assert.Error(t, err)
+
+	plan, err := client.Plan()
+	require.NoError(t, err)
+	assert.Len(t, plan.Transactions, 3)
+	assert.True(t, plan.GasUsed > 0)
+	require.Len(t, plan.Names, 1)
+	assert.Equal(t, "DryRunName", plan.Names[0].Name)
+
+	var contract, inputAccount *def.AccountChange
+	for _, change := range plan.Accounts {
+		switch change.Address {
+		case contractAddress:
+			contract = change
+		case rpctest.PrivateAccounts[0].GetAddress():
+			inputAccount = change
+		}
+	}
+	require.NotNil(t, contract)
+	assert.Nil(t, contract.Before)
+	assert.NotEmpty(t, contract.After.Code)
+	assert.Equal(t, 1, contract.StorageChanged)
+	require.NotNil(t, inputAccount)
+	assert.Equal(t, inputAccount.Before.Sequence+3, inputAccount.After.Sequence)
+
+	assert.Contains(t, plan.String(), contractAddress.String()+" created")
+}
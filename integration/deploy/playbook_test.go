@@ -0,0 +1,272 @@
+// +build integration
+
+package deploy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/deploy/compile"
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/deploy/jobs"
+	"github.com/hyperledger/burrow/deploy/loader"
+	"github.com/hyperledger/burrow/execution/solidity"
+	"github.com/hyperledger/burrow/integration"
+	"github.com/hyperledger/burrow/integration/rpctest"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/rpc/rpcquery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hex "github.com/tmthrgd/go-hex"
+)
+
+const playbook = `jobs:
+- name: deployA
+  deploy:
+    contract: ZeroReset.bin
+- name: deployB
+  deploy:
+    contract: ZeroReset.bin
+- name: setA
+  call:
+    destination: $deployA
+    function: setUint
+    data: [42]
+- name: setB
+  call:
+    destination: $deployB
+    function: setUint
+    data: [7]
+- name: getA
+  query-contract:
+    destination: $deployA
+    function: getUint
+- name: assertA
+  assert:
+    key: $getA
+    relation: eq
+    val: 42
+`
+
+const balancePlaybook = `jobs:
+- name: deploy
+  deploy:
+    contract: ZeroReset.bin
+- name: balanceBefore
+  query-account:
+    account: $deploy
+    field: balance
+- name: send
+  send:
+    destination: $deploy
+    amount: 5
+- name: assertBalance
+  assert-balance:
+    account: $deploy
+    before: $balanceBefore
+    delta: 5
+- name: assertNoRevert
+  assert-revert:
+    destination: $deploy
+    function: setUint
+    data: [1]
+`
+
+const controlPlaybook = `jobs:
+- name: deploy
+  deploy:
+    contract: ZeroReset.bin
+- name: setEach
+  foreach:
+    items: [3, 5, 8]
+    as: value
+    jobs:
+    - name: set
+      call:
+        destination: $deploy
+        function: setUint
+        data: [$value]
+    - name: get
+      query-contract:
+        destination: $deploy
+        function: getUint
+- name: check
+  if:
+    key: $setEach.get_2
+    relation: eq
+    val: 8
+    jobs:
+    - name: reset
+      call:
+        destination: $deploy
+        function: setUint
+        data: [1]
+    else:
+    - name: fail
+      assert:
+        key: $setEach.get_2
+        relation: eq
+        val: 8
+- name: getReset
+  query-contract:
+    destination: $deploy
+    function: getUint
+- name: assertReset
+  assert:
+    key: $getReset
+    relation: eq
+    val: 1
+`
+
+const proxyPlaybook = `jobs:
+- name: deploy
+  deploy:
+    contract: ZeroReset.bin
+    proxy: true
+- name: set
+  call:
+    destination: $deploy
+    function: setUint
+    data: [42]
+- name: upgrade
+  upgrade:
+    proxy: $deploy
+    contract: ZeroResetV2.bin
+- name: get
+  query-contract:
+    destination: $deploy
+    function: getUint
+- name: assertKept
+  assert:
+    key: $get
+    relation: eq
+    val: 42
+- name: assertUpgraded
+  assert:
+    key: $upgrade
+    relation: ne
+    val: $deploy.implementation
+`
+
+const badUpgradePlaybook = `jobs:
+- name: upgrade
+  upgrade:
+    proxy: %v
+    contract: ZeroResetSwapped.bin
+`
+
+const zeroResetLayout = `{"storage": [
+  {"label": "storedInt", "offset": 0, "slot": "0", "type": "t_int256"},
+  {"label": "storedUint", "offset": 0, "slot": "1", "type": "t_uint256"}%s],
+ "types": {
+  "t_int256": {"encoding": "inplace", "label": "int256", "numberOfBytes": "32"},
+  "t_uint256": {"encoding": "inplace", "label": "uint256", "numberOfBytes": "32"}}}`
+
+func TestPlaybook(t *testing.T) {
+	kern, shutdown := integration.RunNode(t, rpctest.GenesisDoc, rpctest.PrivateAccounts)
+	defer shutdown()
+
+	logger := logging.NewNoopLogger()
+	qcli := rpctest.NewQueryClient(t, kern.GRPCListenAddress().String())
+	input := rpctest.PrivateAccounts[0].GetAddress()
+
+	dir, err := ioutil.TempDir("", "deploy-playbook")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "bin"), 0775))
+	contract := new(compile.SolidityContract)
+	contract.Abi = solidity.Abi_ZeroReset
+	contract.Evm.Bytecode.Object = hex.EncodeUpperToString(solidity.Bytecode_ZeroReset)
+	require.NoError(t, contract.Save(filepath.Join(dir, "bin"), "ZeroReset.bin"))
+	file := filepath.Join(dir, "deploy.yaml")
+	require.NoError(t, ioutil.WriteFile(file, []byte(playbook), 0644))
+
+	args := &def.DeployArgs{
+		Address:       input.String(),
+		BinPath:       "[dir]/bin",
+		DefaultAmount: "0",
+		DefaultFee:    "0",
+		DefaultGas:    "1000000",
+		DefaultOutput: def.DefaultOutputFile,
+		ParallelJobs:  4,
+	}
+	run := func(file string) (*def.Playbook, error) {
+		script, err := loader.LoadPlaybook(file, args, logger)
+		require.NoError(t, err)
+		client := def.NewClient(kern.GRPCListenAddress().String(), "", true, 5*time.Second)
+		return script, jobs.ExecutePlaybook(args, script, client, logger)
+	}
+
+	// Independent jobs run concurrently using mempool signing
+	script, err := run(file)
+	require.NoError(t, err)
+	deployA := script.Jobs[1].Result
+	acc, err := qcli.GetAccount(context.Background(), &rpcquery.GetAccountParam{Address: input})
+	require.NoError(t, err)
+	sequence := acc.Sequence
+
+	// Running again skips the jobs already completed
+	script, err = run(file)
+	require.NoError(t, err)
+	assert.Equal(t, deployA, script.Jobs[1].Result)
+	acc, err = qcli.GetAccount(context.Background(), &rpcquery.GetAccountParam{Address: input})
+	require.NoError(t, err)
+	assert.Equal(t, sequence, acc.Sequence)
+	state, err := def.LoadDeployState(filepath.Join(dir, "deploy.state.json"))
+	require.NoError(t, err)
+	assert.Len(t, state.Jobs, 4)
+	assert.Len(t, state.Jobs["deployA"].Addresses, 1)
+
+	// Balance changes are asserted and a call that succeeds fails an assert-revert job
+	file = filepath.Join(dir, "balance.yaml")
+	require.NoError(t, ioutil.WriteFile(file, []byte(balancePlaybook), 0644))
+	script, err = run(file)
+	require.Error(t, err)
+	assert.Equal(t, "passed", script.Jobs[4].Result)
+	assert.Equal(t, "failed", script.Jobs[5].Result)
+
+	// Jobs run for each item and on a condition
+	file = filepath.Join(dir, "control.yaml")
+	require.NoError(t, ioutil.WriteFile(file, []byte(controlPlaybook), 0644))
+	script, err = run(file)
+	require.NoError(t, err)
+	assert.Equal(t, "3", script.Jobs[2].Result)
+	assert.Equal(t, "true", script.Jobs[3].Result)
+	assert.Equal(t, "passed", script.Jobs[5].Result)
+
+	// A proxy keeps its storage across upgrades to implementations with compatible storage layouts
+	for name, layout := range map[string]string{
+		"ZeroReset.bin":        fmt.Sprintf(zeroResetLayout, ""),
+		"ZeroResetV2.bin":      fmt.Sprintf(zeroResetLayout, `, {"label": "paused", "offset": 0, "slot": "2", "type": "t_uint256"}`),
+		"ZeroResetSwapped.bin": strings.Replace(fmt.Sprintf(zeroResetLayout, ""), `"t_int256"}`, `"t_uint256"}`, 1),
+	} {
+		contract.StorageLayout = new(compile.StorageLayout)
+		require.NoError(t, json.Unmarshal([]byte(layout), contract.StorageLayout))
+		require.NoError(t, contract.Save(filepath.Join(dir, "bin"), name))
+	}
+	file = filepath.Join(dir, "proxy.yaml")
+	require.NoError(t, ioutil.WriteFile(file, []byte(proxyPlaybook), 0644))
+	script, err = run(file)
+	require.NoError(t, err)
+	assert.Equal(t, "passed", script.Jobs[5].Result)
+	assert.Equal(t, "passed", script.Jobs[6].Result)
+	proxyAddress := script.Jobs[1].Result
+	state, err = def.LoadDeployState(filepath.Join(dir, "proxy.state.json"))
+	require.NoError(t, err)
+	require.Len(t, state.Jobs["deploy"].Addresses, 2)
+	assert.Equal(t, proxyAddress, state.Jobs["deploy"].Addresses[1].String())
+	assert.Equal(t, state.Jobs["deploy"].Addresses[0].String(), state.Jobs["deploy"].Variables[0].Value)
+
+	file = filepath.Join(dir, "bad_upgrade.yaml")
+	require.NoError(t, ioutil.WriteFile(file, []byte(fmt.Sprintf(badUpgradePlaybook, proxyAddress)), 0644))
+	_, err = run(file)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "storedInt has changed type from int256 to uint256")
+}
@@ -0,0 +1,295 @@
+package keys
+
+import (
+	"bufio"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/integration"
+	"github.com/hyperledger/burrow/keys"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestKeysServerPolicies(t *testing.T) {
+	testDir, cleanup := integration.EnterTestDirectory()
+	defer cleanup()
+
+	ks := keys.NewKeyStore(testDir, false)
+	signer, err := ks.GenerateKey(context.Background(), &keys.GenRequest{CurveType: "ed25519", KeyName: "signer"})
+	require.NoError(t, err)
+	other, err := ks.GenerateKey(context.Background(), &keys.GenRequest{CurveType: "ed25519", KeyName: "other"})
+	require.NoError(t, err)
+	for _, seed := range []string{"shared", "custody"} {
+		_, err = ks.GenerateSeed(context.Background(), &keys.GenSeedRequest{Name: seed})
+		require.NoError(t, err)
+	}
+
+	ca := newTestCA(t, testDir)
+	serverCert, serverKey := ca.issue(t, "localhost")
+	aliceCert, aliceKey := ca.issue(t, "alice")
+	bobCert, bobKey := ca.issue(t, "bob")
+	auditLog := filepath.Join(testDir, "audit.log")
+
+	server, err := keys.NewServer(&keys.KeysConfig{
+		KeysDirectory: testDir,
+		Server: &keys.ServerConfig{
+			TLSCertFile:  serverCert,
+			TLSKeyFile:   serverKey,
+			ClientCAFile: ca.certFile,
+			AuditLog:     auditLog,
+			Policies: []*keys.Policy{
+				{Client: "alice", Methods: []string{"Sign", "PublicKey"}, Keys: []string{"signer"}, SignRate: 0.001,
+					SignBurst: 2},
+				{Client: "bob", Methods: []string{"GenerateKey", "List"}, Seeds: []string{"shared"}},
+			},
+		},
+	}, logging.NewNoopLogger())
+	require.NoError(t, err)
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	go server.Serve(listener)
+	defer server.Stop()
+	address := listener.Addr().String()
+
+	dial := func(tlsConf *keys.ClientTLSConfig) keys.KeysClient {
+		opt, err := tlsConf.DialOption()
+		require.NoError(t, err)
+		conn, err := grpc.Dial(address, opt)
+		require.NoError(t, err)
+		return keys.NewKeysClient(conn)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	message := []byte("hello")
+
+	// No client certificate
+	_, err = dial(&keys.ClientTLSConfig{CAFile: ca.certFile}).List(ctx, &keys.ListRequest{})
+	require.Error(t, err)
+	_, err = dial(nil).List(ctx, &keys.ListRequest{})
+	require.Error(t, err)
+
+	alice := dial(&keys.ClientTLSConfig{CAFile: ca.certFile, CertFile: aliceCert, KeyFile: aliceKey})
+	_, err = alice.Sign(ctx, &keys.SignRequest{Name: "signer", Message: message})
+	require.NoError(t, err)
+	_, err = alice.Sign(ctx, &keys.SignRequest{Address: signer.Address, Message: message})
+	require.NoError(t, err)
+	_, err = alice.Sign(ctx, &keys.SignRequest{Name: "signer", Message: message})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	_, err = alice.Sign(ctx, &keys.SignRequest{Address: other.Address, Message: message})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = alice.PublicKey(ctx, &keys.PubRequest{Name: "signer"})
+	require.NoError(t, err)
+	_, err = alice.Export(ctx, &keys.ExportRequest{Name: "signer"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	bob := dial(&keys.ClientTLSConfig{CAFile: ca.certFile, CertFile: bobCert, KeyFile: bobKey})
+	_, err = bob.Sign(ctx, &keys.SignRequest{Name: "signer", Message: message})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = bob.GenerateKey(ctx, &keys.GenRequest{CurveType: "secp256k1", Seed: "custody", Path: "m/0"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = bob.GenerateKey(ctx, &keys.GenRequest{CurveType: "secp256k1", Seed: "shared", Path: "m/0"})
+	require.NoError(t, err)
+	genResp, err := bob.GenerateKey(ctx, &keys.GenRequest{CurveType: "secp256k1"})
+	require.NoError(t, err)
+
+	// Key clients authenticate in the same way
+	kc, err := keys.NewRemoteKeyClientWithTLS(address,
+		&keys.ClientTLSConfig{CAFile: ca.certFile, CertFile: aliceCert, KeyFile: aliceKey}, logging.NewNoopLogger())
+	require.NoError(t, err)
+	_, err = kc.GetAddressForKeyName("signer")
+	assert.Error(t, err, "alice may not list keys")
+
+	var entries []map[string]interface{}
+	f, err := os.Open(auditLog)
+	require.NoError(t, err)
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		entry := make(map[string]interface{})
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+		entries = append(entries, entry)
+	}
+	var signs, denied int
+	var seeds []interface{}
+	for _, entry := range entries {
+		assert.NotEmpty(t, entry["time"])
+		if entry["method"] == "Sign" {
+			signs++
+			assert.Equal(t, "2CF24DBA5FB0A30E26E83B2AC5B9E29E1B161E5C1FA7425E73043362938B9824",
+				entry["message_sha256"])
+		}
+		if entry["message"] == "keys request denied" {
+			denied++
+		}
+		if entry["seed"] != nil {
+			seeds = append(seeds, entry["message"], entry["seed"])
+		}
+	}
+	// Clients without certificates do not get past the TLS handshake
+	assert.Equal(t, 5, signs)
+	assert.Equal(t, 6, denied)
+	assert.Equal(t, []interface{}{"keys request denied", "custody", "keys request", "shared"}, seeds)
+	last := entries[len(entries)-2]
+	assert.Equal(t, "bob", last["client"])
+	assert.Equal(t, "GenerateKey", last["method"])
+	assert.Equal(t, genResp.Address, last["address"])
+}
+
+func TestKeysServerPolicyNames(t *testing.T) {
+	testDir, cleanup := integration.EnterTestDirectory()
+	defer cleanup()
+
+	ks := keys.NewKeyStore(testDir, false)
+	signer, err := ks.GenerateKey(context.Background(), &keys.GenRequest{CurveType: "ed25519", KeyName: "signer"})
+	require.NoError(t, err)
+	other, err := ks.GenerateKey(context.Background(), &keys.GenRequest{CurveType: "ed25519", KeyName: "other"})
+	require.NoError(t, err)
+
+	ca := newTestCA(t, testDir)
+	serverCert, serverKey := ca.issue(t, "localhost")
+	aliceCert, aliceKey := ca.issue(t, "alice")
+	server, err := keys.NewServer(&keys.KeysConfig{
+		KeysDirectory: testDir,
+		Server: &keys.ServerConfig{
+			TLSCertFile:  serverCert,
+			TLSKeyFile:   serverKey,
+			ClientCAFile: ca.certFile,
+			Policies: []*keys.Policy{
+				{Client: "alice", Methods: []string{"Sign", "AddName", "RemoveName", "Import"},
+					Keys: []string{"signer"}},
+			},
+		},
+	}, logging.NewNoopLogger())
+	require.NoError(t, err)
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	opt, err := (&keys.ClientTLSConfig{CAFile: ca.certFile, CertFile: aliceCert, KeyFile: aliceKey}).DialOption()
+	require.NoError(t, err)
+	conn, err := grpc.Dial(listener.Addr().String(), opt)
+	require.NoError(t, err)
+	alice := keys.NewKeysClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	message := []byte("hello")
+
+	// Alice may not point the name she may sign with at another key
+	_, err = alice.AddName(ctx, &keys.AddNameRequest{Keyname: "signer", Address: other.Address})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = alice.RemoveName(ctx, &keys.RemoveNameRequest{KeyName: "other"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = alice.Import(ctx, &keys.ImportRequest{Name: "other", CurveType: "ed25519", KeyBytes: make([]byte, 64)})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = alice.AddName(ctx, &keys.AddNameRequest{Keyname: "alias", Address: signer.Address})
+	require.NoError(t, err)
+	_, err = alice.Sign(ctx, &keys.SignRequest{Name: "alias", Message: message})
+	require.NoError(t, err)
+
+	// Nor does moving the name by other means let her sign with the key it now refers to
+	_, err = ks.AddName(ctx, &keys.AddNameRequest{Keyname: "signer", Address: other.Address})
+	require.NoError(t, err)
+	_, err = alice.Sign(ctx, &keys.SignRequest{Name: "signer", Message: message})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = alice.Sign(ctx, &keys.SignRequest{Address: signer.Address, Message: message})
+	require.NoError(t, err)
+}
+
+func TestKeysServerPolicyValidation(t *testing.T) {
+	testDir, cleanup := integration.EnterTestDirectory()
+	defer cleanup()
+	for _, policy := range []*keys.Policy{
+		{Methods: []string{"Sign"}},
+		{Client: "alice", Methods: []string{"Sign"}},
+		{Client: "*", Methods: []string{"Steal"}},
+		{Client: "*", Methods: []string{"Sign"}, Keys: []string{"nobody"}},
+	} {
+		_, err := keys.NewServer(&keys.KeysConfig{
+			KeysDirectory: testDir,
+			Server:        &keys.ServerConfig{Policies: []*keys.Policy{policy}},
+		}, logging.NewNoopLogger())
+		assert.Error(t, err)
+	}
+	_, err := keys.NewServer(&keys.KeysConfig{
+		KeysDirectory: testDir,
+		Server:        &keys.ServerConfig{Policies: []*keys.Policy{{Client: "*", Methods: []string{"*"}}}},
+	}, logging.NewNoopLogger())
+	assert.NoError(t, err)
+}
+
+type testCA struct {
+	dir      string
+	certFile string
+	cert     *x509.Certificate
+	key      *ecdsa.PrivateKey
+	serial   int64
+}
+
+func newTestCA(t *testing.T, dir string) *testCA {
+	ca := &testCA{dir: dir}
+	ca.cert, ca.key, ca.certFile, _ = ca.write(t, "ca", nil)
+	return ca
+}
+
+// Issues a certificate for commonName, returning the certificate and key files
+func (ca *testCA) issue(t *testing.T, commonName string) (string, string) {
+	_, _, certFile, keyFile := ca.write(t, commonName, ca)
+	return certFile, keyFile
+}
+
+func (ca *testCA) write(t *testing.T, commonName string, issuer *testCA) (*x509.Certificate, *ecdsa.PrivateKey,
+	string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	ca.serial++
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(ca.serial),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		DNSNames:     []string{commonName},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	parent, signer := template, key
+	if issuer == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+	} else {
+		parent, signer = issuer.cert, issuer.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, signer)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile := filepath.Join(ca.dir, commonName+".crt")
+	keyFile := filepath.Join(ca.dir, commonName+".key")
+	require.NoError(t, ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		0600))
+	require.NoError(t, ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY",
+		Bytes: keyDER}), 0600))
+	return cert, key, certFile, keyFile
+}
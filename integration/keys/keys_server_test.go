@@ -3,6 +3,7 @@ package keys
 import (
 	"context"
 	"net"
+	"strings"
 	"testing"
 	"time"
 
@@ -10,8 +11,10 @@ import (
 	"github.com/hyperledger/burrow/crypto/sha3"
 	"github.com/hyperledger/burrow/integration"
 	"github.com/hyperledger/burrow/keys"
+	"github.com/hyperledger/burrow/keys/hd"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	hex "github.com/tmthrgd/go-hex"
 	"google.golang.org/grpc"
 )
 
@@ -104,6 +107,87 @@ func TestKeysServer(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("DeriveFromSeed", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+		seedResp, err := cli.GenerateSeed(ctx, &keys.GenSeedRequest{Name: "recovered", Mnemonic: mnemonic})
+		require.NoError(t, err)
+		assert.Empty(t, seedResp.Mnemonic, "a recovered mnemonic should not be returned")
+		_, err = cli.GenerateSeed(ctx, &keys.GenSeedRequest{Name: "recovered"})
+		require.Error(t, err, "seeds should not be overwritten")
+
+		seed, err := hd.SeedFromMnemonic(mnemonic, "")
+		require.NoError(t, err)
+		for typ, path := range map[crypto.CurveType]string{
+			crypto.CurveTypeSecp256k1: "m/44'/60'/0'/0/0",
+			crypto.CurveTypeEd25519:   "m/44'/60'/0'/0'/0'",
+		} {
+			genResp, err := cli.GenerateKey(ctx, &keys.GenRequest{CurveType: typ.String(), KeyName: "derived-" + typ.String(),
+				Seed: "recovered", Path: path})
+			require.NoError(t, err)
+			p, err := hd.ParsePath(path)
+			require.NoError(t, err)
+			privateKey, err := hd.DeriveKey(seed, typ, p)
+			require.NoError(t, err)
+			assert.Equal(t, privateKey.GetPublicKey().GetAddress().String(), genResp.Address)
+
+			again, err := cli.GenerateKey(ctx, &keys.GenRequest{CurveType: typ.String(), Seed: "recovered", Path: path})
+			require.NoError(t, err)
+			assert.Equal(t, genResp.Address, again.Address)
+		}
+
+		_, err = cli.GenerateKey(ctx, &keys.GenRequest{CurveType: "ed25519", Seed: "recovered", Path: "m/0"})
+		require.Error(t, err, "ed25519 keys cannot be derived along non-hardened indices")
+		_, err = cli.GenerateKey(ctx, &keys.GenRequest{CurveType: "ed25519", Seed: "missing", Path: "m/0'"})
+		require.Error(t, err)
+
+		listResp, err := cli.List(ctx, &keys.ListRequest{Seed: "recovered"})
+		require.NoError(t, err)
+		require.Len(t, listResp.Key, 2)
+		paths := []string{listResp.Key[0].Path, listResp.Key[1].Path}
+		assert.ElementsMatch(t, []string{"m/44'/60'/0'/0/0", "m/44'/60'/0'/0'/0'"}, paths)
+		listResp, err = cli.List(ctx, &keys.ListRequest{KeyName: "derived-secp256k1"})
+		require.NoError(t, err)
+		require.Len(t, listResp.Key, 1)
+		assert.Equal(t, "recovered", listResp.Key[0].Seed)
+
+		seedResp, err = cli.GenerateSeed(ctx, &keys.GenSeedRequest{Name: "new", Passphrase: "secret"})
+		require.NoError(t, err)
+		assert.Len(t, strings.Fields(seedResp.Mnemonic), 24)
+		_, err = cli.GenerateKey(ctx, &keys.GenRequest{CurveType: "secp256k1", Seed: "new", Path: "m/0",
+			Passphrase: "wrong"})
+		require.Error(t, err)
+		genResp, err := cli.GenerateKey(ctx, &keys.GenRequest{CurveType: "secp256k1", Seed: "new", Path: "m/0",
+			Passphrase: "secret"})
+		require.NoError(t, err)
+		_, err = cli.Sign(ctx, &keys.SignRequest{Address: genResp.Address, Passphrase: "secret", Message: []byte("hi")})
+		require.NoError(t, err)
+	})
+
+	t.Run("ImportEthereumKey", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		// From the Web3 Secret Storage definition
+		keyJSON := `{"crypto":{"cipher":"aes-128-ctr","cipherparams":{"iv":"6087dab2f9fdbbfaddc31a909735c1e6"},` +
+			`"ciphertext":"5318b4d5bcd28de64ee5559e671353e16f075ecae9f99c7a79a38af5f869aa46","kdf":"pbkdf2",` +
+			`"kdfparams":{"c":262144,"dklen":32,"prf":"hmac-sha256",` +
+			`"salt":"ae3cd4e7013836a3df6bd7241b12db061dbe2c6785853cce422d148a624ce0bd"},` +
+			`"mac":"517ead924a9d0dc3124507e3393d175ce3ff7c1e96529c6c555ce9e51205e9b2"},` +
+			`"id":"3198bc9c-6672-5ab3-d995-4942343ae5b6","version":3}`
+		_, err := cli.ImportJSON(ctx, &keys.ImportJSONRequest{JSON: keyJSON, Passphrase: "wrong"})
+		require.Error(t, err)
+		importResp, err := cli.ImportJSON(ctx, &keys.ImportJSONRequest{JSON: keyJSON, Passphrase: "testpassword"})
+		require.NoError(t, err)
+
+		exportResp, err := cli.Export(ctx, &keys.ExportRequest{Address: importResp.Address, Passphrase: "testpassword"})
+		require.NoError(t, err)
+		assert.Equal(t, "secp256k1", exportResp.CurveType)
+		assert.Equal(t, "7A28B5BA57C53603B0B07B56BBA752F7784BF506FA95EDC395F5CF6C7514FE9D",
+			hex.EncodeUpperToString(exportResp.Privatekey))
+	})
+
 	select {
 	case err := <-failedCh:
 		require.NoError(t, err)
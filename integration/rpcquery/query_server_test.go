@@ -71,6 +71,16 @@ func TestQueryServer(t *testing.T) {
 		assert.Equal(t, genAcc, genAccOut)
 	})
 
+	t.Run("GetPermissions", func(t *testing.T) {
+		cli := rpctest.NewQueryClient(t, kern.GRPCListenAddress().String())
+		perms, err := cli.GetPermissions(context.Background(), &rpcquery.GetPermissionsParam{
+			Address: rpctest.PrivateAccounts[2].GetAddress(),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, rpctest.GenesisDoc.Accounts[2].Permissions.Base, perms.Base)
+		assert.Empty(t, perms.Grants)
+	})
+
 	t.Run("ListAccounts", func(t *testing.T) {
 		cli := rpctest.NewQueryClient(t, kern.GRPCListenAddress().String())
 		stream, err := cli.ListAccounts(context.Background(), &rpcquery.ListAccountsParam{})
@@ -1,10 +1,68 @@
 package keys
 
+// PKCS11PINEnv is the environment variable the PIN of a PKCS#11 token is read from if not configured, so that it need
+// not be written to disk
+const PKCS11PINEnv = "BURROW_PKCS11_PIN"
+
 type KeysConfig struct {
 	GRPCServiceEnabled      bool
 	AllowBadFilePermissions bool
 	RemoteAddress           string
 	KeysDirectory           string
+	// Connect to the keys server at RemoteAddress over TLS
+	RemoteTLS *ClientTLSConfig `json:",omitempty" toml:",omitempty"`
+	// Sign votes and proposals through the keys service, which records the last it signed with the validator key to
+	// protect against double signing across restarts, rather than keeping that record in the node's memory
+	SignConsensus bool `json:",omitempty" toml:",omitempty"`
+	// Use keys held in a PKCS#11 token, such as a hardware security module, instead of those in KeysDirectory
+	PKCS11 *PKCS11Config `json:",omitempty" toml:",omitempty"`
+	// TLS, client policies and audit log of the standalone keys server
+	Server *ServerConfig `json:",omitempty" toml:",omitempty"`
+}
+
+type PKCS11Config struct {
+	// Path to the PKCS#11 module (shared library) of the token
+	Module string
+	// Label of the token holding the keys
+	TokenLabel string
+	// PIN of the token's user, read from BURROW_PKCS11_PIN if empty
+	PIN string `json:",omitempty" toml:",omitempty"`
+}
+
+type ServerConfig struct {
+	// Serve TLS with this certificate and private key (PEM files)
+	TLSCertFile string `json:",omitempty" toml:",omitempty"`
+	TLSKeyFile  string `json:",omitempty" toml:",omitempty"`
+	// Require clients to present a certificate signed by one of the CAs in this PEM file (mutual TLS)
+	ClientCAFile string `json:",omitempty" toml:",omitempty"`
+	// Once any policies are given clients may only make the requests a policy allows
+	Policies []*Policy `json:",omitempty" toml:",omitempty"`
+	// File to log each request that uses or changes a key to as JSON lines
+	AuditLog string `json:",omitempty" toml:",omitempty"`
+}
+
+type ClientTLSConfig struct {
+	// CA certificates (PEM) that sign the keys server's certificate, the system's CAs if empty
+	CAFile string `json:",omitempty" toml:",omitempty"`
+	// Certificate and private key (PEM) to present to a keys server that requires client certificates
+	CertFile string `json:",omitempty" toml:",omitempty"`
+	KeyFile  string `json:",omitempty" toml:",omitempty"`
+}
+
+// Policy allows a client to call some methods of the keys server with some keys
+type Policy struct {
+	// Common name of the client's certificate, or * for any client
+	Client string
+	// Methods of the keys service the client may call, such as Sign or PublicKey, or * for all
+	Methods []string
+	// Names or addresses of the keys the client may use or name, or * for all - names are resolved when the server starts
+	Keys []string
+	// Names of the seeds the client may derive keys from, or * for all
+	Seeds []string `json:",omitempty" toml:",omitempty"`
+	// Signatures per second the client may make with each key, or 0 for no limit
+	SignRate float64 `json:",omitempty" toml:",omitempty"`
+	// Signatures the client may make at once before SignRate applies, defaults to 1
+	SignBurst int `json:",omitempty" toml:",omitempty"`
 }
 
 func DefaultKeysConfig() *KeysConfig {
@@ -0,0 +1,262 @@
+package keys
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	amino "github.com/tendermint/go-amino"
+	"github.com/tendermint/tendermint/types"
+)
+
+// Steps of a Tendermint round in the order a validator signs them
+const (
+	stepPropose   int8 = 1
+	stepPrevote   int8 = 2
+	stepPrecommit int8 = 3
+)
+
+var consensusCodec = amino.NewCodec()
+
+// The last vote or proposal a key signed on a chain, persisted so that the key is never used to sign conflicting
+// messages at the same height, round and step, even across restarts
+type lastSigned struct {
+	Height    int64
+	Round     int64
+	Step      int8
+	Signature *crypto.Signature
+	SignBytes binary.HexBytes
+}
+
+// A vote or proposal decoded from its canonical sign bytes
+type consensusMessage struct {
+	chainID   string
+	height    int64
+	round     int64
+	step      int8
+	timestamp time.Time
+}
+
+// SignConsensus signs the canonical sign bytes of a Tendermint vote or proposal, refusing to sign a message at a lower
+// height, round, or step than the key last signed on the same chain. If it is asked to sign a message at the height,
+// round, and step it last signed it returns the previous signature when the messages are the same or differ only by
+// timestamp (along with the previous sign bytes, from which the signer should take the timestamp), and an error
+// otherwise.
+func (k *KeyStore) SignConsensus(ctx context.Context, in *SignConsensusRequest) (*SignConsensusResponse, error) {
+	addr, err := getNameAddr(k.keysDirPath, in.GetName(), in.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+	addrB, err := crypto.AddressFromHexString(addr)
+	if err != nil {
+		return nil, err
+	}
+	msg, err := decodeConsensusMessage(in.GetSignBytes())
+	if err != nil {
+		return nil, err
+	}
+	key, err := k.GetKey(in.GetPassphrase(), addrB[:])
+	if err != nil {
+		return nil, err
+	}
+
+	k.consensusLock.Lock()
+	defer k.consensusLock.Unlock()
+	signed, err := k.loadLastSigned(addrB)
+	if err != nil {
+		return nil, err
+	}
+	last := signed[msg.chainID]
+	if last != nil {
+		sameHRS, err := last.checkHRS(msg)
+		if err != nil {
+			return nil, fmt.Errorf("key %v will not sign consensus message: %v", addrB, err)
+		}
+		if sameHRS {
+			// We may be asked to sign again if the validator crashed before writing its last signature to its WAL
+			signBytes := in.GetSignBytes()
+			if bytes.Equal(signBytes, last.SignBytes) || onlyDifferByTimestamp(last.SignBytes, signBytes) {
+				return &SignConsensusResponse{Signature: last.Signature, SignBytes: last.SignBytes}, nil
+			}
+			return nil, fmt.Errorf("key %v has already signed conflicting data at height %d, round %d, step %d",
+				addrB, msg.height, msg.round, msg.step)
+		}
+	}
+
+	sig, err := key.PrivateKey.Sign(in.GetSignBytes())
+	if err != nil {
+		return nil, err
+	}
+	signed[msg.chainID] = &lastSigned{
+		Height:    msg.height,
+		Round:     msg.round,
+		Step:      msg.step,
+		Signature: sig,
+		SignBytes: in.GetSignBytes(),
+	}
+	// Only return the signature once we are sure never to sign a conflicting message
+	err = k.storeLastSigned(addrB, signed)
+	if err != nil {
+		return nil, err
+	}
+	return &SignConsensusResponse{Signature: sig, SignBytes: in.GetSignBytes()}, nil
+}
+
+// Returns an error on a height, round, or step regression and true if the message is at the last signed height, round
+// and step
+func (ls *lastSigned) checkHRS(msg *consensusMessage) (bool, error) {
+	switch {
+	case ls.Height > msg.height:
+		return false, fmt.Errorf("height regression from %d to %d", ls.Height, msg.height)
+	case ls.Height < msg.height:
+		return false, nil
+	case ls.Round > msg.round:
+		return false, fmt.Errorf("round regression from %d to %d at height %d", ls.Round, msg.round, msg.height)
+	case ls.Round < msg.round:
+		return false, nil
+	case ls.Step > msg.step:
+		return false, fmt.Errorf("step regression from %d to %d at height %d, round %d", ls.Step, msg.step,
+			msg.height, msg.round)
+	case ls.Step < msg.step:
+		return false, nil
+	}
+	return true, nil
+}
+
+func decodeConsensusMessage(signBytes []byte) (*consensusMessage, error) {
+	proposal := new(types.CanonicalProposal)
+	err := consensusCodec.UnmarshalBinaryLengthPrefixed(signBytes, proposal)
+	if err == nil && proposal.Type == types.ProposalType {
+		return &consensusMessage{
+			chainID:   proposal.ChainID,
+			height:    proposal.Height,
+			round:     proposal.Round,
+			step:      stepPropose,
+			timestamp: proposal.Timestamp,
+		}, nil
+	}
+	vote := new(types.CanonicalVote)
+	err = consensusCodec.UnmarshalBinaryLengthPrefixed(signBytes, vote)
+	if err != nil {
+		return nil, fmt.Errorf("sign bytes are neither a Tendermint vote nor a proposal: %v", err)
+	}
+	msg := &consensusMessage{
+		chainID:   vote.ChainID,
+		height:    vote.Height,
+		round:     vote.Round,
+		timestamp: vote.Timestamp,
+	}
+	switch vote.Type {
+	case types.PrevoteType:
+		msg.step = stepPrevote
+	case types.PrecommitType:
+		msg.step = stepPrecommit
+	default:
+		return nil, fmt.Errorf("sign bytes are a vote of unknown type %v", vote.Type)
+	}
+	return msg, nil
+}
+
+// ConsensusTimestamp returns the timestamp of the vote or proposal with signBytes
+func ConsensusTimestamp(signBytes []byte) (time.Time, error) {
+	msg, err := decodeConsensusMessage(signBytes)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return msg.timestamp, nil
+}
+
+func onlyDifferByTimestamp(lastSignBytes, signBytes []byte) bool {
+	last, err := decodeConsensusMessage(lastSignBytes)
+	if err != nil {
+		return false
+	}
+	msg, err := decodeConsensusMessage(signBytes)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(withTimestamp(lastSignBytes, last.step, msg.timestamp), signBytes)
+}
+
+// Returns signBytes re-encoded with timestamp
+func withTimestamp(signBytes []byte, step int8, timestamp time.Time) []byte {
+	var canonical interface{}
+	if step == stepPropose {
+		proposal := new(types.CanonicalProposal)
+		consensusCodec.MustUnmarshalBinaryLengthPrefixed(signBytes, proposal)
+		proposal.Timestamp = timestamp
+		canonical = proposal
+	} else {
+		vote := new(types.CanonicalVote)
+		consensusCodec.MustUnmarshalBinaryLengthPrefixed(signBytes, vote)
+		vote.Timestamp = timestamp
+		canonical = vote
+	}
+	return consensusCodec.MustMarshalBinaryLengthPrefixed(canonical)
+}
+
+// The last messages signed by address, by chain ID
+func (k *KeyStore) loadLastSigned(address crypto.Address) (map[string]*lastSigned, error) {
+	signed := make(map[string]*lastSigned)
+	file, err := k.lastSignedFile(address)
+	if err != nil {
+		return nil, err
+	}
+	bs, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		return signed, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(bs, &signed)
+	if err != nil {
+		return nil, fmt.Errorf("could not read last consensus message signed by %v from %s: %v", address, file,
+			err)
+	}
+	return signed, nil
+}
+
+// Writes the last messages signed by address so that a crash leaves either the old or the new file in place
+func (k *KeyStore) storeLastSigned(address crypto.Address, signed map[string]*lastSigned) error {
+	file, err := k.lastSignedFile(address)
+	if err != nil {
+		return err
+	}
+	bs, err := json.Marshal(signed)
+	if err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(file), filepath.Base(file))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	_, err = tmp.Write(bs)
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("could not write last consensus message signed by %v: %v", address, err)
+	}
+	return os.Rename(tmp.Name(), file)
+}
+
+func (k *KeyStore) lastSignedFile(address crypto.Address) (string, error) {
+	dir, err := returnConsensusDir(k.keysDirPath)
+	if err != nil {
+		return "", err
+	}
+	return path.Join(dir, address.String()+".json"), nil
+}
@@ -0,0 +1,94 @@
+package keys
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/types"
+)
+
+func TestSignConsensus(t *testing.T) {
+	dir, err := ioutil.TempDir("", "burrow-keys")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ks := NewKeyStore(dir, false)
+	gen, err := ks.GenerateKey(context.Background(), &GenRequest{CurveType: "ed25519", KeyName: "validator"})
+	require.NoError(t, err)
+	address, err := crypto.AddressFromHexString(gen.Address)
+	require.NoError(t, err)
+	publicKey, err := NewLocalKeyClient(ks, logging.NewNoopLogger()).PublicKey(address)
+	require.NoError(t, err)
+
+	now := time.Now().UTC()
+	vote := &types.Vote{
+		Type:      types.PrevoteType,
+		Height:    5,
+		Round:     1,
+		Timestamp: now,
+		BlockID:   types.BlockID{Hash: []byte("block")},
+	}
+	sign := func(ks *KeyStore, signBytes []byte) (*SignConsensusResponse, error) {
+		return ks.SignConsensus(context.Background(), &SignConsensusRequest{Name: "validator", SignBytes: signBytes})
+	}
+
+	resp, err := sign(ks, vote.SignBytes("chain"))
+	require.NoError(t, err)
+	assert.Equal(t, vote.SignBytes("chain"), resp.SignBytes)
+	require.NoError(t, publicKey.Verify(vote.SignBytes("chain"), resp.Signature))
+
+	// Signing again at a later time gets the first signature and timestamp
+	vote.Timestamp = now.Add(time.Second)
+	again, err := sign(ks, vote.SignBytes("chain"))
+	require.NoError(t, err)
+	assert.Equal(t, resp, again)
+	timestamp, err := ConsensusTimestamp(again.SignBytes)
+	require.NoError(t, err)
+	assert.True(t, now.Equal(timestamp))
+
+	// A different block at the same height, round and step
+	vote.BlockID = types.BlockID{Hash: []byte("other block")}
+	_, err = sign(ks, vote.SignBytes("chain"))
+	assert.Error(t, err)
+
+	// Other chains are tracked separately
+	_, err = sign(ks, vote.SignBytes("other chain"))
+	assert.NoError(t, err)
+
+	// The last signed step survives restarts
+	ks = NewKeyStore(dir, false)
+	_, err = sign(ks, vote.SignBytes("chain"))
+	assert.Error(t, err)
+
+	proposal := &types.Proposal{
+		Type:      types.ProposalType,
+		Height:    5,
+		Round:     1,
+		POLRound:  -1,
+		Timestamp: now,
+	}
+	_, err = sign(ks, proposal.SignBytes("chain"))
+	assert.Error(t, err, "proposing is a step regression from prevoting")
+
+	vote.Type = types.PrecommitType
+	_, err = sign(ks, vote.SignBytes("chain"))
+	assert.NoError(t, err)
+
+	proposal.Round = 2
+	_, err = sign(ks, proposal.SignBytes("chain"))
+	assert.NoError(t, err)
+
+	vote.Height = 4
+	_, err = sign(ks, vote.SignBytes("chain"))
+	assert.Error(t, err)
+
+	_, err = sign(ks, []byte("not a vote"))
+	assert.Error(t, err)
+}
@@ -35,6 +35,24 @@ func returnNamesDir(dir string) (string, error) {
 	return dir, checkMakeDataDir(dir)
 }
 
+func returnSeedsDir(dir string) (string, error) {
+	dir = path.Join(dir, "seeds")
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	return dir, checkMakeDataDir(dir)
+}
+
+func returnConsensusDir(dir string) (string, error) {
+	dir = path.Join(dir, "consensus")
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	return dir, checkMakeDataDir(dir)
+}
+
 //----------------------------------------------------------------
 func writeKey(keyDir string, addr, keyJson []byte) ([]byte, error) {
 	dir, err := returnDataDir(keyDir)
@@ -0,0 +1,250 @@
+package keys
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/crypto/sha3"
+	hex "github.com/tmthrgd/go-hex"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Keys in the Web3 Secret Storage (version 3) format written by geth, MetaMask and other Ethereum tools, see
+// https://github.com/ethereum/wiki/wiki/Web3-Secret-Storage-Definition
+const (
+	ethereumKeyVersion = 3
+	ethereumCipher     = "aes-128-ctr"
+	ethereumKDFScrypt  = "scrypt"
+	ethereumKDFPBKDF2  = "pbkdf2"
+)
+
+// The most work a key file may ask of its KDF. Key files are untrusted input so these stop one from tying up the key
+// server for minutes or exhausting its memory. Scrypt's N is that of geth's standard keys (using 256MiB with r = 8),
+// and the PBKDF2 iteration count is four times geth's.
+const (
+	ethereumMaxDKLen   = 64
+	ethereumMaxScryptN = 1 << 18
+	ethereumMaxScryptR = 8
+	ethereumMaxScryptP = 16
+	ethereumMaxPBKDF2C = 1 << 20
+)
+
+type ethereumKeyJSON struct {
+	Address string             `json:"address"`
+	Crypto  ethereumCryptoJSON `json:"crypto"`
+	ID      string             `json:"id"`
+	Version int                `json:"version"`
+}
+
+type ethereumCryptoJSON struct {
+	Cipher       string                 `json:"cipher"`
+	CipherText   string                 `json:"ciphertext"`
+	CipherParams ethereumCipherParams   `json:"cipherparams"`
+	KDF          string                 `json:"kdf"`
+	KDFParams    map[string]interface{} `json:"kdfparams"`
+	MAC          string                 `json:"mac"`
+}
+
+type ethereumCipherParams struct {
+	IV string `json:"iv"`
+}
+
+// IsEthereumKeyJSON returns true if keyJSON is a version 3 Ethereum key file
+func IsEthereumKeyJSON(keyJSON []byte) bool {
+	key := new(ethereumKeyJSON)
+	err := json.Unmarshal(keyJSON, key)
+	return err == nil && key.Version == ethereumKeyVersion && key.Crypto.Cipher != ""
+}
+
+// DecryptEthereumKey decrypts the secp256k1 key in a version 3 Ethereum key file
+func DecryptEthereumKey(passphrase string, keyJSON []byte) (*Key, error) {
+	ethKey := new(ethereumKeyJSON)
+	err := json.Unmarshal(keyJSON, ethKey)
+	if err != nil {
+		return nil, err
+	}
+	if ethKey.Version != ethereumKeyVersion {
+		return nil, fmt.Errorf("unsupported Ethereum key version %d, only version %d is supported", ethKey.Version,
+			ethereumKeyVersion)
+	}
+	if ethKey.Crypto.Cipher != ethereumCipher {
+		return nil, fmt.Errorf("unsupported Ethereum key cipher %s", ethKey.Crypto.Cipher)
+	}
+	cipherText, err := hex.DecodeString(ethKey.Crypto.CipherText)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode Ethereum key ciphertext: %v", err)
+	}
+	iv, err := hex.DecodeString(ethKey.Crypto.CipherParams.IV)
+	if err != nil || len(iv) != aes.BlockSize {
+		return nil, fmt.Errorf("Ethereum key iv should be %d hex bytes but is %s", aes.BlockSize,
+			ethKey.Crypto.CipherParams.IV)
+	}
+	mac, err := hex.DecodeString(ethKey.Crypto.MAC)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode Ethereum key mac: %v", err)
+	}
+	derivedKey, err := ethereumDerivedKey(passphrase, ethKey.Crypto.KDF, ethKey.Crypto.KDFParams)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(ethereumMAC(derivedKey, cipherText), mac) {
+		return nil, fmt.Errorf("could not decrypt Ethereum key, the passphrase may be wrong")
+	}
+	privateKey, err := aesCTR(derivedKey[:16], iv, cipherText)
+	if err != nil {
+		return nil, err
+	}
+	key, err := NewKeyFromPriv(crypto.CurveTypeSecp256k1, privateKey)
+	if err != nil {
+		return nil, err
+	}
+	if ethKey.Address != "" {
+		address, err := EthereumAddress(key.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		if !strings.EqualFold(strings.TrimPrefix(ethKey.Address, "0x"), hex.EncodeToString(address)) {
+			return nil, fmt.Errorf("Ethereum key has address %s but its private key has address %x",
+				ethKey.Address, address)
+		}
+	}
+	return key, nil
+}
+
+// EncryptEthereumKey writes a secp256k1 key as a version 3 Ethereum key file encrypted with passphrase
+func EncryptEthereumKey(passphrase string, key *Key) ([]byte, error) {
+	if key.CurveType != crypto.CurveTypeSecp256k1 {
+		return nil, fmt.Errorf("only secp256k1 keys can be exported as Ethereum keys, not %v", key.CurveType)
+	}
+	address, err := EthereumAddress(key.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	salt := make([]byte, 32)
+	iv := make([]byte, aes.BlockSize)
+	id := make([]byte, 16)
+	for _, bs := range [][]byte{salt, iv, id} {
+		_, err = rand.Read(bs)
+		if err != nil {
+			return nil, err
+		}
+	}
+	derivedKey, err := scrypt.Key([]byte(passphrase), salt, scryptN, scryptr, scryptp, scryptdkLen)
+	if err != nil {
+		return nil, err
+	}
+	cipherText, err := aesCTR(derivedKey[:16], iv, key.PrivateKey.RawBytes())
+	if err != nil {
+		return nil, err
+	}
+	// Random (version 4) UUID
+	id[6] = id[6]&0x0f | 0x40
+	id[8] = id[8]&0x3f | 0x80
+	return json.Marshal(ethereumKeyJSON{
+		Address: hex.EncodeToString(address),
+		Crypto: ethereumCryptoJSON{
+			Cipher:       ethereumCipher,
+			CipherText:   hex.EncodeToString(cipherText),
+			CipherParams: ethereumCipherParams{IV: hex.EncodeToString(iv)},
+			KDF:          ethereumKDFScrypt,
+			KDFParams: map[string]interface{}{
+				"n":     scryptN,
+				"r":     scryptr,
+				"p":     scryptp,
+				"dklen": scryptdkLen,
+				"salt":  hex.EncodeToString(salt),
+			},
+			MAC: hex.EncodeToString(ethereumMAC(derivedKey, cipherText)),
+		},
+		ID:      fmt.Sprintf("%x-%x-%x-%x-%x", id[:4], id[4:6], id[6:8], id[8:10], id[10:]),
+		Version: ethereumKeyVersion,
+	})
+}
+
+// EthereumAddress returns the address Ethereum uses for a secp256k1 public key, which differs from burrow's
+func EthereumAddress(publicKey crypto.PublicKey) ([]byte, error) {
+	if publicKey.CurveType != crypto.CurveTypeSecp256k1 {
+		return nil, fmt.Errorf("only secp256k1 keys have Ethereum addresses, not %v", publicKey.CurveType)
+	}
+	pub, err := btcec.ParsePubKey(publicKey.PublicKey, btcec.S256())
+	if err != nil {
+		return nil, err
+	}
+	return sha3.Sha3(pub.SerializeUncompressed()[1:])[12:], nil
+}
+
+func ethereumDerivedKey(passphrase, kdf string, params map[string]interface{}) ([]byte, error) {
+	salt, err := hex.DecodeString(stringParam(params, "salt"))
+	if err != nil {
+		return nil, fmt.Errorf("could not decode Ethereum key salt: %v", err)
+	}
+	dkLen, err := boundedIntParam(params, "dklen", 32, ethereumMaxDKLen)
+	if err != nil {
+		return nil, err
+	}
+	switch kdf {
+	case ethereumKDFScrypt:
+		n, err := boundedIntParam(params, "n", 2, ethereumMaxScryptN)
+		if err != nil {
+			return nil, err
+		}
+		r, err := boundedIntParam(params, "r", 1, ethereumMaxScryptR)
+		if err != nil {
+			return nil, err
+		}
+		p, err := boundedIntParam(params, "p", 1, ethereumMaxScryptP)
+		if err != nil {
+			return nil, err
+		}
+		return scrypt.Key([]byte(passphrase), salt, n, r, p, dkLen)
+	case ethereumKDFPBKDF2:
+		if prf := stringParam(params, "prf"); prf != "hmac-sha256" {
+			return nil, fmt.Errorf("unsupported Ethereum key pbkdf2 prf %s", prf)
+		}
+		c, err := boundedIntParam(params, "c", 1, ethereumMaxPBKDF2C)
+		if err != nil {
+			return nil, err
+		}
+		return pbkdf2.Key([]byte(passphrase), salt, c, dkLen, sha256.New), nil
+	default:
+		return nil, fmt.Errorf("unsupported Ethereum key kdf %s", kdf)
+	}
+}
+
+func ethereumMAC(derivedKey, cipherText []byte) []byte {
+	return sha3.Sha3(append(append([]byte{}, derivedKey[16:32]...), cipherText...))
+}
+
+func aesCTR(key, iv, in []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]byte, len(in))
+	cipher.NewCTR(block, iv).XORKeyStream(out, in)
+	return out, nil
+}
+
+// Returns the integer parameter name if it lies between min and max inclusive, JSON numbers are decoded as float64
+func boundedIntParam(params map[string]interface{}, name string, min, max int) (int, error) {
+	f, _ := params[name].(float64)
+	if f < float64(min) || f > float64(max) {
+		return 0, fmt.Errorf("Ethereum key kdf parameter %s should be between %d and %d but is %v", name, min, max,
+			params[name])
+	}
+	return int(f), nil
+}
+
+func stringParam(params map[string]interface{}, name string) string {
+	s, _ := params[name].(string)
+	return s
+}
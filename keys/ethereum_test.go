@@ -0,0 +1,117 @@
+package keys
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hex "github.com/tmthrgd/go-hex"
+)
+
+// Test vectors from the Web3 Secret Storage definition
+const (
+	testEthereumPassphrase = "testpassword"
+	testEthereumPrivateKey = "7a28b5ba57c53603b0b07b56bba752f7784bf506fa95edc395f5cf6c7514fe9d"
+	testEthereumAddress    = "008aeeda4d805471df9b2a5b0f38a0c3bcba786b"
+)
+
+var testEthereumKeys = map[string]string{
+	"pbkdf2": `{
+    "crypto" : {
+        "cipher" : "aes-128-ctr",
+        "cipherparams" : {
+            "iv" : "6087dab2f9fdbbfaddc31a909735c1e6"
+        },
+        "ciphertext" : "5318b4d5bcd28de64ee5559e671353e16f075ecae9f99c7a79a38af5f869aa46",
+        "kdf" : "pbkdf2",
+        "kdfparams" : {
+            "c" : 262144,
+            "dklen" : 32,
+            "prf" : "hmac-sha256",
+            "salt" : "ae3cd4e7013836a3df6bd7241b12db061dbe2c6785853cce422d148a624ce0bd"
+        },
+        "mac" : "517ead924a9d0dc3124507e3393d175ce3ff7c1e96529c6c555ce9e51205e9b2"
+    },
+    "id" : "3198bc9c-6672-5ab3-d995-4942343ae5b6",
+    "version" : 3
+}`,
+	"scrypt": `{
+    "crypto" : {
+        "cipher" : "aes-128-ctr",
+        "cipherparams" : {
+            "iv" : "83dbcc02d8ccb40e466191a123791e0e"
+        },
+        "ciphertext" : "d172bf743a674da9cdad04534d56926ef8358534d458fffccd4e6ad2fbde479c",
+        "kdf" : "scrypt",
+        "kdfparams" : {
+            "dklen" : 32,
+            "n" : 262144,
+            "r" : 1,
+            "p" : 8,
+            "salt" : "ab0c7876052600dd703518d6fc3fe8984592145b591fc8fb5c6d43190334ba19"
+        },
+        "mac" : "2103ac29920d71da29f15d75b4a16dbe95cfd7ff8faea1056c33131d846e3097"
+    },
+    "id" : "3198bc9c-6672-5ab3-d995-4942343ae5b6",
+    "version" : 3
+}`,
+}
+
+func TestDecryptEthereumKey(t *testing.T) {
+	for kdf, keyJSON := range testEthereumKeys {
+		require.True(t, IsEthereumKeyJSON([]byte(keyJSON)), kdf)
+		key, err := DecryptEthereumKey(testEthereumPassphrase, []byte(keyJSON))
+		require.NoError(t, err, kdf)
+		assert.Equal(t, testEthereumPrivateKey, hex.EncodeToString(key.PrivateKey.RawBytes()), kdf)
+		address, err := EthereumAddress(key.PublicKey)
+		require.NoError(t, err)
+		assert.Equal(t, testEthereumAddress, hex.EncodeToString(address))
+	}
+
+	_, err := DecryptEthereumKey("wrong", []byte(testEthereumKeys["pbkdf2"]))
+	assert.Error(t, err)
+}
+
+func TestEncryptEthereumKey(t *testing.T) {
+	key, err := NewKeyFromPriv(crypto.CurveTypeSecp256k1, hex.MustDecodeString(testEthereumPrivateKey))
+	require.NoError(t, err)
+	keyJSON, err := EncryptEthereumKey(testEthereumPassphrase, key)
+	require.NoError(t, err)
+	require.True(t, IsEthereumKeyJSON(keyJSON))
+
+	ethKey := new(ethereumKeyJSON)
+	require.NoError(t, json.Unmarshal(keyJSON, ethKey))
+	assert.Equal(t, testEthereumAddress, ethKey.Address)
+	assert.Regexp(t, "^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$", ethKey.ID)
+
+	decrypted, err := DecryptEthereumKey(testEthereumPassphrase, keyJSON)
+	require.NoError(t, err)
+	assert.Equal(t, key, decrypted)
+
+	ed25519Key, err := NewKey(crypto.CurveTypeEd25519)
+	require.NoError(t, err)
+	_, err = EncryptEthereumKey(testEthereumPassphrase, ed25519Key)
+	assert.Error(t, err)
+
+	// Burrow's own key files are not Ethereum keys
+	burrowJSON, err := json.Marshal(key)
+	require.NoError(t, err)
+	assert.False(t, IsEthereumKeyJSON(burrowJSON))
+}
+
+func TestDecryptEthereumKeyBoundsKDF(t *testing.T) {
+	for kdf, param := range map[string]string{"scrypt": "n", "pbkdf2": "c"} {
+		for _, value := range []float64{1 << 30, 0, -1} {
+			keyJSON := new(ethereumKeyJSON)
+			require.NoError(t, json.Unmarshal([]byte(testEthereumKeys[kdf]), keyJSON))
+			keyJSON.Crypto.KDFParams[param] = value
+			bs, err := json.Marshal(keyJSON)
+			require.NoError(t, err)
+			_, err = DecryptEthereumKey(testEthereumPassphrase, bs)
+			require.Error(t, err, "%s %s of %v should be rejected", kdf, param, value)
+			assert.Contains(t, err.Error(), "kdf parameter "+param)
+		}
+	}
+}
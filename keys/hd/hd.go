@@ -0,0 +1,185 @@
+// Package hd derives keys from a seed along a path of child indices as in BIP-32 for secp256k1 and SLIP-10 for
+// Ed25519, so that many keys can be recovered from a single seed. Seeds are created from BIP-39 mnemonics.
+package hd
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/tyler-smith/go-bip39"
+)
+
+// Indices from HardenedOffset derive hardened children, which cannot be derived from a parent public key
+const HardenedOffset uint32 = 1 << 31
+
+// Entropy in bits of new mnemonics, giving 24 words
+const DefaultEntropyBits = 256
+
+// The HMAC keys the master key of each curve is derived with
+var masterKeys = map[crypto.CurveType][]byte{
+	crypto.CurveTypeSecp256k1: []byte("Bitcoin seed"),
+	crypto.CurveTypeEd25519:   []byte("ed25519 seed"),
+}
+
+// A Path of child indices from the master key, written as m/44'/60'/0'/0/0 where ' (or h) marks a hardened index
+type Path []uint32
+
+func ParsePath(path string) (Path, error) {
+	parts := strings.Split(strings.TrimSpace(path), "/")
+	if parts[0] != "m" {
+		return nil, fmt.Errorf("derivation path %s should start at the master key m", path)
+	}
+	p := make(Path, len(parts)-1)
+	for i, part := range parts[1:] {
+		hardened := strings.HasSuffix(part, "'") || strings.HasSuffix(part, "h")
+		if hardened {
+			part = part[:len(part)-1]
+		}
+		index, err := strconv.ParseUint(part, 10, 32)
+		if err != nil || uint32(index) >= HardenedOffset {
+			return nil, fmt.Errorf("invalid index %s in derivation path %s", parts[i+1], path)
+		}
+		p[i] = uint32(index)
+		if hardened {
+			p[i] += HardenedOffset
+		}
+	}
+	return p, nil
+}
+
+func (p Path) String() string {
+	buf := bytes.NewBufferString("m")
+	for _, index := range p {
+		buf.WriteByte('/')
+		if index >= HardenedOffset {
+			buf.WriteString(strconv.FormatUint(uint64(index-HardenedOffset), 10))
+			buf.WriteByte('\'')
+		} else {
+			buf.WriteString(strconv.FormatUint(uint64(index), 10))
+		}
+	}
+	return buf.String()
+}
+
+// NewMnemonic returns a new random mnemonic for entropyBits of entropy, a multiple of 32 from 128 to 256
+func NewMnemonic(entropyBits int) (string, error) {
+	entropy, err := bip39.NewEntropy(entropyBits)
+	if err != nil {
+		return "", err
+	}
+	return bip39.NewMnemonic(entropy)
+}
+
+// SeedFromMnemonic checks the mnemonic's checksum and returns the seed it encodes with the (optional) passphrase
+func SeedFromMnemonic(mnemonic, passphrase string) ([]byte, error) {
+	mnemonic = strings.Join(strings.Fields(mnemonic), " ")
+	if !bip39.IsMnemonicValid(mnemonic) {
+		return nil, fmt.Errorf("invalid mnemonic, check that every word is in the BIP-39 English word list")
+	}
+	return bip39.NewSeedWithErrorChecking(mnemonic, passphrase)
+}
+
+// ExtendedKey is a private key along with the chain code from which its children are derived
+type ExtendedKey struct {
+	CurveType crypto.CurveType
+	Key       []byte
+	ChainCode []byte
+}
+
+func NewMasterKey(seed []byte, curveType crypto.CurveType) (*ExtendedKey, error) {
+	hmacKey, ok := masterKeys[curveType]
+	if !ok {
+		return nil, crypto.ErrInvalidCurve(curveType.String())
+	}
+	mac := hmac.New(sha512.New, hmacKey)
+	mac.Write(seed)
+	sum := mac.Sum(nil)
+	key := &ExtendedKey{CurveType: curveType, Key: sum[:32], ChainCode: sum[32:]}
+	if curveType == crypto.CurveTypeSecp256k1 && !validSecp256k1(key.Key) {
+		return nil, fmt.Errorf("seed gives an invalid master key, use another seed")
+	}
+	return key, nil
+}
+
+// DeriveKey returns the private key at path from seed
+func DeriveKey(seed []byte, curveType crypto.CurveType, path Path) (crypto.PrivateKey, error) {
+	key, err := NewMasterKey(seed, curveType)
+	if err != nil {
+		return crypto.PrivateKey{}, err
+	}
+	key, err = key.DerivePath(path)
+	if err != nil {
+		return crypto.PrivateKey{}, err
+	}
+	return key.PrivateKey()
+}
+
+func (key *ExtendedKey) DerivePath(path Path) (*ExtendedKey, error) {
+	var err error
+	for _, index := range path {
+		key, err = key.Child(index)
+		if err != nil {
+			return nil, fmt.Errorf("could not derive %v: %v", path, err)
+		}
+	}
+	return key, nil
+}
+
+func (key *ExtendedKey) Child(index uint32) (*ExtendedKey, error) {
+	data := make([]byte, 0, 37)
+	if index >= HardenedOffset {
+		data = append(data, 0)
+		data = append(data, key.Key...)
+	} else {
+		// Only secp256k1 keys have public derivation, which SLIP-10 does not define for Ed25519
+		if key.CurveType != crypto.CurveTypeSecp256k1 {
+			return nil, fmt.Errorf("%v keys can only have hardened children but index %d is not hardened",
+				key.CurveType, index)
+		}
+		_, publicKey := btcec.PrivKeyFromBytes(btcec.S256(), key.Key)
+		data = append(data, publicKey.SerializeCompressed()...)
+	}
+	data = append(data, 0, 0, 0, 0)
+	binary.BigEndian.PutUint32(data[len(data)-4:], index)
+
+	mac := hmac.New(sha512.New, key.ChainCode)
+	mac.Write(data)
+	sum := mac.Sum(nil)
+	child := &ExtendedKey{CurveType: key.CurveType, Key: sum[:32], ChainCode: sum[32:]}
+	if key.CurveType == crypto.CurveTypeSecp256k1 {
+		// BIP-32 adds the parent key modulo the curve order, skipping the index if the result is invalid
+		if !validSecp256k1(child.Key) {
+			return nil, fmt.Errorf("index %d gives an invalid key, use the next index", index)
+		}
+		n := btcec.S256().N
+		k := new(big.Int).SetBytes(child.Key)
+		k.Add(k, new(big.Int).SetBytes(key.Key))
+		k.Mod(k, n)
+		if k.Sign() == 0 {
+			return nil, fmt.Errorf("index %d gives an invalid key, use the next index", index)
+		}
+		child.Key = make([]byte, 32)
+		kBytes := k.Bytes()
+		copy(child.Key[32-len(kBytes):], kBytes)
+	}
+	return child, nil
+}
+
+// PrivateKey returns the key as one of burrow's private keys
+func (key *ExtendedKey) PrivateKey() (crypto.PrivateKey, error) {
+	// Ed25519 keys are the seed from which burrow's expanded private key is generated
+	return crypto.GeneratePrivateKey(bytes.NewReader(key.Key), key.CurveType)
+}
+
+func validSecp256k1(key []byte) bool {
+	k := new(big.Int).SetBytes(key)
+	return k.Sign() != 0 && k.Cmp(btcec.S256().N) < 0
+}
@@ -0,0 +1,93 @@
+package hd
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hex "github.com/tmthrgd/go-hex"
+)
+
+func TestParsePath(t *testing.T) {
+	path, err := ParsePath("m/44'/60'/0h/0/1")
+	require.NoError(t, err)
+	assert.Equal(t, Path{44 + HardenedOffset, 60 + HardenedOffset, HardenedOffset, 0, 1}, path)
+	assert.Equal(t, "m/44'/60'/0'/0/1", path.String())
+
+	path, err = ParsePath("m")
+	require.NoError(t, err)
+	assert.Equal(t, "m", path.String())
+
+	for _, bad := range []string{"", "44'/60'", "m/", "m/x", "m/-1", "m/2147483648"} {
+		_, err = ParsePath(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+// Test vector 1 of BIP-32 and SLIP-10
+func TestDeriveKey(t *testing.T) {
+	seed := hex.MustDecodeString("000102030405060708090a0b0c0d0e0f")
+	vectors := []struct {
+		curveType crypto.CurveType
+		path      string
+		key       string
+		chainCode string
+	}{
+		{crypto.CurveTypeSecp256k1, "m",
+			"e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35",
+			"873dff81c02f525623fd1fe5167eac3a55a049de3d314bb42ee227ffed37d508"},
+		{crypto.CurveTypeSecp256k1, "m/0'",
+			"edb2e14f9ee77d26dd93b4ecede8d16ed408ce149b6cd80b0715a2d911a0afea",
+			"47fdacbd0f1097043b78c63c20c34ef4ed9a111d980047ad16282c7ae6236141"},
+		{crypto.CurveTypeSecp256k1, "m/0'/1",
+			"3c6cb8d0f6a264c91ea8b5030fadaa8e538b020f0a387421a12de9319dc93368", ""},
+		{crypto.CurveTypeSecp256k1, "m/0'/1/2'/2/1000000000",
+			"471b76e389e528d6de6d816857e012c5455051cad6660850e58372a6c3e6e7c8", ""},
+		{crypto.CurveTypeEd25519, "m",
+			"2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7",
+			"90046a93de5380a72b5e45010748567d5ea02bbf6522f979e05c0d8d8ca9fffb"},
+		{crypto.CurveTypeEd25519, "m/0'",
+			"68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3",
+			"8b59aa11380b624e81507a27fedda59fea6d0b779a778918a2fd3590e16e9c69"},
+		{crypto.CurveTypeEd25519, "m/0'/1'",
+			"b1d0bad404bf35da785a64ca1ac54b2617211d2777696fbffaf208f746ae84f2", ""},
+	}
+	for _, v := range vectors {
+		path, err := ParsePath(v.path)
+		require.NoError(t, err)
+		master, err := NewMasterKey(seed, v.curveType)
+		require.NoError(t, err)
+		key, err := master.DerivePath(path)
+		require.NoError(t, err)
+		assert.Equal(t, v.key, hex.EncodeToString(key.Key), "%v %s", v.curveType, v.path)
+		if v.chainCode != "" {
+			assert.Equal(t, v.chainCode, hex.EncodeToString(key.ChainCode), "%v %s", v.curveType, v.path)
+		}
+
+		privateKey, err := DeriveKey(seed, v.curveType, path)
+		require.NoError(t, err)
+		assert.Equal(t, v.curveType, privateKey.CurveType)
+		assert.Equal(t, v.key, hex.EncodeToString(privateKey.RawBytes()[:32]))
+	}
+
+	_, err := DeriveKey(seed, crypto.CurveTypeEd25519, Path{HardenedOffset, 1})
+	assert.Error(t, err, "Ed25519 has no public derivation")
+}
+
+func TestSeedFromMnemonic(t *testing.T) {
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	seed, err := SeedFromMnemonic(mnemonic, "TREZOR")
+	require.NoError(t, err)
+	assert.Equal(t, "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf1"+
+		"41630c7a3c4ab7c81b2f001698e7463b04", hex.EncodeToString(seed))
+
+	_, err = SeedFromMnemonic("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon "+
+		"abandon abandon", "")
+	assert.Error(t, err, "checksum should not match")
+
+	mnemonic, err = NewMnemonic(DefaultEntropyBits)
+	require.NoError(t, err)
+	_, err = SeedFromMnemonic(mnemonic, "")
+	require.NoError(t, err)
+}
@@ -16,12 +16,16 @@ package keys
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"io/ioutil"
 	"time"
 
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/logging"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 type KeyClient interface {
@@ -41,8 +45,18 @@ type KeyClient interface {
 	HealthCheck() error
 }
 
+// ConsensusSigner is implemented by key clients that can sign Tendermint votes and proposals with double-sign
+// protection
+type ConsensusSigner interface {
+	// SignConsensus returns the signature of the canonical sign bytes of a vote or proposal and the bytes signed, which
+	// differ from signBytes only by timestamp if a previous signature is returned
+	SignConsensus(signAddress crypto.Address, signBytes []byte) (*crypto.Signature, []byte, error)
+}
+
 var _ KeyClient = (*localKeyClient)(nil)
 var _ KeyClient = (*remoteKeyClient)(nil)
+var _ ConsensusSigner = (*localKeyClient)(nil)
+var _ ConsensusSigner = (*remoteKeyClient)(nil)
 
 type localKeyClient struct {
 	ks     *KeyStore
@@ -63,6 +77,15 @@ func (l *localKeyClient) Sign(signAddress crypto.Address, message []byte) (*cryp
 	return resp.GetSignature(), nil
 }
 
+func (l *localKeyClient) SignConsensus(signAddress crypto.Address, signBytes []byte) (*crypto.Signature, []byte,
+	error) {
+	resp, err := l.ks.SignConsensus(nil, &SignConsensusRequest{Address: signAddress.String(), SignBytes: signBytes})
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp.GetSignature(), resp.GetSignBytes(), nil
+}
+
 func (l *localKeyClient) PublicKey(address crypto.Address) (publicKey crypto.PublicKey, err error) {
 	resp, err := l.ks.PublicKey(nil, &PubRequest{Address: address.String()})
 	if err != nil {
@@ -121,6 +144,20 @@ func (l *remoteKeyClient) Sign(signAddress crypto.Address, message []byte) (*cry
 	return resp.GetSignature(), nil
 }
 
+func (l *remoteKeyClient) SignConsensus(signAddress crypto.Address, signBytes []byte) (*crypto.Signature, []byte,
+	error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	req := SignConsensusRequest{Address: signAddress.String(), SignBytes: signBytes}
+	l.logger.TraceMsg("Sending SignConsensus request to remote key server: ", fmt.Sprintf("%v", req))
+	resp, err := l.kc.SignConsensus(ctx, &req)
+	if err != nil {
+		l.logger.TraceMsg("Received SignConsensus request error response: ", err)
+		return nil, nil, err
+	}
+	return resp.GetSignature(), resp.GetSignBytes(), nil
+}
+
 func (l *remoteKeyClient) PublicKey(address crypto.Address) (publicKey crypto.PublicKey, err error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
@@ -183,10 +220,18 @@ func (l *remoteKeyClient) HealthCheck() error {
 
 // NewRemoteKeyClient returns a new keys client for provided rpc location
 func NewRemoteKeyClient(rpcAddress string, logger *logging.Logger) (KeyClient, error) {
+	return NewRemoteKeyClientWithTLS(rpcAddress, nil, logger)
+}
+
+// NewRemoteKeyClientWithTLS returns a new keys client for a keys server that serves TLS, or in the clear if tlsConf
+// is nil
+func NewRemoteKeyClientWithTLS(rpcAddress string, tlsConf *ClientTLSConfig, logger *logging.Logger) (KeyClient, error) {
 	logger = logger.WithScope("RemoteKeyClient")
-	var opts []grpc.DialOption
-	opts = append(opts, grpc.WithInsecure())
-	conn, err := grpc.Dial(rpcAddress, opts...)
+	opt, err := tlsConf.DialOption()
+	if err != nil {
+		return nil, err
+	}
+	conn, err := grpc.Dial(rpcAddress, opt)
 	if err != nil {
 		return nil, err
 	}
@@ -195,6 +240,32 @@ func NewRemoteKeyClient(rpcAddress string, logger *logging.Logger) (KeyClient, e
 	return &remoteKeyClient{kc: kc, rpcAddress: rpcAddress, logger: logger}, nil
 }
 
+// DialOption returns the transport credentials to dial a keys server with
+func (conf *ClientTLSConfig) DialOption() (grpc.DialOption, error) {
+	if conf == nil {
+		return grpc.WithInsecure(), nil
+	}
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if conf.CAFile != "" {
+		caPEM, err := ioutil.ReadFile(conf.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("could not read keys server CAs: %v", err)
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("no certificates found in keys server CA file %s", conf.CAFile)
+		}
+	}
+	if conf.CertFile != "" || conf.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(conf.CertFile, conf.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("could not load keys client certificate: %v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)), nil
+}
+
 // NewLocalKeyClient returns a new keys client, backed by the local filesystem
 func NewLocalKeyClient(ks *KeyStore, logger *logging.Logger) KeyClient {
 	logger = logger.WithScope("LocalKeyClient")
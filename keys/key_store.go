@@ -40,6 +40,9 @@ type keyJSON struct {
 	PublicKey   string
 	AddressHash string
 	PrivateKey  privateKeyJSON
+	// The seed and path a key derived from a seed was derived from
+	Seed string `json:",omitempty"`
+	Path string `json:",omitempty"`
 }
 
 type privateKeyJSON struct {
@@ -57,6 +60,8 @@ func (k *Key) MarshalJSON() (j []byte, err error) {
 		PublicKey:   hex.EncodeUpperToString(k.Pubkey()),
 		AddressHash: k.PublicKey.AddressHashType(),
 		PrivateKey:  privateKeyJSON{Crypto: CryptoNone, Plain: hex.EncodeUpperToString(k.PrivateKey.RawBytes())},
+		Seed:        k.Seed,
+		Path:        k.Path,
 	}
 	j, err = json.Marshal(jStruct)
 	return j, err
@@ -88,6 +93,8 @@ func (k *Key) UnmarshalJSON(j []byte) (err error) {
 	k.CurveType = curveType
 	k.PublicKey = k2.PrivateKey.GetPublicKey()
 	k.PrivateKey = k2.PrivateKey
+	k.Seed = keyJ.Seed
+	k.Path = keyJ.Path
 
 	return nil
 }
@@ -115,6 +122,8 @@ type KeyStore struct {
 	AllowBadFilePermissions bool
 	keysDirPath             string
 	logger                  *logging.Logger
+	// Held while checking and recording the last consensus message signed
+	consensusLock sync.Mutex
 }
 
 func (ks *KeyStore) Gen(passphrase string, curveType crypto.CurveType) (key *Key, err error) {
@@ -185,32 +194,15 @@ func (ks *KeyStore) AllKeys() ([]*Key, error) {
 }
 
 func DecryptKey(passphrase string, keyProtected *keyJSON) (*Key, error) {
-	salt := keyProtected.PrivateKey.Salt
-	nonce := keyProtected.PrivateKey.Nonce
-	cipherText := keyProtected.PrivateKey.CipherText
-
 	curveType, err := crypto.CurveTypeFromString(keyProtected.CurveType)
 	if err != nil {
 		return nil, err
 	}
-	authArray := []byte(passphrase)
-	derivedKey, err := scrypt.Key(authArray, salt, scryptN, scryptr, scryptp, scryptdkLen)
-	if err != nil {
-		return nil, err
-	}
-	aesBlock, err := aes.NewCipher(derivedKey)
-	if err != nil {
-		return nil, err
-	}
-	gcm, err := cipher.NewGCM(aesBlock)
-	if err != nil {
-		return nil, err
-	}
 	pubKey, err := hex.DecodeString(keyProtected.PublicKey)
 	if err != nil {
 		return nil, err
 	}
-	plainText, err := gcm.Open(nil, nonce, cipherText, nil)
+	plainText, err := decrypt(passphrase, keyProtected.PrivateKey)
 	if err != nil {
 		pkey, _ := NewKeyFromPub(curveType, pubKey)
 		return pkey, err
@@ -226,9 +218,28 @@ func DecryptKey(passphrase string, keyProtected *keyJSON) (*Key, error) {
 	if address != k.Address {
 		return nil, fmt.Errorf("address does not match")
 	}
+	k.Seed = keyProtected.Seed
+	k.Path = keyProtected.Path
 	return k, nil
 }
 
+// Decrypts bytes encrypted by encrypt
+func decrypt(passphrase string, encrypted privateKeyJSON) ([]byte, error) {
+	derivedKey, err := scrypt.Key([]byte(passphrase), encrypted.Salt, scryptN, scryptr, scryptp, scryptdkLen)
+	if err != nil {
+		return nil, err
+	}
+	aesBlock, err := aes.NewCipher(derivedKey)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(aesBlock)
+	if err != nil {
+		return nil, err
+	}
+	return gcm.Open(nil, encrypted.Nonce, encrypted.CipherText, nil)
+}
+
 func (ks *KeyStore) GetAllAddresses() (addresses []string, err error) {
 	ks.Lock()
 	defer ks.Unlock()
@@ -259,60 +270,67 @@ func (ks *KeyStore) StoreKeyPlain(key *Key) (err error) {
 }
 
 func (ks *KeyStore) StoreKeyEncrypted(passphrase string, key *Key) error {
-	authArray := []byte(passphrase)
-	salt := make([]byte, 32)
-	_, err := rand.Read(salt)
+	cipherStruct, err := encrypt(passphrase, key.PrivateKey.RawBytes())
 	if err != nil {
 		return err
 	}
-
-	derivedKey, err := scrypt.Key(authArray, salt, scryptN, scryptr, scryptp, scryptdkLen)
+	keyStruct := keyJSON{
+		CurveType:   key.CurveType.String(),
+		Address:     hex.EncodeUpperToString(key.Address[:]),
+		PublicKey:   hex.EncodeUpperToString(key.Pubkey()),
+		AddressHash: key.PublicKey.AddressHashType(),
+		PrivateKey:  cipherStruct,
+		Seed:        key.Seed,
+		Path:        key.Path,
+	}
+	keyJSON, err := json.Marshal(keyStruct)
 	if err != nil {
 		return err
 	}
+	dataDirPath, err := returnDataDir(ks.keysDirPath)
+	if err != nil {
+		return err
+	}
+
+	return WriteKeyFile(key.Address[:], dataDirPath, keyJSON)
+}
+
+// Encrypts toEncrypt with a key derived from passphrase by scrypt
+func encrypt(passphrase string, toEncrypt []byte) (privateKeyJSON, error) {
+	salt := make([]byte, 32)
+	_, err := rand.Read(salt)
+	if err != nil {
+		return privateKeyJSON{}, err
+	}
 
-	toEncrypt := key.PrivateKey.RawBytes()
+	derivedKey, err := scrypt.Key([]byte(passphrase), salt, scryptN, scryptr, scryptp, scryptdkLen)
+	if err != nil {
+		return privateKeyJSON{}, err
+	}
 
 	AES256Block, err := aes.NewCipher(derivedKey)
 	if err != nil {
-		return err
+		return privateKeyJSON{}, err
 	}
 
 	gcm, err := cipher.NewGCM(AES256Block)
 	if err != nil {
-		return err
+		return privateKeyJSON{}, err
 	}
 
 	// XXX: a GCM nonce may only be used once per key ever!
 	nonce := make([]byte, gcm.NonceSize())
 	_, err = rand.Read(nonce)
 	if err != nil {
-		return err
+		return privateKeyJSON{}, err
 	}
 
 	// (dst, nonce, plaintext, extradata)
 	cipherText := gcm.Seal(nil, nonce, toEncrypt, nil)
 
-	cipherStruct := privateKeyJSON{
+	return privateKeyJSON{
 		Crypto: CryptoAESGCM, Salt: salt, Nonce: nonce, CipherText: cipherText,
-	}
-	keyStruct := keyJSON{
-		CurveType:   key.CurveType.String(),
-		Address:     hex.EncodeUpperToString(key.Address[:]),
-		PublicKey:   hex.EncodeUpperToString(key.Pubkey()),
-		AddressHash: key.PublicKey.AddressHashType(),
-		PrivateKey:  cipherStruct,
-	}
-	keyJSON, err := json.Marshal(keyStruct)
-	if err != nil {
-		return err
-	}
-	dataDirPath, err := returnDataDir(ks.keysDirPath)
-	if err != nil {
-		return err
-	}
-
-	return WriteKeyFile(key.Address[:], dataDirPath, keyJSON)
+	}, nil
 }
 
 func (ks *KeyStore) DeleteKey(passphrase string, keyAddr []byte) (err error) {
@@ -370,6 +388,9 @@ type Key struct {
 	Address    crypto.Address
 	PublicKey  crypto.PublicKey
 	PrivateKey crypto.PrivateKey
+	// The name of the seed and the path a derived key was derived along
+	Seed string
+	Path string
 }
 
 func NewKey(typ crypto.CurveType) (*Key, error) {
@@ -15,6 +15,8 @@
 		RemoveNameRequest
 		GenRequest
 		GenResponse
+		GenSeedRequest
+		GenSeedResponse
 		PubRequest
 		PubResponse
 		ImportJSONRequest
@@ -24,6 +26,8 @@
 		ExportResponse
 		SignRequest
 		SignResponse
+		SignConsensusRequest
+		SignConsensusResponse
 		VerifyRequest
 		HashRequest
 		HashResponse
@@ -59,6 +63,8 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
 type ListRequest struct {
 	KeyName string `protobuf:"bytes,1,opt,name=KeyName,proto3" json:"KeyName,omitempty"`
+	// Only list keys derived from this seed
+	Seed string `protobuf:"bytes,2,opt,name=Seed,proto3" json:"Seed,omitempty"`
 }
 
 func (m *ListRequest) Reset()                    { *m = ListRequest{} }
@@ -73,6 +79,13 @@ func (m *ListRequest) GetKeyName() string {
 	return ""
 }
 
+func (m *ListRequest) GetSeed() string {
+	if m != nil {
+		return m.Seed
+	}
+	return ""
+}
+
 func (*ListRequest) XXX_MessageName() string {
 	return "keys.ListRequest"
 }
@@ -137,6 +150,9 @@ type GenRequest struct {
 	Passphrase string `protobuf:"bytes,1,opt,name=Passphrase,proto3" json:"Passphrase,omitempty"`
 	CurveType  string `protobuf:"bytes,2,opt,name=CurveType,proto3" json:"CurveType,omitempty"`
 	KeyName    string `protobuf:"bytes,3,opt,name=KeyName,proto3" json:"KeyName,omitempty"`
+	// Derive the key from this seed along Path instead of generating a random key
+	Seed string `protobuf:"bytes,4,opt,name=Seed,proto3" json:"Seed,omitempty"`
+	Path string `protobuf:"bytes,5,opt,name=Path,proto3" json:"Path,omitempty"`
 }
 
 func (m *GenRequest) Reset()                    { *m = GenRequest{} }
@@ -165,6 +181,20 @@ func (m *GenRequest) GetKeyName() string {
 	return ""
 }
 
+func (m *GenRequest) GetSeed() string {
+	if m != nil {
+		return m.Seed
+	}
+	return ""
+}
+
+func (m *GenRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
 func (*GenRequest) XXX_MessageName() string {
 	return "keys.GenRequest"
 }
@@ -189,6 +219,82 @@ func (*GenResponse) XXX_MessageName() string {
 	return "keys.GenResponse"
 }
 
+type GenSeedRequest struct {
+	Passphrase string `protobuf:"bytes,1,opt,name=Passphrase,proto3" json:"Passphrase,omitempty"`
+	Name       string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	// Recover the seed from this mnemonic, otherwise a new mnemonic is generated
+	Mnemonic string `protobuf:"bytes,3,opt,name=Mnemonic,proto3" json:"Mnemonic,omitempty"`
+	// The optional BIP-39 passphrase that is combined with the mnemonic
+	MnemonicPassphrase string `protobuf:"bytes,4,opt,name=MnemonicPassphrase,proto3" json:"MnemonicPassphrase,omitempty"`
+	// Entropy of a new mnemonic, defaults to 256 bits (24 words)
+	EntropyBits uint32 `protobuf:"varint,5,opt,name=EntropyBits,proto3" json:"EntropyBits,omitempty"`
+}
+
+func (m *GenSeedRequest) Reset()                    { *m = GenSeedRequest{} }
+func (m *GenSeedRequest) String() string            { return proto.CompactTextString(m) }
+func (*GenSeedRequest) ProtoMessage()               {}
+func (*GenSeedRequest) Descriptor() ([]byte, []int) { return fileDescriptorKeys, []int{7} }
+
+func (m *GenSeedRequest) GetPassphrase() string {
+	if m != nil {
+		return m.Passphrase
+	}
+	return ""
+}
+
+func (m *GenSeedRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GenSeedRequest) GetMnemonic() string {
+	if m != nil {
+		return m.Mnemonic
+	}
+	return ""
+}
+
+func (m *GenSeedRequest) GetMnemonicPassphrase() string {
+	if m != nil {
+		return m.MnemonicPassphrase
+	}
+	return ""
+}
+
+func (m *GenSeedRequest) GetEntropyBits() uint32 {
+	if m != nil {
+		return m.EntropyBits
+	}
+	return 0
+}
+
+func (*GenSeedRequest) XXX_MessageName() string {
+	return "keys.GenSeedRequest"
+}
+
+type GenSeedResponse struct {
+	// Only returned for a new seed, and should be backed up
+	Mnemonic string `protobuf:"bytes,1,opt,name=Mnemonic,proto3" json:"Mnemonic,omitempty"`
+}
+
+func (m *GenSeedResponse) Reset()                    { *m = GenSeedResponse{} }
+func (m *GenSeedResponse) String() string            { return proto.CompactTextString(m) }
+func (*GenSeedResponse) ProtoMessage()               {}
+func (*GenSeedResponse) Descriptor() ([]byte, []int) { return fileDescriptorKeys, []int{8} }
+
+func (m *GenSeedResponse) GetMnemonic() string {
+	if m != nil {
+		return m.Mnemonic
+	}
+	return ""
+}
+
+func (*GenSeedResponse) XXX_MessageName() string {
+	return "keys.GenSeedResponse"
+}
+
 type PubRequest struct {
 	Address string `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
 	Name    string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
@@ -197,7 +303,7 @@ type PubRequest struct {
 func (m *PubRequest) Reset()                    { *m = PubRequest{} }
 func (m *PubRequest) String() string            { return proto.CompactTextString(m) }
 func (*PubRequest) ProtoMessage()               {}
-func (*PubRequest) Descriptor() ([]byte, []int) { return fileDescriptorKeys, []int{7} }
+func (*PubRequest) Descriptor() ([]byte, []int) { return fileDescriptorKeys, []int{9} }
 
 func (m *PubRequest) GetAddress() string {
 	if m != nil {
@@ -225,7 +331,7 @@ type PubResponse struct {
 func (m *PubResponse) Reset()                    { *m = PubResponse{} }
 func (m *PubResponse) String() string            { return proto.CompactTextString(m) }
 func (*PubResponse) ProtoMessage()               {}
-func (*PubResponse) Descriptor() ([]byte, []int) { return fileDescriptorKeys, []int{8} }
+func (*PubResponse) Descriptor() ([]byte, []int) { return fileDescriptorKeys, []int{10} }
 
 func (m *PubResponse) GetPublicKey() []byte {
 	if m != nil {
@@ -253,7 +359,7 @@ type ImportJSONRequest struct {
 func (m *ImportJSONRequest) Reset()                    { *m = ImportJSONRequest{} }
 func (m *ImportJSONRequest) String() string            { return proto.CompactTextString(m) }
 func (*ImportJSONRequest) ProtoMessage()               {}
-func (*ImportJSONRequest) Descriptor() ([]byte, []int) { return fileDescriptorKeys, []int{9} }
+func (*ImportJSONRequest) Descriptor() ([]byte, []int) { return fileDescriptorKeys, []int{11} }
 
 func (m *ImportJSONRequest) GetPassphrase() string {
 	if m != nil {
@@ -280,7 +386,7 @@ type ImportResponse struct {
 func (m *ImportResponse) Reset()                    { *m = ImportResponse{} }
 func (m *ImportResponse) String() string            { return proto.CompactTextString(m) }
 func (*ImportResponse) ProtoMessage()               {}
-func (*ImportResponse) Descriptor() ([]byte, []int) { return fileDescriptorKeys, []int{10} }
+func (*ImportResponse) Descriptor() ([]byte, []int) { return fileDescriptorKeys, []int{12} }
 
 func (m *ImportResponse) GetAddress() string {
 	if m != nil {
@@ -303,7 +409,7 @@ type ImportRequest struct {
 func (m *ImportRequest) Reset()                    { *m = ImportRequest{} }
 func (m *ImportRequest) String() string            { return proto.CompactTextString(m) }
 func (*ImportRequest) ProtoMessage()               {}
-func (*ImportRequest) Descriptor() ([]byte, []int) { return fileDescriptorKeys, []int{11} }
+func (*ImportRequest) Descriptor() ([]byte, []int) { return fileDescriptorKeys, []int{13} }
 
 func (m *ImportRequest) GetPassphrase() string {
 	if m != nil {
@@ -346,7 +452,7 @@ type ExportRequest struct {
 func (m *ExportRequest) Reset()                    { *m = ExportRequest{} }
 func (m *ExportRequest) String() string            { return proto.CompactTextString(m) }
 func (*ExportRequest) ProtoMessage()               {}
-func (*ExportRequest) Descriptor() ([]byte, []int) { return fileDescriptorKeys, []int{12} }
+func (*ExportRequest) Descriptor() ([]byte, []int) { return fileDescriptorKeys, []int{14} }
 
 func (m *ExportRequest) GetPassphrase() string {
 	if m != nil {
@@ -383,7 +489,7 @@ type ExportResponse struct {
 func (m *ExportResponse) Reset()                    { *m = ExportResponse{} }
 func (m *ExportResponse) String() string            { return proto.CompactTextString(m) }
 func (*ExportResponse) ProtoMessage()               {}
-func (*ExportResponse) Descriptor() ([]byte, []int) { return fileDescriptorKeys, []int{13} }
+func (*ExportResponse) Descriptor() ([]byte, []int) { return fileDescriptorKeys, []int{15} }
 
 func (m *ExportResponse) GetPublickey() []byte {
 	if m != nil {
@@ -427,7 +533,7 @@ type SignRequest struct {
 func (m *SignRequest) Reset()                    { *m = SignRequest{} }
 func (m *SignRequest) String() string            { return proto.CompactTextString(m) }
 func (*SignRequest) ProtoMessage()               {}
-func (*SignRequest) Descriptor() ([]byte, []int) { return fileDescriptorKeys, []int{14} }
+func (*SignRequest) Descriptor() ([]byte, []int) { return fileDescriptorKeys, []int{16} }
 
 func (m *SignRequest) GetPassphrase() string {
 	if m != nil {
@@ -468,7 +574,7 @@ type SignResponse struct {
 func (m *SignResponse) Reset()                    { *m = SignResponse{} }
 func (m *SignResponse) String() string            { return proto.CompactTextString(m) }
 func (*SignResponse) ProtoMessage()               {}
-func (*SignResponse) Descriptor() ([]byte, []int) { return fileDescriptorKeys, []int{15} }
+func (*SignResponse) Descriptor() ([]byte, []int) { return fileDescriptorKeys, []int{17} }
 
 func (m *SignResponse) GetSignature() *crypto.Signature {
 	if m != nil {
@@ -481,6 +587,82 @@ func (*SignResponse) XXX_MessageName() string {
 	return "keys.SignResponse"
 }
 
+// Signs a Tendermint vote or proposal, refusing to sign a message that conflicts with one already signed at the same
+// height, round and step
+type SignConsensusRequest struct {
+	Passphrase string `protobuf:"bytes,1,opt,name=Passphrase,proto3" json:"Passphrase,omitempty"`
+	Address    string `protobuf:"bytes,2,opt,name=Address,proto3" json:"Address,omitempty"`
+	Name       string `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name,omitempty"`
+	// Canonical sign bytes of the vote or proposal
+	SignBytes []byte `protobuf:"bytes,4,opt,name=SignBytes,proto3" json:"SignBytes,omitempty"`
+}
+
+func (m *SignConsensusRequest) Reset()                    { *m = SignConsensusRequest{} }
+func (m *SignConsensusRequest) String() string            { return proto.CompactTextString(m) }
+func (*SignConsensusRequest) ProtoMessage()               {}
+func (*SignConsensusRequest) Descriptor() ([]byte, []int) { return fileDescriptorKeys, []int{18} }
+
+func (m *SignConsensusRequest) GetPassphrase() string {
+	if m != nil {
+		return m.Passphrase
+	}
+	return ""
+}
+
+func (m *SignConsensusRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SignConsensusRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SignConsensusRequest) GetSignBytes() []byte {
+	if m != nil {
+		return m.SignBytes
+	}
+	return nil
+}
+
+func (*SignConsensusRequest) XXX_MessageName() string {
+	return "keys.SignConsensusRequest"
+}
+
+type SignConsensusResponse struct {
+	Signature *crypto.Signature `protobuf:"bytes,1,opt,name=Signature" json:"Signature,omitempty"`
+	// The bytes signed, which differ from those requested only by timestamp when a previous signature is returned
+	SignBytes []byte `protobuf:"bytes,2,opt,name=SignBytes,proto3" json:"SignBytes,omitempty"`
+}
+
+func (m *SignConsensusResponse) Reset()                    { *m = SignConsensusResponse{} }
+func (m *SignConsensusResponse) String() string            { return proto.CompactTextString(m) }
+func (*SignConsensusResponse) ProtoMessage()               {}
+func (*SignConsensusResponse) Descriptor() ([]byte, []int) { return fileDescriptorKeys, []int{19} }
+
+func (m *SignConsensusResponse) GetSignature() *crypto.Signature {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *SignConsensusResponse) GetSignBytes() []byte {
+	if m != nil {
+		return m.SignBytes
+	}
+	return nil
+}
+
+func (*SignConsensusResponse) XXX_MessageName() string {
+	return "keys.SignConsensusResponse"
+}
+
 type VerifyRequest struct {
 	PublicKey []byte            `protobuf:"bytes,2,opt,name=PublicKey,proto3" json:"PublicKey,omitempty"`
 	Message   []byte            `protobuf:"bytes,3,opt,name=Message,proto3" json:"Message,omitempty"`
@@ -490,7 +672,7 @@ type VerifyRequest struct {
 func (m *VerifyRequest) Reset()                    { *m = VerifyRequest{} }
 func (m *VerifyRequest) String() string            { return proto.CompactTextString(m) }
 func (*VerifyRequest) ProtoMessage()               {}
-func (*VerifyRequest) Descriptor() ([]byte, []int) { return fileDescriptorKeys, []int{16} }
+func (*VerifyRequest) Descriptor() ([]byte, []int) { return fileDescriptorKeys, []int{20} }
 
 func (m *VerifyRequest) GetPublicKey() []byte {
 	if m != nil {
@@ -525,7 +707,7 @@ type HashRequest struct {
 func (m *HashRequest) Reset()                    { *m = HashRequest{} }
 func (m *HashRequest) String() string            { return proto.CompactTextString(m) }
 func (*HashRequest) ProtoMessage()               {}
-func (*HashRequest) Descriptor() ([]byte, []int) { return fileDescriptorKeys, []int{17} }
+func (*HashRequest) Descriptor() ([]byte, []int) { return fileDescriptorKeys, []int{21} }
 
 func (m *HashRequest) GetHashtype() string {
 	if m != nil {
@@ -552,7 +734,7 @@ type HashResponse struct {
 func (m *HashResponse) Reset()                    { *m = HashResponse{} }
 func (m *HashResponse) String() string            { return proto.CompactTextString(m) }
 func (*HashResponse) ProtoMessage()               {}
-func (*HashResponse) Descriptor() ([]byte, []int) { return fileDescriptorKeys, []int{18} }
+func (*HashResponse) Descriptor() ([]byte, []int) { return fileDescriptorKeys, []int{22} }
 
 func (m *HashResponse) GetHash() string {
 	if m != nil {
@@ -568,12 +750,15 @@ func (*HashResponse) XXX_MessageName() string {
 type KeyID struct {
 	Address string   `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
 	KeyName []string `protobuf:"bytes,2,rep,name=KeyName" json:"KeyName,omitempty"`
+	// The seed and path of derived keys
+	Seed string `protobuf:"bytes,3,opt,name=Seed,proto3" json:"Seed,omitempty"`
+	Path string `protobuf:"bytes,4,opt,name=Path,proto3" json:"Path,omitempty"`
 }
 
 func (m *KeyID) Reset()                    { *m = KeyID{} }
 func (m *KeyID) String() string            { return proto.CompactTextString(m) }
 func (*KeyID) ProtoMessage()               {}
-func (*KeyID) Descriptor() ([]byte, []int) { return fileDescriptorKeys, []int{19} }
+func (*KeyID) Descriptor() ([]byte, []int) { return fileDescriptorKeys, []int{23} }
 
 func (m *KeyID) GetAddress() string {
 	if m != nil {
@@ -589,6 +774,20 @@ func (m *KeyID) GetKeyName() []string {
 	return nil
 }
 
+func (m *KeyID) GetSeed() string {
+	if m != nil {
+		return m.Seed
+	}
+	return ""
+}
+
+func (m *KeyID) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
 func (*KeyID) XXX_MessageName() string {
 	return "keys.KeyID"
 }
@@ -600,7 +799,7 @@ type ListResponse struct {
 func (m *ListResponse) Reset()                    { *m = ListResponse{} }
 func (m *ListResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListResponse) ProtoMessage()               {}
-func (*ListResponse) Descriptor() ([]byte, []int) { return fileDescriptorKeys, []int{20} }
+func (*ListResponse) Descriptor() ([]byte, []int) { return fileDescriptorKeys, []int{24} }
 
 func (m *ListResponse) GetKey() []*KeyID {
 	if m != nil {
@@ -621,7 +820,7 @@ type AddNameRequest struct {
 func (m *AddNameRequest) Reset()                    { *m = AddNameRequest{} }
 func (m *AddNameRequest) String() string            { return proto.CompactTextString(m) }
 func (*AddNameRequest) ProtoMessage()               {}
-func (*AddNameRequest) Descriptor() ([]byte, []int) { return fileDescriptorKeys, []int{21} }
+func (*AddNameRequest) Descriptor() ([]byte, []int) { return fileDescriptorKeys, []int{25} }
 
 func (m *AddNameRequest) GetKeyname() string {
 	if m != nil {
@@ -655,6 +854,10 @@ func init() {
 	golang_proto.RegisterType((*GenRequest)(nil), "keys.GenRequest")
 	proto.RegisterType((*GenResponse)(nil), "keys.GenResponse")
 	golang_proto.RegisterType((*GenResponse)(nil), "keys.GenResponse")
+	proto.RegisterType((*GenSeedRequest)(nil), "keys.GenSeedRequest")
+	golang_proto.RegisterType((*GenSeedRequest)(nil), "keys.GenSeedRequest")
+	proto.RegisterType((*GenSeedResponse)(nil), "keys.GenSeedResponse")
+	golang_proto.RegisterType((*GenSeedResponse)(nil), "keys.GenSeedResponse")
 	proto.RegisterType((*PubRequest)(nil), "keys.PubRequest")
 	golang_proto.RegisterType((*PubRequest)(nil), "keys.PubRequest")
 	proto.RegisterType((*PubResponse)(nil), "keys.PubResponse")
@@ -673,6 +876,10 @@ func init() {
 	golang_proto.RegisterType((*SignRequest)(nil), "keys.SignRequest")
 	proto.RegisterType((*SignResponse)(nil), "keys.SignResponse")
 	golang_proto.RegisterType((*SignResponse)(nil), "keys.SignResponse")
+	proto.RegisterType((*SignConsensusRequest)(nil), "keys.SignConsensusRequest")
+	golang_proto.RegisterType((*SignConsensusRequest)(nil), "keys.SignConsensusRequest")
+	proto.RegisterType((*SignConsensusResponse)(nil), "keys.SignConsensusResponse")
+	golang_proto.RegisterType((*SignConsensusResponse)(nil), "keys.SignConsensusResponse")
 	proto.RegisterType((*VerifyRequest)(nil), "keys.VerifyRequest")
 	golang_proto.RegisterType((*VerifyRequest)(nil), "keys.VerifyRequest")
 	proto.RegisterType((*HashRequest)(nil), "keys.HashRequest")
@@ -699,8 +906,10 @@ const _ = grpc.SupportPackageIsVersion4
 
 type KeysClient interface {
 	GenerateKey(ctx context.Context, in *GenRequest, opts ...grpc.CallOption) (*GenResponse, error)
+	GenerateSeed(ctx context.Context, in *GenSeedRequest, opts ...grpc.CallOption) (*GenSeedResponse, error)
 	PublicKey(ctx context.Context, in *PubRequest, opts ...grpc.CallOption) (*PubResponse, error)
 	Sign(ctx context.Context, in *SignRequest, opts ...grpc.CallOption) (*SignResponse, error)
+	SignConsensus(ctx context.Context, in *SignConsensusRequest, opts ...grpc.CallOption) (*SignConsensusResponse, error)
 	Verify(ctx context.Context, in *VerifyRequest, opts ...grpc.CallOption) (*VerifyResponse, error)
 	Import(ctx context.Context, in *ImportRequest, opts ...grpc.CallOption) (*ImportResponse, error)
 	ImportJSON(ctx context.Context, in *ImportJSONRequest, opts ...grpc.CallOption) (*ImportResponse, error)
@@ -728,6 +937,15 @@ func (c *keysClient) GenerateKey(ctx context.Context, in *GenRequest, opts ...gr
 	return out, nil
 }
 
+func (c *keysClient) GenerateSeed(ctx context.Context, in *GenSeedRequest, opts ...grpc.CallOption) (*GenSeedResponse, error) {
+	out := new(GenSeedResponse)
+	err := grpc.Invoke(ctx, "/keys.Keys/GenerateSeed", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *keysClient) PublicKey(ctx context.Context, in *PubRequest, opts ...grpc.CallOption) (*PubResponse, error) {
 	out := new(PubResponse)
 	err := grpc.Invoke(ctx, "/keys.Keys/PublicKey", in, out, c.cc, opts...)
@@ -746,6 +964,15 @@ func (c *keysClient) Sign(ctx context.Context, in *SignRequest, opts ...grpc.Cal
 	return out, nil
 }
 
+func (c *keysClient) SignConsensus(ctx context.Context, in *SignConsensusRequest, opts ...grpc.CallOption) (*SignConsensusResponse, error) {
+	out := new(SignConsensusResponse)
+	err := grpc.Invoke(ctx, "/keys.Keys/SignConsensus", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *keysClient) Verify(ctx context.Context, in *VerifyRequest, opts ...grpc.CallOption) (*VerifyResponse, error) {
 	out := new(VerifyResponse)
 	err := grpc.Invoke(ctx, "/keys.Keys/Verify", in, out, c.cc, opts...)
@@ -822,8 +1049,10 @@ func (c *keysClient) AddName(ctx context.Context, in *AddNameRequest, opts ...gr
 
 type KeysServer interface {
 	GenerateKey(context.Context, *GenRequest) (*GenResponse, error)
+	GenerateSeed(context.Context, *GenSeedRequest) (*GenSeedResponse, error)
 	PublicKey(context.Context, *PubRequest) (*PubResponse, error)
 	Sign(context.Context, *SignRequest) (*SignResponse, error)
+	SignConsensus(context.Context, *SignConsensusRequest) (*SignConsensusResponse, error)
 	Verify(context.Context, *VerifyRequest) (*VerifyResponse, error)
 	Import(context.Context, *ImportRequest) (*ImportResponse, error)
 	ImportJSON(context.Context, *ImportJSONRequest) (*ImportResponse, error)
@@ -856,6 +1085,24 @@ func _Keys_GenerateKey_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Keys_GenerateSeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenSeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeysServer).GenerateSeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keys.Keys/GenerateSeed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeysServer).GenerateSeed(ctx, req.(*GenSeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Keys_PublicKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PubRequest)
 	if err := dec(in); err != nil {
@@ -892,6 +1139,24 @@ func _Keys_Sign_Handler(srv interface{}, ctx context.Context, dec func(interface
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Keys_SignConsensus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignConsensusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeysServer).SignConsensus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keys.Keys/SignConsensus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeysServer).SignConsensus(ctx, req.(*SignConsensusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Keys_Verify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(VerifyRequest)
 	if err := dec(in); err != nil {
@@ -1044,6 +1309,10 @@ var _Keys_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GenerateKey",
 			Handler:    _Keys_GenerateKey_Handler,
 		},
+		{
+			MethodName: "GenerateSeed",
+			Handler:    _Keys_GenerateSeed_Handler,
+		},
 		{
 			MethodName: "PublicKey",
 			Handler:    _Keys_PublicKey_Handler,
@@ -1052,6 +1321,10 @@ var _Keys_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Sign",
 			Handler:    _Keys_Sign_Handler,
 		},
+		{
+			MethodName: "SignConsensus",
+			Handler:    _Keys_SignConsensus_Handler,
+		},
 		{
 			MethodName: "Verify",
 			Handler:    _Keys_Verify_Handler,
@@ -1110,6 +1383,12 @@ func (m *ListRequest) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintKeys(dAtA, i, uint64(len(m.KeyName)))
 		i += copy(dAtA[i:], m.KeyName)
 	}
+	if len(m.Seed) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Seed)))
+		i += copy(dAtA[i:], m.Seed)
+	}
 	return i, nil
 }
 
@@ -1224,6 +1503,18 @@ func (m *GenRequest) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintKeys(dAtA, i, uint64(len(m.KeyName)))
 		i += copy(dAtA[i:], m.KeyName)
 	}
+	if len(m.Seed) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Seed)))
+		i += copy(dAtA[i:], m.Seed)
+	}
+	if len(m.Path) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Path)))
+		i += copy(dAtA[i:], m.Path)
+	}
 	return i, nil
 }
 
@@ -1251,6 +1542,77 @@ func (m *GenResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *GenSeedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenSeedRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Passphrase) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Passphrase)))
+		i += copy(dAtA[i:], m.Passphrase)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Mnemonic) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Mnemonic)))
+		i += copy(dAtA[i:], m.Mnemonic)
+	}
+	if len(m.MnemonicPassphrase) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.MnemonicPassphrase)))
+		i += copy(dAtA[i:], m.MnemonicPassphrase)
+	}
+	if m.EntropyBits != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintKeys(dAtA, i, uint64(m.EntropyBits))
+	}
+	return i, nil
+}
+
+func (m *GenSeedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenSeedResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Mnemonic) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Mnemonic)))
+		i += copy(dAtA[i:], m.Mnemonic)
+	}
+	return i, nil
+}
+
 func (m *PubRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1555,7 +1917,7 @@ func (m *SignResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *VerifyRequest) Marshal() (dAtA []byte, err error) {
+func (m *SignConsensusRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1565,37 +1927,39 @@ func (m *VerifyRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VerifyRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SignConsensusRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.PublicKey) > 0 {
+	if len(m.Passphrase) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Passphrase)))
+		i += copy(dAtA[i:], m.Passphrase)
+	}
+	if len(m.Address) > 0 {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.PublicKey)))
-		i += copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Address)))
+		i += copy(dAtA[i:], m.Address)
 	}
-	if len(m.Message) > 0 {
+	if len(m.Name) > 0 {
 		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.Message)))
-		i += copy(dAtA[i:], m.Message)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	if m.Signature != nil {
-		dAtA[i] = 0x2a
+	if len(m.SignBytes) > 0 {
+		dAtA[i] = 0x22
 		i++
-		i = encodeVarintKeys(dAtA, i, uint64(m.Signature.Size()))
-		n2, err := m.Signature.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n2
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.SignBytes)))
+		i += copy(dAtA[i:], m.SignBytes)
 	}
 	return i, nil
 }
 
-func (m *HashRequest) Marshal() (dAtA []byte, err error) {
+func (m *SignConsensusResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1605,16 +1969,90 @@ func (m *HashRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HashRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SignConsensusResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Hashtype) > 0 {
+	if m.Signature != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintKeys(dAtA, i, uint64(len(m.Hashtype)))
-		i += copy(dAtA[i:], m.Hashtype)
+		i = encodeVarintKeys(dAtA, i, uint64(m.Signature.Size()))
+		n2, err := m.Signature.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if len(m.SignBytes) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.SignBytes)))
+		i += copy(dAtA[i:], m.SignBytes)
+	}
+	return i, nil
+}
+
+func (m *VerifyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifyRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.PublicKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.PublicKey)))
+		i += copy(dAtA[i:], m.PublicKey)
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if m.Signature != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintKeys(dAtA, i, uint64(m.Signature.Size()))
+		n3, err := m.Signature.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	return i, nil
+}
+
+func (m *HashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HashRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hashtype) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Hashtype)))
+		i += copy(dAtA[i:], m.Hashtype)
 	}
 	if len(m.Message) > 0 {
 		dAtA[i] = 0x12
@@ -1685,6 +2123,18 @@ func (m *KeyID) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
+	if len(m.Seed) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Seed)))
+		i += copy(dAtA[i:], m.Seed)
+	}
+	if len(m.Path) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Path)))
+		i += copy(dAtA[i:], m.Path)
+	}
 	return i, nil
 }
 
@@ -1764,6 +2214,10 @@ func (m *ListRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
+	l = len(m.Seed)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
 	return n
 }
 
@@ -1810,6 +2264,14 @@ func (m *GenRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
+	l = len(m.Seed)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
 	return n
 }
 
@@ -1823,6 +2285,41 @@ func (m *GenResponse) Size() (n int) {
 	return n
 }
 
+func (m *GenSeedRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Passphrase)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.Mnemonic)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.MnemonicPassphrase)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.EntropyBits != 0 {
+		n += 1 + sovKeys(uint64(m.EntropyBits))
+	}
+	return n
+}
+
+func (m *GenSeedResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Mnemonic)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	return n
+}
+
 func (m *PubRequest) Size() (n int) {
 	var l int
 	_ = l
@@ -1969,6 +2466,42 @@ func (m *SignResponse) Size() (n int) {
 	return n
 }
 
+func (m *SignConsensusRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Passphrase)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.SignBytes)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	return n
+}
+
+func (m *SignConsensusResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Signature != nil {
+		l = m.Signature.Size()
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.SignBytes)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	return n
+}
+
 func (m *VerifyRequest) Size() (n int) {
 	var l int
 	_ = l
@@ -2024,6 +2557,14 @@ func (m *KeyID) Size() (n int) {
 			n += 1 + l + sovKeys(uint64(l))
 		}
 	}
+	l = len(m.Seed)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
 	return n
 }
 
@@ -2124,6 +2665,35 @@ func (m *ListRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.KeyName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seed = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -2490,6 +3060,64 @@ func (m *GenRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.KeyName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seed = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -2590,7 +3218,7 @@ func (m *GenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PubRequest) Unmarshal(dAtA []byte) error {
+func (m *GenSeedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2613,15 +3241,15 @@ func (m *PubRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PubRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GenSeedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PubRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GenSeedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Passphrase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2646,7 +3274,7 @@ func (m *PubRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Passphrase = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -2677,28 +3305,510 @@ func (m *PubRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipKeys(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthKeys
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mnemonic", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mnemonic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MnemonicPassphrase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MnemonicPassphrase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntropyBits", wireType)
+			}
+			m.EntropyBits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EntropyBits |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenSeedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenSeedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenSeedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mnemonic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mnemonic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PubRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PubRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PubRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PubResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PubResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PubResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurveType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurveType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImportJSONRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImportJSONRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImportJSONRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Passphrase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Passphrase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSON", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JSON = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *PubResponse) Unmarshal(dAtA []byte) error {
+func (m *ImportResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2721,46 +3831,15 @@ func (m *PubResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PubResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImportResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PubResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthKeys
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PublicKey == nil {
-				m.PublicKey = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurveType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2785,7 +3864,7 @@ func (m *PubResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurveType = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2808,7 +3887,7 @@ func (m *PubResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImportJSONRequest) Unmarshal(dAtA []byte) error {
+func (m *ImportRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2831,10 +3910,10 @@ func (m *ImportJSONRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ImportJSONRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImportRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImportJSONRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2868,7 +3947,7 @@ func (m *ImportJSONRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSON", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2893,7 +3972,67 @@ func (m *ImportJSONRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JSON = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurveType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurveType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyBytes = append(m.KeyBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.KeyBytes == nil {
+				m.KeyBytes = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2916,7 +4055,7 @@ func (m *ImportJSONRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImportResponse) Unmarshal(dAtA []byte) error {
+func (m *ExportRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2939,13 +4078,71 @@ func (m *ImportResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ImportResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExportRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Passphrase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Passphrase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
@@ -2995,7 +4192,7 @@ func (m *ImportResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImportRequest) Unmarshal(dAtA []byte) error {
+func (m *ExportResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3018,17 +4215,17 @@ func (m *ImportRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ImportRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExportResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Passphrase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Publickey", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -3038,26 +4235,28 @@ func (m *ImportRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Passphrase = string(dAtA[iNdEx:postIndex])
+			m.Publickey = append(m.Publickey[:0], dAtA[iNdEx:postIndex]...)
+			if m.Publickey == nil {
+				m.Publickey = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Privatekey", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -3067,26 +4266,28 @@ func (m *ImportRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Privatekey = append(m.Privatekey[:0], dAtA[iNdEx:postIndex]...)
+			if m.Privatekey == nil {
+				m.Privatekey = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurveType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -3096,26 +4297,28 @@ func (m *ImportRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurveType = string(dAtA[iNdEx:postIndex])
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurveType", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -3125,22 +4328,20 @@ func (m *ImportRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyBytes = append(m.KeyBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.KeyBytes == nil {
-				m.KeyBytes = []byte{}
-			}
+			m.CurveType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3163,7 +4364,7 @@ func (m *ImportRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExportRequest) Unmarshal(dAtA []byte) error {
+func (m *SignRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3186,10 +4387,10 @@ func (m *ExportRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExportRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3223,7 +4424,7 @@ func (m *ExportRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3248,11 +4449,11 @@ func (m *ExportRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3277,7 +4478,38 @@ func (m *ExportRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = append(m.Message[:0], dAtA[iNdEx:postIndex]...)
+			if m.Message == nil {
+				m.Message = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3300,7 +4532,7 @@ func (m *ExportRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExportResponse) Unmarshal(dAtA []byte) error {
+func (m *SignResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3323,79 +4555,17 @@ func (m *ExportResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExportResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Publickey", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthKeys
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Publickey = append(m.Publickey[:0], dAtA[iNdEx:postIndex]...)
-			if m.Publickey == nil {
-				m.Publickey = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Privatekey", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthKeys
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Privatekey = append(m.Privatekey[:0], dAtA[iNdEx:postIndex]...)
-			if m.Privatekey == nil {
-				m.Privatekey = []byte{}
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeys
@@ -3405,51 +4575,24 @@ func (m *ExportResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthKeys
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurveType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeys
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthKeys
+			if m.Signature == nil {
+				m.Signature = &crypto.Signature{}
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Signature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.CurveType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3472,7 +4615,7 @@ func (m *ExportResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignRequest) Unmarshal(dAtA []byte) error {
+func (m *SignConsensusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3495,10 +4638,10 @@ func (m *SignRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignConsensusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignConsensusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3590,7 +4733,7 @@ func (m *SignRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignBytes", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3614,9 +4757,9 @@ func (m *SignRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = append(m.Message[:0], dAtA[iNdEx:postIndex]...)
-			if m.Message == nil {
-				m.Message = []byte{}
+			m.SignBytes = append(m.SignBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.SignBytes == nil {
+				m.SignBytes = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -3640,7 +4783,7 @@ func (m *SignRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignResponse) Unmarshal(dAtA []byte) error {
+func (m *SignConsensusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3663,13 +4806,13 @@ func (m *SignResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignConsensusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignConsensusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 3:
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
@@ -3702,6 +4845,37 @@ func (m *SignResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignBytes = append(m.SignBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.SignBytes == nil {
+				m.SignBytes = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -4144,6 +5318,64 @@ func (m *KeyID) Unmarshal(dAtA []byte) error {
 			}
 			m.KeyName = append(m.KeyName, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seed = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -4463,54 +5695,65 @@ func init() { proto.RegisterFile("keys.proto", fileDescriptorKeys) }
 func init() { golang_proto.RegisterFile("keys.proto", fileDescriptorKeys) }
 
 var fileDescriptorKeys = []byte{
-	// 769 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x5d, 0x4f, 0x13, 0x4d,
-	0x14, 0xce, 0x76, 0x17, 0x5e, 0x7a, 0xb6, 0xf4, 0xa5, 0x2b, 0xc6, 0x66, 0x83, 0x0d, 0x99, 0x1b,
-	0x88, 0x49, 0x5b, 0x53, 0x12, 0x63, 0xe4, 0x82, 0xf0, 0x61, 0x10, 0xab, 0x48, 0x8a, 0xf1, 0xc2,
-	0xc4, 0x8b, 0x2d, 0x3d, 0xb4, 0x0d, 0xb4, 0x5b, 0x67, 0x76, 0x91, 0xbd, 0xf0, 0xd6, 0xdf, 0xe5,
-	0x25, 0x57, 0xc6, 0x9f, 0x60, 0xe0, 0x8f, 0x98, 0x99, 0xd9, 0xe9, 0xce, 0x2c, 0x88, 0x4d, 0xbc,
-	0x9b, 0xf3, 0x35, 0xcf, 0x39, 0x67, 0x9e, 0x3e, 0x5b, 0x80, 0x33, 0x4c, 0x58, 0x63, 0x42, 0xc3,
-	0x28, 0xf4, 0x1c, 0x7e, 0xf6, 0xeb, 0xfd, 0x61, 0x34, 0x88, 0xbb, 0x8d, 0x93, 0x70, 0xd4, 0xec,
-	0x87, 0xfd, 0xb0, 0x29, 0x82, 0xdd, 0xf8, 0x54, 0x58, 0xc2, 0x10, 0x27, 0x59, 0xe4, 0x97, 0x4e,
-	0x68, 0x32, 0x89, 0x52, 0x8b, 0xac, 0x81, 0xfb, 0x66, 0xc8, 0xa2, 0x0e, 0x7e, 0x8e, 0x91, 0x45,
-	0x5e, 0x15, 0xfe, 0x6b, 0x63, 0x72, 0x18, 0x8c, 0xb0, 0x6a, 0xad, 0x5a, 0xeb, 0xc5, 0x8e, 0x32,
-	0xc9, 0x12, 0x94, 0x3f, 0x20, 0x1d, 0x9e, 0x26, 0x1d, 0x64, 0x93, 0x70, 0xcc, 0x90, 0x2c, 0x83,
-	0xd7, 0xc1, 0x51, 0x78, 0x81, 0x3c, 0x3e, 0xf5, 0x56, 0xe0, 0xff, 0xed, 0x5e, 0xcf, 0x70, 0xd5,
-	0xa1, 0xa2, 0x27, 0xfe, 0x0d, 0xa9, 0x07, 0xb0, 0x8f, 0x63, 0x95, 0x57, 0x03, 0x38, 0x0a, 0x18,
-	0x9b, 0x0c, 0x68, 0xc0, 0x54, 0xaa, 0xe6, 0xf1, 0x56, 0xa0, 0xb8, 0x1b, 0xd3, 0x0b, 0x7c, 0x9f,
-	0x4c, 0xb0, 0x5a, 0x10, 0xe1, 0xcc, 0xa1, 0xa3, 0xd8, 0x26, 0xca, 0x1a, 0xb8, 0x02, 0x45, 0xf6,
-	0xc8, 0x13, 0xb7, 0x7b, 0x3d, 0x8a, 0x8c, 0xa9, 0x76, 0x52, 0x93, 0xbc, 0x00, 0x38, 0x8a, 0xbb,
-	0x5a, 0xdb, 0x77, 0xe7, 0x79, 0x1e, 0x38, 0x02, 0x47, 0xf6, 0x20, 0xce, 0xe4, 0x00, 0x5c, 0x51,
-	0x9b, 0x82, 0xac, 0x40, 0xf1, 0x28, 0xee, 0x9e, 0x0f, 0x4f, 0xda, 0x98, 0x88, 0xf2, 0x52, 0x27,
-	0x73, 0xdc, 0x3f, 0x09, 0xd9, 0x87, 0xca, 0xc1, 0x68, 0x12, 0xd2, 0xe8, 0xf5, 0xf1, 0xbb, 0xc3,
-	0x59, 0x97, 0xe3, 0x81, 0xc3, 0xd3, 0x55, 0x4f, 0xfc, 0x4c, 0x9e, 0x40, 0x59, 0x5e, 0x34, 0xc3,
-	0xec, 0x5f, 0x61, 0x51, 0xe5, 0xce, 0x0c, 0x98, 0x5f, 0x82, 0x39, 0x97, 0x9d, 0x7f, 0x21, 0x1f,
-	0x16, 0xda, 0x98, 0xec, 0x24, 0x11, 0xb2, 0xaa, 0x23, 0x56, 0x32, 0xb5, 0xc9, 0x27, 0x58, 0x7c,
-	0x79, 0xf9, 0xaf, 0xf0, 0xda, 0x74, 0xb6, 0x39, 0xdd, 0x37, 0x0b, 0xca, 0xea, 0xfe, 0xfc, 0x0b,
-	0x9d, 0xe5, 0x5f, 0xe8, 0x0c, 0x13, 0x01, 0x4f, 0x87, 0x17, 0x41, 0x84, 0x3c, 0x5c, 0x10, 0x61,
-	0xcd, 0x93, 0x87, 0x2a, 0x65, 0xe4, 0x30, 0x76, 0xe0, 0xe4, 0xdf, 0x36, 0x06, 0xf7, 0x78, 0xd8,
-	0x9f, 0x99, 0xf2, 0x1a, 0x4c, 0xe1, 0x6e, 0x0e, 0xda, 0xe6, 0xfc, 0x6f, 0x91, 0xb1, 0xa0, 0x8f,
-	0xe9, 0x7e, 0x95, 0x49, 0xb6, 0xa0, 0x24, 0x61, 0xd3, 0xe1, 0x9b, 0x50, 0xe4, 0x76, 0x10, 0xc5,
-	0x54, 0x5e, 0xe1, 0xb6, 0x2a, 0x8d, 0x54, 0x2d, 0xa6, 0x81, 0x4e, 0x96, 0x43, 0x2e, 0x61, 0x51,
-	0x69, 0x82, 0xec, 0xdc, 0x20, 0x78, 0x21, 0x4f, 0x70, 0xad, 0x13, 0xdb, 0xe8, 0xc4, 0x44, 0x9e,
-	0x9b, 0x01, 0x79, 0x17, 0xdc, 0x57, 0x01, 0x1b, 0x28, 0x5c, 0x1f, 0x16, 0xb8, 0x19, 0xf1, 0xed,
-	0xca, 0x7d, 0x4d, 0x6d, 0x1d, 0xb5, 0x60, 0xce, 0x4f, 0xa0, 0x24, 0x2f, 0x49, 0xe7, 0xf7, 0xc0,
-	0xe1, 0x76, 0x7a, 0x83, 0x38, 0x93, 0x4d, 0x98, 0x6b, 0x63, 0x72, 0xb0, 0x77, 0xcf, 0x0f, 0x5f,
-	0xd3, 0x98, 0xc2, 0xaa, 0xad, 0x6b, 0x4c, 0x1d, 0x4a, 0x52, 0x5c, 0x53, 0x80, 0xc7, 0x60, 0x4b,
-	0x5e, 0xd9, 0xeb, 0x6e, 0xcb, 0x6d, 0x08, 0x25, 0x17, 0xb7, 0x77, 0xb8, 0x9f, 0xec, 0x41, 0x79,
-	0x2a, 0x9d, 0xba, 0x48, 0x8e, 0x4d, 0x91, 0x1c, 0xe7, 0x58, 0x6d, 0x72, 0xa0, 0xf5, 0xc3, 0x01,
-	0xa7, 0x8d, 0x09, 0xf3, 0x5a, 0x42, 0xe1, 0x90, 0x06, 0x11, 0xf2, 0xed, 0x2f, 0x49, 0xbc, 0x4c,
-	0x5a, 0xfd, 0x8a, 0xe6, 0x49, 0x3b, 0x7c, 0xaa, 0x3d, 0xa0, 0xaa, 0xc8, 0xd4, 0x4f, 0x55, 0xe8,
-	0x9a, 0x56, 0x07, 0x87, 0x3f, 0x8b, 0x97, 0x86, 0x34, 0x1e, 0xfb, 0x9e, 0xee, 0x4a, 0xd3, 0x37,
-	0x60, 0x5e, 0x52, 0xc6, 0x7b, 0x20, 0xa3, 0x06, 0x81, 0xfc, 0x65, 0xd3, 0x99, 0x15, 0x49, 0x19,
-	0x52, 0x45, 0x86, 0x28, 0xa9, 0xa2, 0x9c, 0xaa, 0x6d, 0x02, 0x64, 0x82, 0xe9, 0x3d, 0xd2, 0x73,
-	0x34, 0x09, 0xfd, 0x43, 0xf1, 0x06, 0xcc, 0x4b, 0x65, 0x50, 0x88, 0x86, 0x0e, 0xa9, 0xa2, 0x9c,
-	0x78, 0xd4, 0x25, 0x7f, 0xd4, 0x2a, 0x34, 0x82, 0xaa, 0x55, 0x18, 0x74, 0xdb, 0x02, 0xc8, 0x3e,
-	0x8b, 0xaa, 0xc1, 0x5b, 0x1f, 0x4a, 0xbf, 0x7a, 0x3b, 0x90, 0xe1, 0x71, 0x7a, 0x29, 0x3c, 0xed,
-	0x3b, 0xae, 0xf0, 0x0c, 0xf6, 0x3d, 0x13, 0x94, 0x11, 0x60, 0x69, 0xff, 0x26, 0xdb, 0xfc, 0x87,
-	0x39, 0xaf, 0xac, 0xdb, 0x79, 0x7e, 0x75, 0x5d, 0xb3, 0x7e, 0x5e, 0xd7, 0xac, 0x5f, 0xd7, 0x35,
-	0xeb, 0xfb, 0x4d, 0xcd, 0xba, 0xba, 0xa9, 0x59, 0x1f, 0x89, 0xf6, 0xaf, 0x63, 0x90, 0x4c, 0x90,
-	0x9e, 0x63, 0xaf, 0x8f, 0xb4, 0xd9, 0x8d, 0x29, 0x0d, 0xbf, 0x34, 0xf9, 0x4d, 0xdd, 0x79, 0xf1,
-	0x1f, 0x63, 0xe3, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x6c, 0x2b, 0x01, 0x49, 0xb4, 0x08, 0x00,
+	// 945 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0x97, 0x63, 0xb7, 0x34, 0xcf, 0x49, 0x76, 0x33, 0xa4, 0x22, 0x1a, 0x4a, 0x54, 0xcd, 0x85,
+	0x15, 0x52, 0x12, 0xd4, 0x4a, 0x08, 0xb1, 0x87, 0xd5, 0xb6, 0x5b, 0x75, 0x4b, 0xd8, 0xa5, 0x72,
+	0x11, 0x07, 0x24, 0x0e, 0x4e, 0x32, 0x4d, 0xac, 0x6e, 0x6c, 0xe3, 0xb1, 0x4b, 0x7d, 0xe0, 0xc2,
+	0x81, 0x13, 0x9f, 0x86, 0x4f, 0xc0, 0x71, 0x8f, 0x5c, 0xb8, 0xa3, 0xee, 0x17, 0x41, 0xf3, 0xc7,
+	0xf6, 0x8c, 0xd7, 0xec, 0x16, 0xd0, 0xde, 0xe6, 0xfd, 0xe6, 0xfd, 0x7f, 0x6f, 0xfc, 0x33, 0xc0,
+	0x15, 0xcd, 0xd9, 0x24, 0x4e, 0xa2, 0x34, 0x42, 0x0e, 0x3f, 0xe3, 0xf1, 0x2a, 0x48, 0xd7, 0xd9,
+	0x7c, 0xb2, 0x88, 0x36, 0xd3, 0x55, 0xb4, 0x8a, 0xa6, 0xe2, 0x72, 0x9e, 0x5d, 0x0a, 0x49, 0x08,
+	0xe2, 0x24, 0x8d, 0x70, 0x67, 0x91, 0xe4, 0x71, 0xaa, 0x24, 0xf2, 0x10, 0xdc, 0xaf, 0x02, 0x96,
+	0x7a, 0xf4, 0x87, 0x8c, 0xb2, 0x14, 0x0d, 0xe1, 0xbd, 0x19, 0xcd, 0x9f, 0xfb, 0x1b, 0x3a, 0xb4,
+	0xf6, 0xad, 0x07, 0x6d, 0xaf, 0x10, 0x11, 0x02, 0xe7, 0x82, 0xd2, 0xe5, 0xb0, 0x25, 0x60, 0x71,
+	0x26, 0xf7, 0xa1, 0xf7, 0x2d, 0x4d, 0x82, 0xcb, 0xdc, 0xa3, 0x2c, 0x8e, 0x42, 0x46, 0xc9, 0x00,
+	0x90, 0x47, 0x37, 0xd1, 0x35, 0xe5, 0x36, 0x25, 0xda, 0x87, 0x7b, 0x8f, 0x97, 0x4b, 0x03, 0x1a,
+	0x43, 0x5f, 0x57, 0x7c, 0x4b, 0x74, 0xf2, 0xab, 0x05, 0x70, 0x4a, 0xc3, 0x42, 0x71, 0x04, 0x70,
+	0xee, 0x33, 0x16, 0xaf, 0x13, 0x9f, 0x15, 0xba, 0x1a, 0x82, 0xf6, 0xa0, 0x7d, 0x9c, 0x25, 0xd7,
+	0xf4, 0x9b, 0x3c, 0xa6, 0x2a, 0xe3, 0x0a, 0xd0, 0xc3, 0xd8, 0xcd, 0x45, 0x3a, 0x55, 0x91, 0x1c,
+	0x3b, 0xf7, 0xd3, 0xf5, 0x70, 0x4b, 0x62, 0xfc, 0x4c, 0x3e, 0x06, 0x57, 0x64, 0x23, 0x8b, 0xe1,
+	0x0e, 0x1f, 0x2f, 0x97, 0x09, 0x65, 0xac, 0xc8, 0x5b, 0x89, 0xe4, 0x37, 0x0b, 0x7a, 0xa7, 0x34,
+	0xe4, 0x8e, 0xee, 0x9a, 0x3b, 0x02, 0x47, 0xa4, 0xa6, 0x1a, 0x2d, 0xf2, 0xc2, 0xb0, 0xf3, 0x2c,
+	0xa4, 0x9b, 0x28, 0x0c, 0x16, 0x2a, 0xe5, 0x52, 0x46, 0x13, 0x40, 0xc5, 0x59, 0xf3, 0x2b, 0x2b,
+	0x68, 0xb8, 0x41, 0xfb, 0xe0, 0x9e, 0x84, 0x69, 0x12, 0xc5, 0xf9, 0x51, 0x90, 0x32, 0x51, 0x56,
+	0xd7, 0xd3, 0x21, 0x32, 0x86, 0x7b, 0x65, 0xce, 0xaa, 0x42, 0x3d, 0x01, 0xcb, 0x4c, 0x80, 0x7c,
+	0x01, 0x70, 0x9e, 0xcd, 0xb5, 0x19, 0x36, 0xf7, 0xa2, 0xa9, 0x30, 0x72, 0x06, 0xae, 0xb0, 0x55,
+	0x61, 0xf6, 0xa0, 0x7d, 0x9e, 0xcd, 0x5f, 0x04, 0x8b, 0x19, 0xcd, 0x85, 0x79, 0xc7, 0xab, 0x80,
+	0x37, 0x4f, 0x95, 0x9c, 0x42, 0xff, 0x6c, 0x13, 0x47, 0x49, 0xfa, 0xe5, 0xc5, 0xd7, 0xcf, 0xff,
+	0x45, 0xb3, 0xb9, 0x7a, 0x91, 0x13, 0x3f, 0x93, 0x4f, 0xa0, 0x27, 0x1d, 0xdd, 0x61, 0xbe, 0x3f,
+	0x41, 0xb7, 0xd0, 0xfd, 0xef, 0xd3, 0x35, 0xea, 0xb2, 0xeb, 0xdb, 0x8a, 0x61, 0x67, 0x46, 0xf3,
+	0xa3, 0x3c, 0xa5, 0x4c, 0x4c, 0xb5, 0xe3, 0x95, 0x32, 0xf9, 0x1e, 0xba, 0x27, 0x37, 0xff, 0x37,
+	0xbc, 0x56, 0x9d, 0x6d, 0x56, 0xf7, 0x8b, 0x05, 0xbd, 0x93, 0x1b, 0xa3, 0x15, 0xe5, 0x84, 0xae,
+	0xea, 0x13, 0xba, 0xa2, 0xb9, 0x08, 0x9f, 0x04, 0xd7, 0x7e, 0x4a, 0xf9, 0x75, 0x4b, 0x5c, 0x6b,
+	0x48, 0x3d, 0x54, 0xa7, 0x5a, 0x0e, 0xa3, 0x07, 0x4e, 0x7d, 0xb6, 0x19, 0xb8, 0x17, 0xc1, 0xea,
+	0xce, 0xcf, 0x5f, 0x0b, 0xd3, 0x6a, 0xde, 0x41, 0xdb, 0xac, 0xff, 0x19, 0x65, 0xcc, 0x5f, 0x51,
+	0xd5, 0xdf, 0x42, 0x24, 0x8f, 0xa0, 0x23, 0xc3, 0xaa, 0xe2, 0xa7, 0xd0, 0xe6, 0xb2, 0x9f, 0x66,
+	0x89, 0x74, 0xe1, 0x1e, 0xf4, 0x27, 0xea, 0x73, 0x5a, 0x5e, 0x78, 0x95, 0x0e, 0xf9, 0xd9, 0x82,
+	0x01, 0x97, 0x8e, 0xb9, 0x79, 0xc8, 0x32, 0xf6, 0x6e, 0x2a, 0xd8, 0x93, 0x79, 0xe9, 0x3b, 0x52,
+	0x01, 0xe4, 0x12, 0x76, 0x6b, 0x39, 0x34, 0x95, 0x63, 0xbd, 0xbd, 0x1c, 0x33, 0x4e, 0xab, 0x1e,
+	0xe7, 0x06, 0xba, 0x05, 0x1b, 0xc8, 0x22, 0x8d, 0xd7, 0xdc, 0xaa, 0xbf, 0x66, 0xad, 0xed, 0xb6,
+	0xd1, 0x76, 0x33, 0xaf, 0xad, 0x3b, 0xb4, 0xf9, 0x18, 0xdc, 0xa7, 0x3e, 0x5b, 0x17, 0x71, 0x31,
+	0xec, 0x70, 0x31, 0xcd, 0x63, 0x59, 0x56, 0xdb, 0x2b, 0x65, 0x3d, 0x6a, 0xcb, 0x1c, 0x36, 0x81,
+	0x8e, 0x74, 0xa2, 0xba, 0x83, 0xc0, 0xe1, 0xb2, 0xf2, 0x20, 0xce, 0x64, 0x01, 0x5b, 0x33, 0x9a,
+	0x9f, 0x3d, 0x79, 0xc3, 0x57, 0x4e, 0x23, 0x97, 0xd6, 0xbe, 0xdd, 0x44, 0x2e, 0x76, 0x03, 0xb9,
+	0x38, 0x1a, 0xb9, 0x8c, 0xa1, 0x23, 0x29, 0x59, 0x25, 0xf2, 0x11, 0xd8, 0xf2, 0xb1, 0xd9, 0x0f,
+	0xdc, 0x03, 0x77, 0x22, 0xf8, 0x5f, 0x64, 0xe1, 0x71, 0x9c, 0x3c, 0x81, 0x5e, 0x49, 0xae, 0x3a,
+	0x8d, 0x86, 0x26, 0x8d, 0x86, 0xb5, 0xa7, 0x6e, 0xae, 0xd5, 0xc1, 0x9f, 0x5b, 0xe0, 0xcc, 0x68,
+	0xce, 0xd0, 0x81, 0xa0, 0x36, 0x9a, 0xf8, 0x29, 0xe5, 0x53, 0xba, 0x2f, 0xe3, 0x55, 0xdc, 0x8b,
+	0xfb, 0x1a, 0xa2, 0x32, 0x7c, 0x08, 0x9d, 0xc2, 0x46, 0x54, 0x35, 0x28, 0x55, 0x34, 0xe2, 0xc3,
+	0xbb, 0x35, 0x54, 0x19, 0x7f, 0xaa, 0x6d, 0x49, 0x11, 0xae, 0xe2, 0x13, 0xdc, 0xd7, 0x10, 0x65,
+	0x31, 0x06, 0x87, 0xcf, 0x1e, 0xa9, 0x2b, 0xed, 0xcb, 0x80, 0x91, 0x0e, 0x29, 0xf5, 0xa7, 0xd0,
+	0x35, 0xf6, 0x1f, 0xe1, 0x4a, 0xa9, 0xfe, 0x30, 0xf1, 0x87, 0x8d, 0x77, 0xca, 0xd3, 0x21, 0x6c,
+	0xcb, 0x0d, 0x47, 0xef, 0x4b, 0x35, 0x63, 0xdf, 0xf1, 0xc0, 0x04, 0x2b, 0x23, 0x49, 0x11, 0x85,
+	0x91, 0x41, 0x18, 0x78, 0x60, 0x82, 0x65, 0x47, 0xa1, 0x22, 0x33, 0xf4, 0x81, 0xae, 0xa3, 0xd1,
+	0xdb, 0x3f, 0x18, 0x1f, 0xc2, 0xf6, 0xc9, 0x8d, 0x1e, 0xd1, 0xe0, 0x08, 0x3c, 0x30, 0xc1, 0xaa,
+	0xa9, 0x7c, 0xc5, 0x8b, 0xa6, 0x6a, 0xef, 0x09, 0x23, 0x1d, 0x52, 0xea, 0x8f, 0x00, 0xaa, 0xff,
+	0xb7, 0x22, 0xc1, 0xd7, 0xfe, 0xe8, 0xf0, 0xf0, 0xf5, 0x8b, 0x2a, 0x1e, 0xdf, 0xf2, 0x22, 0x9e,
+	0xf6, 0x13, 0x8a, 0x91, 0x0e, 0x29, 0xf5, 0xcf, 0xc4, 0xe6, 0x8a, 0x60, 0x2a, 0x7f, 0x73, 0xe9,
+	0xf1, 0x6e, 0x0d, 0x95, 0x76, 0x47, 0x9f, 0xbf, 0xbc, 0x1d, 0x59, 0x7f, 0xdc, 0x8e, 0xac, 0xbf,
+	0x6e, 0x47, 0xd6, 0xef, 0xaf, 0x46, 0xd6, 0xcb, 0x57, 0x23, 0xeb, 0x3b, 0xa2, 0xfd, 0x32, 0xaf,
+	0xf3, 0x98, 0x26, 0x2f, 0xe8, 0x72, 0x45, 0x93, 0xe9, 0x3c, 0x4b, 0x92, 0xe8, 0xc7, 0x29, 0xf7,
+	0x34, 0xdf, 0x16, 0x3f, 0xc8, 0x87, 0x7f, 0x0f, 0x00, 0x0c, 0x5a, 0xec, 0xd4, 0x71, 0x0b, 0x00,
 	0x00,
 }
@@ -0,0 +1,327 @@
+// The PKCS#11 module is loaded with cgo, which we do not want to depend on when building a static binary
+// +build pkcs11
+
+package keys
+
+import (
+	"bytes"
+	"encoding/asn1"
+	"fmt"
+	"math/big"
+	"os"
+	"sync"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/miekg/pkcs11"
+)
+
+// Mechanisms and key types added to PKCS#11 in v3.0 for Ed25519
+const (
+	ckkECEdwards           = 0x00000040
+	ckmECEdwardsKeyPairGen = 0x00001055
+	ckmEdDSA               = 0x00001057
+)
+
+var (
+	// DER encoded object identifiers of the curves, as CKA_EC_PARAMS holds them
+	ed25519Params   = []byte{0x06, 0x03, 0x2B, 0x65, 0x70}
+	secp256k1Params = []byte{0x06, 0x05, 0x2B, 0x81, 0x04, 0x00, 0x0A}
+)
+
+var _ KeyClient = (*pkcs11KeyClient)(nil)
+
+// Keys generated in the token are labelled with their key name and identified by their address
+type pkcs11KeyClient struct {
+	sync.Mutex
+	ctx     *pkcs11.Ctx
+	session pkcs11.SessionHandle
+	logger  *logging.Logger
+}
+
+// NewPKCS11KeyClient returns a keys client backed by a PKCS#11 token, such as a hardware security module, in which
+// keys are generated and used without their private keys ever leaving the token
+func NewPKCS11KeyClient(conf *PKCS11Config, logger *logging.Logger) (KeyClient, error) {
+	logger = logger.WithScope("PKCS11KeyClient")
+	ctx := pkcs11.New(conf.Module)
+	if ctx == nil {
+		return nil, fmt.Errorf("could not load PKCS#11 module %s", conf.Module)
+	}
+	err := ctx.Initialize()
+	if err != nil {
+		ctx.Destroy()
+		return nil, fmt.Errorf("could not initialise PKCS#11 module %s: %v", conf.Module, err)
+	}
+	slot, err := findSlot(ctx, conf.TokenLabel)
+	if err != nil {
+		ctx.Finalize()
+		ctx.Destroy()
+		return nil, err
+	}
+	session, err := ctx.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+	if err != nil {
+		ctx.Finalize()
+		ctx.Destroy()
+		return nil, fmt.Errorf("could not open session with PKCS#11 token %s: %v", conf.TokenLabel, err)
+	}
+	pin := conf.PIN
+	if pin == "" {
+		pin = os.Getenv(PKCS11PINEnv)
+	}
+	err = ctx.Login(session, pkcs11.CKU_USER, pin)
+	if err != nil && err != pkcs11.Error(pkcs11.CKR_USER_ALREADY_LOGGED_IN) {
+		ctx.CloseSession(session)
+		ctx.Finalize()
+		ctx.Destroy()
+		return nil, fmt.Errorf("could not log in to PKCS#11 token %s: %v", conf.TokenLabel, err)
+	}
+	logger.InfoMsg("Using PKCS#11 token", "module", conf.Module, "token", conf.TokenLabel)
+	return &pkcs11KeyClient{ctx: ctx, session: session, logger: logger}, nil
+}
+
+func findSlot(ctx *pkcs11.Ctx, tokenLabel string) (uint, error) {
+	slots, err := ctx.GetSlotList(true)
+	if err != nil {
+		return 0, fmt.Errorf("could not list PKCS#11 slots: %v", err)
+	}
+	for _, slot := range slots {
+		info, err := ctx.GetTokenInfo(slot)
+		if err != nil {
+			return 0, fmt.Errorf("could not get PKCS#11 token info for slot %d: %v", slot, err)
+		}
+		if info.Label == tokenLabel {
+			return slot, nil
+		}
+	}
+	return 0, fmt.Errorf("could not find PKCS#11 token labelled %s", tokenLabel)
+}
+
+func (p *pkcs11KeyClient) Sign(signAddress crypto.Address, message []byte) (*crypto.Signature, error) {
+	p.Lock()
+	defer p.Unlock()
+	private, err := p.findKey(pkcs11.CKO_PRIVATE_KEY, pkcs11.NewAttribute(pkcs11.CKA_ID, signAddress.Bytes()))
+	if err != nil {
+		return nil, err
+	}
+	curveType, err := p.curveType(private)
+	if err != nil {
+		return nil, err
+	}
+	switch curveType {
+	case crypto.CurveTypeEd25519:
+		sig, err := p.sign(private, ckmEdDSA, message)
+		if err != nil {
+			return nil, err
+		}
+		return crypto.SignatureFromBytes(sig, curveType)
+	case crypto.CurveTypeSecp256k1:
+		// Like btcec we sign the message as though it were a hash, using only as many of its bytes as the curve
+		// order has. Tokens can reject longer inputs to CKM_ECDSA rather than truncating them.
+		if len(message) > btcec.PrivKeyBytesLen {
+			message = message[:btcec.PrivKeyBytesLen]
+		}
+		sig, err := p.sign(private, pkcs11.CKM_ECDSA, message)
+		if err != nil {
+			return nil, err
+		}
+		if len(sig) != 2*btcec.PrivKeyBytesLen {
+			return nil, fmt.Errorf("PKCS#11 token returned ECDSA signature of %d bytes", len(sig))
+		}
+		// Serialize encodes as DER with the lower of the two possible values of S as btcec would sign
+		ecdsa := &btcec.Signature{
+			R: new(big.Int).SetBytes(sig[:btcec.PrivKeyBytesLen]),
+			S: new(big.Int).SetBytes(sig[btcec.PrivKeyBytesLen:]),
+		}
+		return crypto.SignatureFromBytes(ecdsa.Serialize(), curveType)
+	}
+	return nil, crypto.ErrInvalidCurve(curveType.String())
+}
+
+func (p *pkcs11KeyClient) sign(private pkcs11.ObjectHandle, mechanism uint, message []byte) ([]byte, error) {
+	err := p.ctx.SignInit(p.session, []*pkcs11.Mechanism{pkcs11.NewMechanism(mechanism, nil)}, private)
+	if err != nil {
+		return nil, fmt.Errorf("could not start signing with PKCS#11 token: %v", err)
+	}
+	sig, err := p.ctx.Sign(p.session, message)
+	if err != nil {
+		return nil, fmt.Errorf("could not sign with PKCS#11 token: %v", err)
+	}
+	return sig, nil
+}
+
+func (p *pkcs11KeyClient) PublicKey(address crypto.Address) (crypto.PublicKey, error) {
+	p.Lock()
+	defer p.Unlock()
+	public, err := p.findKey(pkcs11.CKO_PUBLIC_KEY, pkcs11.NewAttribute(pkcs11.CKA_ID, address.Bytes()))
+	if err != nil {
+		return crypto.PublicKey{}, err
+	}
+	return p.publicKey(public)
+}
+
+// Generate creates a key pair within the token. The private key is marked sensitive and not extractable so that it
+// cannot be read out of the token.
+func (p *pkcs11KeyClient) Generate(keyName string, curveType crypto.CurveType) (crypto.Address, error) {
+	p.Lock()
+	defer p.Unlock()
+	var mechanism uint
+	var params []byte
+	switch curveType {
+	case crypto.CurveTypeEd25519:
+		mechanism, params = ckmECEdwardsKeyPairGen, ed25519Params
+	case crypto.CurveTypeSecp256k1:
+		mechanism, params = pkcs11.CKM_EC_KEY_PAIR_GEN, secp256k1Params
+	default:
+		return crypto.Address{}, crypto.ErrInvalidCurve(curveType.String())
+	}
+	publicTemplate := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_VERIFY, true),
+		pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, params),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, keyName),
+	}
+	privateTemplate := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_PRIVATE, true),
+		pkcs11.NewAttribute(pkcs11.CKA_SIGN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_SENSITIVE, true),
+		pkcs11.NewAttribute(pkcs11.CKA_EXTRACTABLE, false),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, keyName),
+	}
+	public, private, err := p.ctx.GenerateKeyPair(p.session, []*pkcs11.Mechanism{pkcs11.NewMechanism(mechanism, nil)},
+		publicTemplate, privateTemplate)
+	if err != nil {
+		return crypto.Address{}, fmt.Errorf("could not generate %v key in PKCS#11 token: %v", curveType, err)
+	}
+	publicKey, err := p.publicKey(public)
+	if err != nil {
+		return crypto.Address{}, err
+	}
+	// The address is only known once the key exists
+	address := publicKey.GetAddress()
+	for _, key := range []pkcs11.ObjectHandle{public, private} {
+		err = p.ctx.SetAttributeValue(p.session, key, []*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_ID, address.Bytes()),
+		})
+		if err != nil {
+			return crypto.Address{}, fmt.Errorf("could not set ID of key in PKCS#11 token: %v", err)
+		}
+	}
+	p.logger.InfoMsg("Generated key in PKCS#11 token", "name", keyName, "address", address,
+		"curve_type", curveType)
+	return address, nil
+}
+
+func (p *pkcs11KeyClient) GetAddressForKeyName(keyName string) (crypto.Address, error) {
+	keyAddress, err := crypto.AddressFromHexString(keyName)
+	if err == nil {
+		return keyAddress, nil
+	}
+	p.Lock()
+	defer p.Unlock()
+	private, err := p.findKey(pkcs11.CKO_PRIVATE_KEY, pkcs11.NewAttribute(pkcs11.CKA_LABEL, keyName))
+	if err != nil {
+		return crypto.Address{}, fmt.Errorf("`%s` is neither an address or a known key name", keyName)
+	}
+	attrs, err := p.ctx.GetAttributeValue(p.session, private, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_ID, nil),
+	})
+	if err != nil {
+		return crypto.Address{}, fmt.Errorf("could not get ID of key %s: %v", keyName, err)
+	}
+	return crypto.AddressFromBytes(attrs[0].Value)
+}
+
+// HealthCheck returns nil if the token can still be reached
+func (p *pkcs11KeyClient) HealthCheck() error {
+	p.Lock()
+	defer p.Unlock()
+	_, err := p.ctx.GetSessionInfo(p.session)
+	return err
+}
+
+// Returns the only key of class with attribute
+func (p *pkcs11KeyClient) findKey(class uint, attribute *pkcs11.Attribute) (pkcs11.ObjectHandle, error) {
+	err := p.ctx.FindObjectsInit(p.session, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, class),
+		attribute,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("could not search PKCS#11 token: %v", err)
+	}
+	keys, _, err := p.ctx.FindObjects(p.session, 2)
+	finalErr := p.ctx.FindObjectsFinal(p.session)
+	if err != nil {
+		return 0, fmt.Errorf("could not search PKCS#11 token: %v", err)
+	}
+	if finalErr != nil {
+		return 0, fmt.Errorf("could not search PKCS#11 token: %v", finalErr)
+	}
+	switch len(keys) {
+	case 0:
+		return 0, fmt.Errorf("could not find key %X in PKCS#11 token", attribute.Value)
+	case 1:
+		return keys[0], nil
+	}
+	return 0, fmt.Errorf("more than one key %X in PKCS#11 token", attribute.Value)
+}
+
+func (p *pkcs11KeyClient) curveType(key pkcs11.ObjectHandle) (crypto.CurveType, error) {
+	attrs, err := p.ctx.GetAttributeValue(p.session, key, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, nil),
+		pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, nil),
+	})
+	if err != nil {
+		return crypto.CurveTypeUnset, fmt.Errorf("could not get type of key in PKCS#11 token: %v", err)
+	}
+	// Encoded in the byte order of the platform
+	isKeyType := func(keyType uint) bool {
+		return bytes.Equal(attrs[0].Value, pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, keyType).Value)
+	}
+	switch {
+	case isKeyType(ckkECEdwards) && bytes.Equal(attrs[1].Value, ed25519Params):
+		return crypto.CurveTypeEd25519, nil
+	case isKeyType(pkcs11.CKK_EC) && bytes.Equal(attrs[1].Value, secp256k1Params):
+		return crypto.CurveTypeSecp256k1, nil
+	}
+	return crypto.CurveTypeUnset, fmt.Errorf("key in PKCS#11 token is neither an Ed25519 nor a secp256k1 key")
+}
+
+func (p *pkcs11KeyClient) publicKey(public pkcs11.ObjectHandle) (crypto.PublicKey, error) {
+	curveType, err := p.curveType(public)
+	if err != nil {
+		return crypto.PublicKey{}, err
+	}
+	attrs, err := p.ctx.GetAttributeValue(p.session, public, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_EC_POINT, nil),
+	})
+	if err != nil {
+		return crypto.PublicKey{}, fmt.Errorf("could not get public key from PKCS#11 token: %v", err)
+	}
+	point := attrs[0].Value
+	switch curveType {
+	case crypto.CurveTypeEd25519:
+		point = unwrapPoint(point, crypto.PublicKeyLength(crypto.CurveTypeEd25519))
+	case crypto.CurveTypeSecp256k1:
+		pub, err := btcec.ParsePubKey(unwrapPoint(point, btcec.PubKeyBytesLenUncompressed), btcec.S256())
+		if err != nil {
+			return crypto.PublicKey{}, fmt.Errorf("could not parse secp256k1 public key from PKCS#11 token: %v", err)
+		}
+		point = pub.SerializeCompressed()
+	}
+	return crypto.PublicKeyFromBytes(point, curveType)
+}
+
+// CKA_EC_POINT should hold the point DER encoded as an octet string but some tokens hold it raw
+func unwrapPoint(point []byte, length int) []byte {
+	if len(point) == length {
+		return point
+	}
+	var unwrapped []byte
+	rest, err := asn1.Unmarshal(point, &unwrapped)
+	if err != nil || len(rest) > 0 {
+		return point
+	}
+	return unwrapped
+}
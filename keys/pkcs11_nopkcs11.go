@@ -0,0 +1,16 @@
+// The PKCS#11 module is loaded with cgo, which we do not want to depend on when building a static binary
+// +build !pkcs11
+
+package keys
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/logging"
+)
+
+// NewPKCS11KeyClient fails because burrow has been built without PKCS#11 support
+func NewPKCS11KeyClient(conf *PKCS11Config, logger *logging.Logger) (KeyClient, error) {
+	return nil, fmt.Errorf("burrow has been built without PKCS#11 support. To use keys held in PKCS#11 token %s "+
+		"build with the 'pkcs11' build tag enabled", conf.TokenLabel)
+}
@@ -0,0 +1,131 @@
+// +build pkcs11
+
+package keys
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/miekg/pkcs11"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Tests run against SoftHSM, whose module can be given by SOFTHSM2_MODULE
+const defaultSoftHSMModule = "/usr/lib/softhsm/libsofthsm2.so"
+
+const (
+	testTokenLabel = "burrow"
+	testSOPIN      = "12345678"
+	testPIN        = "1234"
+)
+
+func TestPKCS11KeyClient(t *testing.T) {
+	module, cleanup := initSoftHSM(t)
+	defer cleanup()
+
+	_, err := NewPKCS11KeyClient(&PKCS11Config{Module: module, TokenLabel: "missing", PIN: testPIN},
+		logging.NewNoopLogger())
+	require.Error(t, err)
+
+	kc, err := NewPKCS11KeyClient(&PKCS11Config{Module: module, TokenLabel: testTokenLabel, PIN: testPIN},
+		logging.NewNoopLogger())
+	require.NoError(t, err)
+	require.NoError(t, kc.HealthCheck())
+
+	for _, curveType := range []crypto.CurveType{crypto.CurveTypeEd25519, crypto.CurveTypeSecp256k1} {
+		name := "validator-" + curveType.String()
+		address, err := kc.Generate(name, curveType)
+		require.NoError(t, err)
+
+		named, err := kc.GetAddressForKeyName(name)
+		require.NoError(t, err)
+		assert.Equal(t, address, named)
+
+		publicKey, err := kc.PublicKey(address)
+		require.NoError(t, err)
+		assert.Equal(t, curveType, publicKey.CurveType)
+		assert.Equal(t, address, publicKey.GetAddress())
+
+		// Messages longer than a hash are signed as the rest of burrow signs them
+		for _, message := range [][]byte{[]byte("hello"), make([]byte, 200)} {
+			sig, err := kc.Sign(address, message)
+			require.NoError(t, err)
+			require.NoError(t, publicKey.Verify(message, sig), "%v signature should verify", curveType)
+		}
+
+		signer, err := AddressableSigner(kc, address)
+		require.NoError(t, err)
+		assert.Equal(t, publicKey, signer.GetPublicKey())
+	}
+
+	_, err = kc.GetAddressForKeyName("missing")
+	assert.Error(t, err)
+	_, err = kc.Sign(crypto.Address{1}, []byte("hello"))
+	assert.Error(t, err)
+}
+
+func TestPKCS11PrivateKeysStayInToken(t *testing.T) {
+	module, cleanup := initSoftHSM(t)
+	defer cleanup()
+
+	kc, err := NewPKCS11KeyClient(&PKCS11Config{Module: module, TokenLabel: testTokenLabel, PIN: testPIN},
+		logging.NewNoopLogger())
+	require.NoError(t, err)
+	address, err := kc.Generate("treasury", crypto.CurveTypeSecp256k1)
+	require.NoError(t, err)
+
+	p := kc.(*pkcs11KeyClient)
+	private, err := p.findKey(pkcs11.CKO_PRIVATE_KEY, pkcs11.NewAttribute(pkcs11.CKA_ID, address.Bytes()))
+	require.NoError(t, err)
+	_, err = p.ctx.GetAttributeValue(p.session, private, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_VALUE, nil),
+	})
+	assert.Equal(t, pkcs11.Error(pkcs11.CKR_ATTRIBUTE_SENSITIVE), err)
+}
+
+// Creates a SoftHSM token in a temporary directory
+func initSoftHSM(t *testing.T) (string, func()) {
+	module := os.Getenv("SOFTHSM2_MODULE")
+	if module == "" {
+		module = defaultSoftHSMModule
+	}
+	dir, err := ioutil.TempDir("", "softhsm")
+	require.NoError(t, err)
+	tokenDir := filepath.Join(dir, "tokens")
+	require.NoError(t, os.Mkdir(tokenDir, 0700))
+	conf := filepath.Join(dir, "softhsm2.conf")
+	require.NoError(t, ioutil.WriteFile(conf,
+		[]byte(fmt.Sprintf("directories.tokendir = %s\nobjectstore.backend = file\n", tokenDir)), 0600))
+	confEnv := os.Getenv("SOFTHSM2_CONF")
+	require.NoError(t, os.Setenv("SOFTHSM2_CONF", conf))
+
+	ctx := pkcs11.New(module)
+	require.NotNil(t, ctx, "could not load SoftHSM module %s, set SOFTHSM2_MODULE", module)
+	require.NoError(t, ctx.Initialize())
+	slots, err := ctx.GetSlotList(false)
+	require.NoError(t, err)
+	require.NotEmpty(t, slots)
+	require.NoError(t, ctx.InitToken(slots[0], testSOPIN, testTokenLabel))
+	// The token is given a new slot once initialised
+	slot, err := findSlot(ctx, testTokenLabel)
+	require.NoError(t, err)
+	session, err := ctx.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+	require.NoError(t, err)
+	require.NoError(t, ctx.Login(session, pkcs11.CKU_SO, testSOPIN))
+	require.NoError(t, ctx.InitPIN(session, testPIN))
+	require.NoError(t, ctx.Logout(session))
+	require.NoError(t, ctx.CloseSession(session))
+	require.NoError(t, ctx.Finalize())
+	ctx.Destroy()
+
+	return module, func() {
+		os.Setenv("SOFTHSM2_CONF", confEnv)
+		os.RemoveAll(dir)
+	}
+}
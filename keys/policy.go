@@ -0,0 +1,295 @@
+package keys
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/structure"
+	hex "github.com/tmthrgd/go-hex"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const anyValue = "*"
+
+// Methods that use or change keys, which are always audited
+var auditedMethods = map[string]bool{
+	"Sign":          true,
+	"SignConsensus": true,
+	"Export":        true,
+	"Import":        true,
+	"ImportJSON":    true,
+	"GenerateKey":   true,
+	"GenerateSeed":  true,
+	"AddName":       true,
+	"RemoveName":    true,
+}
+
+// Requests that use a single key, given by name or address
+type keyRequest interface {
+	GetName() string
+	GetAddress() string
+}
+
+// Checks each request against the policies of the client making it and writes an audit log
+type authoriser struct {
+	sync.Mutex
+	ks       *KeyStore
+	policies []*Policy
+	// The addresses of the keys each policy allows, resolved once so that moving a name cannot change them
+	policyKeys []map[string]bool
+	audit      *logging.Logger
+	// Sign rate limiters by policy and key
+	limiters map[string]*rate.Limiter
+}
+
+func newAuthoriser(ks *KeyStore, policies []*Policy, audit *logging.Logger) (*authoriser, error) {
+	a := &authoriser{
+		ks:         ks,
+		policies:   policies,
+		policyKeys: make([]map[string]bool, len(policies)),
+		audit:      audit,
+		limiters:   make(map[string]*rate.Limiter),
+	}
+	for i, policy := range policies {
+		a.policyKeys[i] = make(map[string]bool)
+		for _, key := range policy.Keys {
+			if key == anyValue {
+				a.policyKeys[i][anyValue] = true
+				continue
+			}
+			address, err := a.keyAddress(key, "")
+			if err != nil {
+				address, err = a.keyAddress("", key)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("policy %d allows key %s, which is neither the name of a key nor an address",
+					i, key)
+			}
+			a.policyKeys[i][address] = true
+		}
+	}
+	return a, nil
+}
+
+func validatePolicies(policies []*Policy, mutualTLS bool) error {
+	methods := make(map[string]bool)
+	for _, method := range _Keys_serviceDesc.Methods {
+		methods[method.MethodName] = true
+	}
+	for i, policy := range policies {
+		if policy.Client == "" {
+			return fmt.Errorf("policy %d should name a Client, or * for any client", i)
+		}
+		if policy.Client != anyValue && !mutualTLS {
+			return fmt.Errorf("policy %d is for client %s but clients can only be identified with mutual TLS, "+
+				"set ClientCAFile", i, policy.Client)
+		}
+		for _, method := range policy.Methods {
+			if method != anyValue && !methods[method] {
+				return fmt.Errorf("policy %d allows unknown method %s", i, method)
+			}
+		}
+		if policy.SignRate < 0 || policy.SignBurst < 0 {
+			return fmt.Errorf("policy %d has a negative SignRate or SignBurst", i)
+		}
+	}
+	return nil
+}
+
+func (a *authoriser) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	method := info.FullMethod[strings.LastIndex(info.FullMethod, "/")+1:]
+	client := clientName(ctx)
+	keyvals := []interface{}{"client", client, "method", method}
+
+	keyName, addresses, isKeyRequest := a.requestKeys(req)
+	if isKeyRequest {
+		keyvals = append(keyvals, "key_name", keyName)
+	}
+	address := strings.Join(addresses, ",")
+	// Deriving a key from a seed uses the seed as much as signing uses a key
+	seed := ""
+	if gr, ok := req.(*GenRequest); ok {
+		seed = gr.GetSeed()
+	}
+	if seed != "" {
+		keyvals = append(keyvals, "seed", seed)
+	}
+	if sr, ok := req.(*SignRequest); ok {
+		hash := sha256.Sum256(sr.GetMessage())
+		keyvals = append(keyvals, "message_sha256", hex.EncodeUpperToString(hash[:]))
+	}
+	if sr, ok := req.(*SignConsensusRequest); ok {
+		hash := sha256.Sum256(sr.GetSignBytes())
+		keyvals = append(keyvals, "message_sha256", hex.EncodeUpperToString(hash[:]))
+	}
+
+	code, err := a.authorise(client, method, addresses, isKeyRequest, seed)
+	if err != nil {
+		a.audit.InfoMsg("keys request denied", append(keyvals, "address", address, structure.ErrorKey, err)...)
+		return nil, status.Error(code, err.Error())
+	}
+
+	resp, err := handler(ctx, req)
+	if auditedMethods[method] {
+		if address == "" {
+			// The key generated or imported
+			if ar, ok := resp.(interface{ GetAddress() string }); ok && err == nil {
+				address = ar.GetAddress()
+			}
+		}
+		keyvals = append(keyvals, "address", address)
+		if err != nil {
+			a.audit.InfoMsg("keys request failed", append(keyvals, structure.ErrorKey, err)...)
+		} else {
+			a.audit.InfoMsg("keys request", keyvals...)
+		}
+	}
+	return resp, err
+}
+
+// Returns the name and addresses of the keys a request uses, or whose names it changes, and whether it is a request
+// that must be checked against the keys a policy allows
+func (a *authoriser) requestKeys(req interface{}) (string, []string, bool) {
+	// Unknown keys are left to the handler to report
+	switch r := req.(type) {
+	case keyRequest:
+		address, _ := a.keyAddress(r.GetName(), r.GetAddress())
+		return r.GetName(), []string{address}, true
+	case *AddNameRequest:
+		// Both the key the name will point at and any key it points at now
+		address, _ := a.keyAddress("", r.GetAddress())
+		return r.GetKeyname(), append([]string{address}, a.namedKey(r.GetKeyname())...), true
+	case *RemoveNameRequest:
+		address, _ := a.keyAddress(r.GetKeyName(), "")
+		return r.GetKeyName(), []string{address}, true
+	case *ImportRequest:
+		// Importing or generating a key under an existing name moves the name from the key it points at now
+		addresses := a.namedKey(r.GetName())
+		return r.GetName(), addresses, len(addresses) > 0
+	case *GenRequest:
+		addresses := a.namedKey(r.GetKeyName())
+		return r.GetKeyName(), addresses, len(addresses) > 0
+	}
+	return "", nil, false
+}
+
+// Returns the address of the key with name, if there is one
+func (a *authoriser) namedKey(name string) []string {
+	if name == "" {
+		return nil
+	}
+	address, err := a.keyAddress(name, "")
+	if err != nil {
+		return nil
+	}
+	return []string{address}
+}
+
+// Returns nil if a policy allows client to call method with the keys at addresses, and any seed it derives a key
+// from, or the code to deny it with
+func (a *authoriser) authorise(client, method string, addresses []string, isKeyRequest bool,
+	seed string) (codes.Code, error) {
+	if len(a.policies) == 0 {
+		return codes.OK, nil
+	}
+	address := strings.Join(addresses, ",")
+	for i, policy := range a.policies {
+		if policy.Client != anyValue && policy.Client != client {
+			continue
+		}
+		if !contains(policy.Methods, method) {
+			continue
+		}
+		if isKeyRequest && !a.allowsKeys(i, addresses) {
+			continue
+		}
+		if seed != "" && !contains(policy.Seeds, seed) {
+			continue
+		}
+		if (method == "Sign" || method == "SignConsensus") && policy.SignRate > 0 &&
+			!a.limiter(i, policy, address).Allow() {
+			return codes.ResourceExhausted, fmt.Errorf("client '%s' has exceeded its rate of %v signatures per "+
+				"second with key %s", client, policy.SignRate, address)
+		}
+		return codes.OK, nil
+	}
+	denied := fmt.Sprintf("client '%s' may not call %s", client, method)
+	if isKeyRequest {
+		denied += " with key " + address
+	}
+	if seed != "" {
+		denied += " from seed " + seed
+	}
+	return codes.PermissionDenied, fmt.Errorf("%s", denied)
+}
+
+// Policies allow keys by the addresses their names had when the server started, since names may be moved
+func (a *authoriser) allowsKeys(i int, addresses []string) bool {
+	for _, address := range addresses {
+		if address == "" || !a.policyKeys[i][anyValue] && !a.policyKeys[i][address] {
+			return false
+		}
+	}
+	return true
+}
+
+func (a *authoriser) keyAddress(name, address string) (string, error) {
+	addr, err := getNameAddr(a.ks.keysDirPath, name, address)
+	if err != nil {
+		return "", err
+	}
+	parsed, err := crypto.AddressFromHexString(addr)
+	if err != nil {
+		return "", err
+	}
+	return parsed.String(), nil
+}
+
+func (a *authoriser) limiter(i int, policy *Policy, address string) *rate.Limiter {
+	a.Lock()
+	defer a.Unlock()
+	id := fmt.Sprintf("%d/%s", i, address)
+	limiter, ok := a.limiters[id]
+	if !ok {
+		burst := policy.SignBurst
+		if burst == 0 {
+			burst = 1
+		}
+		limiter = rate.NewLimiter(rate.Limit(policy.SignRate), burst)
+		a.limiters[id] = limiter
+	}
+	return limiter
+}
+
+// Clients are named by the common name of their verified certificate
+func clientName(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return ""
+	}
+	return tlsInfo.State.VerifiedChains[0][0].Subject.CommonName
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == anyValue || v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,134 @@
+package keys
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/keys/hd"
+	hex "github.com/tmthrgd/go-hex"
+)
+
+// A seed from which keys are derived, stored under its name in the seeds directory
+type seedJSON struct {
+	Name string
+	Seed privateKeyJSON
+}
+
+// StoreSeed stores seed under name, encrypted if passphrase is not empty. Seeds cannot be overwritten since keys
+// derived from them would be lost.
+func (ks *KeyStore) StoreSeed(passphrase, name string, seed []byte) error {
+	ks.Lock()
+	defer ks.Unlock()
+	seedFile, err := ks.seedFile(name)
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(seedFile); err == nil {
+		return fmt.Errorf("seed %s already exists", name)
+	}
+	seedStruct := seedJSON{Name: name}
+	if passphrase != "" {
+		seedStruct.Seed, err = encrypt(passphrase, seed)
+		if err != nil {
+			return err
+		}
+	} else {
+		seedStruct.Seed = privateKeyJSON{Crypto: CryptoNone, Plain: hex.EncodeUpperToString(seed)}
+	}
+	bs, err := json.Marshal(seedStruct)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(seedFile, bs, 0600)
+}
+
+func (ks *KeyStore) GetSeed(passphrase, name string) ([]byte, error) {
+	ks.Lock()
+	defer ks.Unlock()
+	seedFile, err := ks.seedFile(name)
+	if err != nil {
+		return nil, err
+	}
+	fileInfo, err := os.Stat(seedFile)
+	if err != nil {
+		return nil, fmt.Errorf("unknown seed %s", name)
+	}
+	if (uint32(fileInfo.Mode())&0077) != 0 && !ks.AllowBadFilePermissions {
+		return nil, fmt.Errorf("file %s should be accessible by user only", seedFile)
+	}
+	bs, err := ioutil.ReadFile(seedFile)
+	if err != nil {
+		return nil, err
+	}
+	seedStruct := new(seedJSON)
+	err = json.Unmarshal(bs, seedStruct)
+	if err != nil {
+		return nil, err
+	}
+	if seedStruct.Seed.Crypto == CryptoNone {
+		return hex.DecodeString(seedStruct.Seed.Plain)
+	}
+	seed, err := decrypt(passphrase, seedStruct.Seed)
+	if err != nil {
+		return nil, fmt.Errorf("could not decrypt seed %s: %v", name, err)
+	}
+	return seed, nil
+}
+
+// DeriveKey derives the key at path from the seed called seedName and stores it encrypted with passphrase, which
+// must also be the passphrase of the seed. Deriving the same key again stores the same key.
+func (ks *KeyStore) DeriveKey(passphrase, seedName string, curveType crypto.CurveType, path hd.Path) (*Key, error) {
+	seed, err := ks.GetSeed(passphrase, seedName)
+	if err != nil {
+		return nil, err
+	}
+	privateKey, err := hd.DeriveKey(seed, curveType, path)
+	if err != nil {
+		return nil, err
+	}
+	key, err := NewKeyFromPriv(curveType, privateKey.RawBytes())
+	if err != nil {
+		return nil, err
+	}
+	key.Seed = seedName
+	key.Path = path.String()
+	return key, ks.StoreKey(passphrase, key)
+}
+
+// Returns the seed and path a key was derived from without decrypting it, both are empty for keys not derived
+func (ks *KeyStore) keyDerivation(address string) (seed, path string, err error) {
+	dataDirPath, err := returnDataDir(ks.keysDirPath)
+	if err != nil {
+		return "", "", err
+	}
+	addr, err := crypto.AddressFromHexString(address)
+	if err != nil {
+		return "", "", err
+	}
+	fileContent, err := ks.GetKeyFile(dataDirPath, addr.Bytes())
+	if err != nil {
+		return "", "", err
+	}
+	key := new(keyJSON)
+	err = json.Unmarshal(fileContent, key)
+	if err != nil {
+		return "", "", err
+	}
+	return key.Seed, key.Path, nil
+}
+
+func (ks *KeyStore) seedFile(name string) (string, error) {
+	if name == "" || strings.ContainsAny(name, `/\`) || name == "." || name == ".." {
+		return "", fmt.Errorf("invalid seed name '%s'", name)
+	}
+	seedsDir, err := returnSeedsDir(ks.keysDirPath)
+	if err != nil {
+		return "", err
+	}
+	return path.Join(seedsDir, name+".json"), nil
+}
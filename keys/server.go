@@ -3,15 +3,24 @@ package keys
 import (
 	"context"
 	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"fmt"
 	"hash"
+	"io/ioutil"
 	"strings"
 
+	"github.com/go-kit/kit/log"
 	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/keys/hd"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/loggers"
+	"github.com/hyperledger/burrow/logging/structure"
 	hex "github.com/tmthrgd/go-hex"
 	"golang.org/x/crypto/ripemd160"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 //------------------------------------------------------------------------
@@ -24,6 +33,75 @@ func StandAloneServer(keysDir string, AllowBadFilePermissions bool) *grpc.Server
 	return grpcServer
 }
 
+// NewServer returns a standalone keys server that serves TLS, authorises clients by their certificates against
+// policies and writes an audit log as set in conf.Server, or serves any client in the clear when it is nil
+func NewServer(conf *KeysConfig, logger *logging.Logger) (*grpc.Server, error) {
+	ks := NewKeyStore(conf.KeysDirectory, conf.AllowBadFilePermissions)
+	serverConf := conf.Server
+	if serverConf == nil {
+		serverConf = new(ServerConfig)
+	}
+	var opts []grpc.ServerOption
+	mutualTLS := serverConf.ClientCAFile != ""
+	if serverConf.TLSCertFile != "" || serverConf.TLSKeyFile != "" || mutualTLS {
+		tlsConfig, err := serverTLSConfig(serverConf)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	err := validatePolicies(serverConf.Policies, mutualTLS)
+	if err != nil {
+		return nil, err
+	}
+	audit := logger.WithScope("KeysAudit")
+	if serverConf.AuditLog != "" {
+		fileLogger, err := loggers.NewFileLogger(serverConf.AuditLog, loggers.JSONFormat)
+		if err != nil {
+			return nil, fmt.Errorf("could not open keys audit log: %v", err)
+		}
+		audit = logging.NewLogger(fileLogger).With(structure.TimeKey, log.DefaultTimestampUTC)
+	}
+	if len(serverConf.Policies) == 0 {
+		logger.InfoMsg("Keys server has no policies so any client that can connect may use any key")
+	}
+	auth, err := newAuthoriser(ks, serverConf.Policies, audit)
+	if err != nil {
+		return nil, err
+	}
+	opts = append(opts, grpc.UnaryInterceptor(auth.unaryInterceptor))
+	grpcServer := grpc.NewServer(opts...)
+	RegisterKeysServer(grpcServer, ks)
+	return grpcServer, nil
+}
+
+func serverTLSConfig(conf *ServerConfig) (*tls.Config, error) {
+	if conf.TLSCertFile == "" || conf.TLSKeyFile == "" {
+		return nil, fmt.Errorf("both TLSCertFile and TLSKeyFile are needed to serve TLS")
+	}
+	cert, err := tls.LoadX509KeyPair(conf.TLSCertFile, conf.TLSKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("could not load keys server TLS certificate: %v", err)
+	}
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if conf.ClientCAFile != "" {
+		caPEM, err := ioutil.ReadFile(conf.ClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("could not read client CAs: %v", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("no certificates found in client CA file %s", conf.ClientCAFile)
+		}
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return tlsConfig, nil
+}
+
 //------------------------------------------------------------------------
 // handlers
 
@@ -33,9 +111,24 @@ func (k *KeyStore) GenerateKey(ctx context.Context, in *GenRequest) (*GenRespons
 		return nil, err
 	}
 
-	key, err := k.Gen(in.Passphrase, curveT)
-	if err != nil {
-		return nil, fmt.Errorf("error generating key %s %s", curveT, err)
+	var key *Key
+	if in.Seed != "" {
+		path, err := hd.ParsePath(in.Path)
+		if err != nil {
+			return nil, err
+		}
+		key, err = k.DeriveKey(in.Passphrase, in.Seed, curveT, path)
+		if err != nil {
+			return nil, fmt.Errorf("error deriving key %s from seed %s: %v", curveT, in.Seed, err)
+		}
+	} else {
+		if in.Path != "" {
+			return nil, fmt.Errorf("a seed must be given to derive key at path %s", in.Path)
+		}
+		key, err = k.Gen(in.Passphrase, curveT)
+		if err != nil {
+			return nil, fmt.Errorf("error generating key %s %s", curveT, err)
+		}
 	}
 
 	addrH := key.Address.String()
@@ -49,6 +142,32 @@ func (k *KeyStore) GenerateKey(ctx context.Context, in *GenRequest) (*GenRespons
 	return &GenResponse{Address: addrH}, nil
 }
 
+func (k *KeyStore) GenerateSeed(ctx context.Context, in *GenSeedRequest) (*GenSeedResponse, error) {
+	resp := new(GenSeedResponse)
+	mnemonic := in.Mnemonic
+	if mnemonic == "" {
+		entropyBits := int(in.EntropyBits)
+		if entropyBits == 0 {
+			entropyBits = hd.DefaultEntropyBits
+		}
+		var err error
+		mnemonic, err = hd.NewMnemonic(entropyBits)
+		if err != nil {
+			return nil, err
+		}
+		resp.Mnemonic = mnemonic
+	}
+	seed, err := hd.SeedFromMnemonic(mnemonic, in.MnemonicPassphrase)
+	if err != nil {
+		return nil, err
+	}
+	err = k.StoreSeed(in.Passphrase, in.Name, seed)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 func (k *KeyStore) Export(ctx context.Context, in *ExportRequest) (*ExportResponse, error) {
 	addr, err := getNameAddr(k.keysDirPath, in.GetName(), in.GetAddress())
 
@@ -161,6 +280,17 @@ func (k *KeyStore) Hash(ctx context.Context, in *HashRequest) (*HashResponse, er
 
 func (k *KeyStore) ImportJSON(ctx context.Context, in *ImportJSONRequest) (*ImportResponse, error) {
 	keyJSON := []byte(in.GetJSON())
+	// Ethereum key files would otherwise be mistaken for our own since JSON field names are matched case-insensitively
+	if IsEthereumKeyJSON(keyJSON) {
+		key, err := DecryptEthereumKey(in.GetPassphrase(), keyJSON)
+		if err != nil {
+			return nil, err
+		}
+		if err = k.StoreKey(in.GetPassphrase(), key); err != nil {
+			return nil, err
+		}
+		return &ImportResponse{Address: hex.EncodeUpperToString(key.Address[:])}, nil
+	}
 	addr := IsValidKeyJson(keyJSON)
 	if addr != nil {
 		_, err := writeKey(k.keysDirPath, addr, keyJSON)
@@ -269,7 +399,18 @@ func (k *KeyStore) List(ctx context.Context, in *ListRequest) (*ListResponse, er
 		}
 	}
 
-	return &ListResponse{Key: list}, nil
+	filtered := list[:0]
+	for _, keyID := range list {
+		keyID.Seed, keyID.Path, err = k.keyDerivation(keyID.Address)
+		if err != nil {
+			return nil, err
+		}
+		if in.Seed == "" || in.Seed == keyID.Seed {
+			filtered = append(filtered, keyID)
+		}
+	}
+
+	return &ListResponse{Key: filtered}, nil
 }
 
 func getAddressNames(address string, byname map[string]string) []string {
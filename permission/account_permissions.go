@@ -66,9 +66,36 @@ func (ap *AccountPermissions) Clone() AccountPermissions {
 		// strings are immutable so copy suffices
 		copy(rolesClone, ap.Roles)
 	}
+	var callACLClone []CallGrant
+	if len(ap.CallACL) > 0 {
+		callACLClone = make([]CallGrant, len(ap.CallACL))
+		for i, grant := range ap.CallACL {
+			callACLClone[i] = CallGrant{
+				Role:     grant.Role,
+				Function: append([]byte(nil), grant.Function...),
+			}
+			if grant.Address != nil {
+				address := *grant.Address
+				callACLClone[i].Address = &address
+			}
+		}
+	}
+	var grantsClone []PermissionGrant
+	if len(ap.Grants) > 0 {
+		grantsClone = make([]PermissionGrant, len(ap.Grants))
+		for i, grant := range ap.Grants {
+			grantsClone[i] = grant
+			if grant.Grantor != nil {
+				grantor := *grant.Grantor
+				grantsClone[i].Grantor = &grantor
+			}
+		}
+	}
 
 	return AccountPermissions{
-		Base:  basePermissionsClone,
-		Roles: rolesClone,
+		Base:    basePermissionsClone,
+		Roles:   rolesClone,
+		CallACL: callACLClone,
+		Grants:  grantsClone,
 	}
 }
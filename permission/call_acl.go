@@ -0,0 +1,101 @@
+package permission
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/hyperledger/burrow/crypto"
+)
+
+// The length of the function selector at the start of contract call data
+const functionSelectorLength = 4
+
+// Grants address the right to call a contract's function with selector, or any of its functions if function is empty
+func AccountCallGrant(address crypto.Address, function []byte) CallGrant {
+	return CallGrant{
+		Address:  &address,
+		Function: function,
+	}
+}
+
+// Grants the holders of role the right to call a contract's function with selector, or any of its functions if
+// function is empty
+func RoleCallGrant(role string, function []byte) CallGrant {
+	return CallGrant{
+		Role:     role,
+		Function: function,
+	}
+}
+
+func (cg CallGrant) EnsureValid() error {
+	if (cg.Address == nil) == (cg.Role == "") {
+		return fmt.Errorf("call grant must be to exactly one of an account or a role")
+	}
+	if len(cg.Function) != 0 && len(cg.Function) != functionSelectorLength {
+		return fmt.Errorf("call grant function selector must be %d bytes but is %d bytes", functionSelectorLength,
+			len(cg.Function))
+	}
+	return nil
+}
+
+// Returns true if the grant permits caller, which has callerPerms, to call a contract with input
+func (cg CallGrant) Permits(caller crypto.Address, callerPerms AccountPermissions, input []byte) bool {
+	if cg.Address != nil {
+		if *cg.Address != caller {
+			return false
+		}
+	} else if !callerPerms.HasRole(cg.Role) {
+		return false
+	}
+	if len(cg.Function) == 0 {
+		return true
+	}
+	return len(input) >= functionSelectorLength && bytes.Equal(cg.Function, input[:functionSelectorLength])
+}
+
+func (cg CallGrant) sameGrant(other CallGrant) bool {
+	if (cg.Address == nil) != (other.Address == nil) {
+		return false
+	}
+	if cg.Address != nil && *cg.Address != *other.Address {
+		return false
+	}
+	return cg.Role == other.Role && bytes.Equal(cg.Function, other.Function)
+}
+
+// Returns true if the account has a call ACL, in which case only the accounts and roles it grants may call it
+func (ap AccountPermissions) HasCallACL() bool {
+	return len(ap.CallACL) > 0
+}
+
+// Returns true if some grant in the call ACL permits caller, which has callerPerms, to call the account with input
+func (ap AccountPermissions) PermitsCall(caller crypto.Address, callerPerms AccountPermissions, input []byte) bool {
+	for _, grant := range ap.CallACL {
+		if grant.Permits(caller, callerPerms, input) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns true if the grant is added to the call ACL, and false if it already exists
+func (ap *AccountPermissions) AddCallGrant(grant CallGrant) bool {
+	for _, cg := range ap.CallACL {
+		if cg.sameGrant(grant) {
+			return false
+		}
+	}
+	ap.CallACL = append(ap.CallACL, grant)
+	return true
+}
+
+// Returns true if the grant is removed from the call ACL, and false if it is not found
+func (ap *AccountPermissions) RemoveCallGrant(grant CallGrant) bool {
+	for i, cg := range ap.CallACL {
+		if cg.sameGrant(grant) {
+			ap.CallACL = append(ap.CallACL[:i:i], ap.CallACL[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
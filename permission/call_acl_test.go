@@ -0,0 +1,51 @@
+package permission
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountPermissions_PermitsCall(t *testing.T) {
+	caller := crypto.Address{1}
+	partner := AccountPermissions{}
+	partner.AddRole("partner")
+	transfer := []byte{1, 2, 3, 4}
+	input := append(transfer, 0, 0, 0, 42)
+
+	ap := AccountPermissions{}
+	assert.False(t, ap.HasCallACL())
+	assert.True(t, ap.AddCallGrant(RoleCallGrant("partner", transfer)))
+	assert.False(t, ap.AddCallGrant(RoleCallGrant("partner", transfer)))
+	assert.True(t, ap.HasCallACL())
+
+	assert.True(t, ap.PermitsCall(caller, partner, input))
+	assert.False(t, ap.PermitsCall(caller, partner, []byte{4, 3, 2, 1}))
+	assert.False(t, ap.PermitsCall(caller, partner, nil))
+	assert.False(t, ap.PermitsCall(caller, AccountPermissions{}, input))
+
+	assert.True(t, ap.AddCallGrant(AccountCallGrant(caller, nil)))
+	assert.True(t, ap.PermitsCall(caller, AccountPermissions{}, nil))
+	assert.False(t, ap.PermitsCall(crypto.Address{2}, AccountPermissions{}, input))
+
+	clone := ap.Clone()
+	assert.True(t, ap.RemoveCallGrant(AccountCallGrant(caller, nil)))
+	assert.False(t, ap.RemoveCallGrant(AccountCallGrant(caller, nil)))
+	assert.False(t, ap.PermitsCall(caller, AccountPermissions{}, nil))
+	assert.True(t, clone.PermitsCall(caller, AccountPermissions{}, nil))
+}
+
+func TestCallGrant_EnsureValid(t *testing.T) {
+	assert.NoError(t, AccountCallGrant(crypto.Address{1}, nil).EnsureValid())
+	assert.NoError(t, RoleCallGrant("partner", []byte{1, 2, 3, 4}).EnsureValid())
+	assert.Error(t, RoleCallGrant("", nil).EnsureValid())
+	assert.Error(t, RoleCallGrant("partner", []byte{1, 2, 3}).EnsureValid())
+	grant := AccountCallGrant(crypto.Address{1}, nil)
+	grant.Role = "partner"
+	assert.Error(t, grant.EnsureValid())
+
+	pa := GrantCallArgs(crypto.Address{2}, RoleCallGrant("partner", []byte{1, 2, 3, 4}))
+	assert.NoError(t, pa.EnsureValid())
+	assert.Equal(t, RoleCallGrant("partner", []byte{1, 2, 3, 4}), pa.CallGrant())
+}
@@ -0,0 +1,86 @@
+package permission
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/crypto"
+)
+
+// Grants perms until the block at expiryHeight or the first block whose time reaches expiryTime (a Unix time in
+// seconds), whichever comes first - a zero expiry is not checked
+func TemporaryGrant(perms PermFlag, expiryHeight uint64, expiryTime int64) PermissionGrant {
+	return PermissionGrant{
+		Perms:        perms,
+		ExpiryHeight: expiryHeight,
+		ExpiryTime:   expiryTime,
+	}
+}
+
+// Grants perms on behalf of grantor until the block at expiryHeight or the first block whose time reaches expiryTime
+func DelegatedGrant(grantor crypto.Address, perms PermFlag, expiryHeight uint64, expiryTime int64) PermissionGrant {
+	grant := TemporaryGrant(perms, expiryHeight, expiryTime)
+	grant.Grantor = &grantor
+	return grant
+}
+
+func (pg PermissionGrant) EnsureValid() error {
+	if pg.Perms == 0 || !pg.Perms.IsValid() {
+		return fmt.Errorf("permission grant must grant valid permissions but grants %v", pg.Perms)
+	}
+	if pg.ExpiryHeight == 0 && pg.ExpiryTime == 0 {
+		return fmt.Errorf("permission grant must expire at a block height or time")
+	}
+	return nil
+}
+
+// Returns true if the grant no longer applies in the block at blockHeight with blockTime (a Unix time in seconds)
+func (pg PermissionGrant) Expired(blockHeight uint64, blockTime int64) bool {
+	return (pg.ExpiryHeight != 0 && blockHeight >= pg.ExpiryHeight) ||
+		(pg.ExpiryTime != 0 && blockTime >= pg.ExpiryTime)
+}
+
+// Returns the base permissions in effect in the block at blockHeight with blockTime, that is the account's base
+// permissions with the permissions of its unexpired grants set
+func (ap AccountPermissions) BaseAt(blockHeight uint64, blockTime int64) BasePermissions {
+	base := ap.Base
+	for _, grant := range ap.Grants {
+		if !grant.Expired(blockHeight, blockTime) {
+			base.Perms |= grant.Perms
+			base.SetBit |= grant.Perms
+		}
+	}
+	return base
+}
+
+// Returns the grants that have not expired by the block at blockHeight with blockTime
+func (ap AccountPermissions) GrantsAt(blockHeight uint64, blockTime int64) []PermissionGrant {
+	var grants []PermissionGrant
+	for _, grant := range ap.Grants {
+		if !grant.Expired(blockHeight, blockTime) {
+			grants = append(grants, grant)
+		}
+	}
+	return grants
+}
+
+// Adds grant, dropping any grants that have expired by the block at blockHeight with blockTime
+func (ap *AccountPermissions) AddGrant(grant PermissionGrant, blockHeight uint64, blockTime int64) {
+	ap.Grants = append(ap.GrantsAt(blockHeight, blockTime), grant)
+}
+
+// Removes perms from every grant, dropping grants left with no permissions, and returns true if any grant changed
+func (ap *AccountPermissions) RevokeGrants(perms PermFlag) bool {
+	var grants []PermissionGrant
+	revoked := false
+	for _, grant := range ap.Grants {
+		if grant.Perms&perms != 0 {
+			revoked = true
+			grant.Perms &^= perms
+		}
+		if grant.Perms != 0 {
+			grants = append(grants, grant)
+		}
+	}
+	ap.Grants = grants
+	return revoked
+}
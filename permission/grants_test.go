@@ -0,0 +1,84 @@
+package permission
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPermissionGrant_Expired(t *testing.T) {
+	byHeight := TemporaryGrant(Call, 10, 0)
+	assert.False(t, byHeight.Expired(9, 1<<40))
+	assert.True(t, byHeight.Expired(10, 0))
+
+	byTime := TemporaryGrant(Call, 0, 1000)
+	assert.False(t, byTime.Expired(1<<40, 999))
+	assert.True(t, byTime.Expired(0, 1000))
+
+	both := TemporaryGrant(Call, 10, 1000)
+	assert.False(t, both.Expired(9, 999))
+	assert.True(t, both.Expired(10, 999))
+	assert.True(t, both.Expired(9, 1000))
+}
+
+func TestAccountPermissions_BaseAt(t *testing.T) {
+	ap := NewAccountPermissions(Send)
+	ap.Base.Set(Call, false)
+	ap.AddGrant(TemporaryGrant(Call|Name, 10, 0), 1, 0)
+	ap.AddGrant(DelegatedGrant(crypto.Address{1}, CreateContract, 0, 1000), 1, 0)
+
+	base := ap.BaseAt(9, 999)
+	for _, perm := range []PermFlag{Send, Call, Name, CreateContract} {
+		v, err := base.Get(perm)
+		assert.NoError(t, err)
+		assert.True(t, v, "%v should be granted", perm)
+	}
+	assert.Len(t, ap.GrantsAt(9, 999), 2)
+
+	base = ap.BaseAt(10, 999)
+	v, err := base.Get(Call)
+	assert.NoError(t, err)
+	assert.False(t, v)
+	_, err = base.Get(Name)
+	assert.Error(t, err, "Name falls back to global permissions once the grant expires")
+	assert.Equal(t, []PermissionGrant{DelegatedGrant(crypto.Address{1}, CreateContract, 0, 1000)}, ap.GrantsAt(10, 999))
+
+	// Adding a grant drops those that have expired
+	ap.AddGrant(TemporaryGrant(Bond, 20, 0), 15, 0)
+	assert.Equal(t, []PermissionGrant{
+		DelegatedGrant(crypto.Address{1}, CreateContract, 0, 1000),
+		TemporaryGrant(Bond, 20, 0),
+	}, ap.Grants)
+}
+
+func TestAccountPermissions_RevokeGrants(t *testing.T) {
+	ap := AccountPermissions{}
+	ap.AddGrant(TemporaryGrant(Call|Name, 10, 0), 1, 0)
+	ap.AddGrant(TemporaryGrant(Call, 10, 0), 1, 0)
+	clone := ap.Clone()
+
+	assert.False(t, ap.RevokeGrants(Send))
+	assert.True(t, ap.RevokeGrants(Call))
+	assert.Equal(t, []PermissionGrant{TemporaryGrant(Name, 10, 0)}, ap.Grants)
+	assert.Len(t, clone.Grants, 2)
+}
+
+func TestPermArgs_EnsureValidExpiry(t *testing.T) {
+	address := crypto.Address{1}
+	assert.NoError(t, SetBaseUntilArgs(address, Call, 10, 0).EnsureValid())
+	assert.NoError(t, DelegateArgs(address, Call, 0, 1000).EnsureValid())
+	assert.Error(t, DelegateArgs(address, Call, 0, 0).EnsureValid())
+
+	unset := SetBaseUntilArgs(address, Call, 10, 0)
+	value := false
+	unset.Value = &value
+	assert.Error(t, unset.EnsureValid())
+
+	unsetBase := UnsetBaseArgs(address, Call)
+	unsetBase.ExpiryHeight = unset.ExpiryHeight
+	assert.Error(t, unsetBase.EnsureValid())
+
+	grantor := crypto.Address{2}
+	assert.Equal(t, DelegatedGrant(grantor, Call, 0, 1000), DelegateArgs(address, Call, 0, 1000).Grant(&grantor))
+}
@@ -50,8 +50,16 @@ const (
 	HasRole
 	AddRole
 	RemoveRole
+	// Call ACL moderator permissions permit changing and querying the accounts and roles that may call a particular
+	// contract - see CallGrant
+	GrantCall
+	RevokeCall
+	CanCall
+	// Delegate permits granting, until an expiry height or time, chain permissions the account itself holds to other
+	// accounts - see PermissionGrant
+	Delegate
 
-	NumPermissions uint = 17 // NOTE Adjust this too. We can support upto 64
+	NumPermissions uint = 21 // NOTE Adjust this too. We can support upto 64
 
 	TopPermFlag      PermFlag = 1 << (NumPermissions - 1)
 	AllPermFlags     PermFlag = TopPermFlag | (TopPermFlag - 1)
@@ -77,6 +85,10 @@ const (
 	HasRoleString    = "hasRole"
 	AddRoleString    = "addRole"
 	RemoveRoleString = "removeRole"
+	GrantCallString  = "grantCall"
+	RevokeCallString = "revokeCall"
+	CanCallString    = "canCall"
+	DelegateString   = "delegate"
 	UnknownString    = "#-UNKNOWN-#"
 
 	AllString = "all"
@@ -130,6 +142,14 @@ func (pf PermFlag) String() string {
 		return AddRoleString
 	case RemoveRole:
 		return RemoveRoleString
+	case GrantCall:
+		return GrantCallString
+	case RevokeCall:
+		return RevokeCallString
+	case CanCall:
+		return CanCallString
+	case Delegate:
+		return DelegateString
 	default:
 		return UnknownString
 	}
@@ -175,6 +195,14 @@ func PermStringToFlag(perm string) (PermFlag, error) {
 		return AddRole, nil
 	case RemoveRoleString, "removerole", "rmrole", "rm_role":
 		return RemoveRole, nil
+	case GrantCallString, "grantcall", "grant_call":
+		return GrantCall, nil
+	case RevokeCallString, "revokecall", "revoke_call":
+		return RevokeCall, nil
+	case CanCallString, "cancall", "can_call":
+		return CanCall, nil
+	case DelegateString:
+		return Delegate, nil
 	default:
 		return 0, fmt.Errorf("unknown permission %s", perm)
 	}
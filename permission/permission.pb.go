@@ -9,6 +9,8 @@
 
 	It has these top-level messages:
 		AccountPermissions
+		PermissionGrant
+		CallGrant
 		BasePermissions
 		PermArgs
 */
@@ -21,6 +23,7 @@ import math "math"
 import _ "github.com/gogo/protobuf/gogoproto"
 
 import github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
+import github_com_hyperledger_burrow_binary "github.com/hyperledger/burrow/binary"
 
 import io "io"
 
@@ -37,9 +40,14 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
 type AccountPermissions struct {
-	Base             BasePermissions `protobuf:"bytes,1,opt,name=Base" json:"Base"`
-	Roles            []string        `protobuf:"bytes,2,rep,name=Roles" json:"Roles,omitempty"`
-	XXX_unrecognized []byte          `json:"-"`
+	Base  BasePermissions `protobuf:"bytes,1,opt,name=Base" json:"Base"`
+	Roles []string        `protobuf:"bytes,2,rep,name=Roles" json:"Roles,omitempty"`
+	// Accounts and roles that may call this account's code - when non-empty it decides who may call the account in
+	// place of the Call permission
+	CallACL []CallGrant `protobuf:"bytes,3,rep,name=CallACL" json:"CallACL,omitempty"`
+	// Permissions held in addition to the base permissions until the grants expire
+	Grants           []PermissionGrant `protobuf:"bytes,4,rep,name=Grants" json:"Grants,omitempty"`
+	XXX_unrecognized []byte            `json:"-"`
 }
 
 func (m *AccountPermissions) Reset()                    { *m = AccountPermissions{} }
@@ -61,10 +69,94 @@ func (m *AccountPermissions) GetRoles() []string {
 	return nil
 }
 
+func (m *AccountPermissions) GetCallACL() []CallGrant {
+	if m != nil {
+		return m.CallACL
+	}
+	return nil
+}
+
+func (m *AccountPermissions) GetGrants() []PermissionGrant {
+	if m != nil {
+		return m.Grants
+	}
+	return nil
+}
+
 func (*AccountPermissions) XXX_MessageName() string {
 	return "permission.AccountPermissions"
 }
 
+// Permissions granted to an account until a block height or time, either by a moderator or delegated by an account
+// holding them
+type PermissionGrant struct {
+	Perms PermFlag `protobuf:"varint,1,opt,name=Perms,casttype=PermFlag" json:"Perms"`
+	// The grant expires from this block height on, if non-zero
+	ExpiryHeight uint64 `protobuf:"varint,2,opt,name=ExpiryHeight" json:"ExpiryHeight,omitempty"`
+	// The grant expires once the block time reaches this Unix time in seconds, if non-zero
+	ExpiryTime int64 `protobuf:"varint,3,opt,name=ExpiryTime" json:"ExpiryTime,omitempty"`
+	// The account that delegated the permissions, if they were delegated
+	Grantor          *github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,4,opt,name=Grantor,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Grantor,omitempty"`
+	XXX_unrecognized []byte                                        `json:"-"`
+}
+
+func (m *PermissionGrant) Reset()                    { *m = PermissionGrant{} }
+func (m *PermissionGrant) String() string            { return proto.CompactTextString(m) }
+func (*PermissionGrant) ProtoMessage()               {}
+func (*PermissionGrant) Descriptor() ([]byte, []int) { return fileDescriptorPermission, []int{1} }
+
+func (m *PermissionGrant) GetPerms() PermFlag {
+	if m != nil {
+		return m.Perms
+	}
+	return 0
+}
+
+func (m *PermissionGrant) GetExpiryHeight() uint64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *PermissionGrant) GetExpiryTime() int64 {
+	if m != nil {
+		return m.ExpiryTime
+	}
+	return 0
+}
+
+func (*PermissionGrant) XXX_MessageName() string {
+	return "permission.PermissionGrant"
+}
+
+// An entry in a contract's call ACL granting either a single account or the holders of a role the right to call it
+type CallGrant struct {
+	// The account that may call
+	Address *github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address,omitempty"`
+	// The role whose holders may call
+	Role string `protobuf:"bytes,2,opt,name=Role" json:"Role,omitempty"`
+	// The 4-byte selector of the function that may be called, or any function if empty
+	Function         github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,3,opt,name=Function,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Function,omitempty"`
+	XXX_unrecognized []byte                                        `json:"-"`
+}
+
+func (m *CallGrant) Reset()                    { *m = CallGrant{} }
+func (m *CallGrant) String() string            { return proto.CompactTextString(m) }
+func (*CallGrant) ProtoMessage()               {}
+func (*CallGrant) Descriptor() ([]byte, []int) { return fileDescriptorPermission, []int{2} }
+
+func (m *CallGrant) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (*CallGrant) XXX_MessageName() string {
+	return "permission.CallGrant"
+}
+
 type BasePermissions struct {
 	Perms            PermFlag `protobuf:"varint,1,opt,name=Perms,casttype=PermFlag" json:"Perms"`
 	SetBit           PermFlag `protobuf:"varint,2,opt,name=SetBit,casttype=PermFlag" json:"SetBit"`
@@ -73,7 +165,7 @@ type BasePermissions struct {
 
 func (m *BasePermissions) Reset()                    { *m = BasePermissions{} }
 func (*BasePermissions) ProtoMessage()               {}
-func (*BasePermissions) Descriptor() ([]byte, []int) { return fileDescriptorPermission, []int{1} }
+func (*BasePermissions) Descriptor() ([]byte, []int) { return fileDescriptorPermission, []int{3} }
 
 func (m *BasePermissions) GetPerms() PermFlag {
 	if m != nil {
@@ -102,11 +194,19 @@ type PermArgs struct {
 	Permission *PermFlag `protobuf:"varint,3,opt,name=Permission,casttype=PermFlag" json:"Permission,omitempty"`
 	Role       *string   `protobuf:"bytes,4,opt,name=Role" json:"Role,omitempty"`
 	Value      *bool     `protobuf:"varint,5,opt,name=Value" json:"Value,omitempty"`
+	// The account a call grant applies to
+	Caller *github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,6,opt,name=Caller,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Caller,omitempty"`
+	// The function selector a call grant applies to
+	Function *github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,7,opt,name=Function,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Function,omitempty"`
+	// The block height from which a grant of Permission expires
+	ExpiryHeight *uint64 `protobuf:"varint,8,opt,name=ExpiryHeight" json:"ExpiryHeight,omitempty"`
+	// The block time (as a Unix time in seconds) from which a grant of Permission expires
+	ExpiryTime *int64 `protobuf:"varint,9,opt,name=ExpiryTime" json:"ExpiryTime,omitempty"`
 }
 
 func (m *PermArgs) Reset()                    { *m = PermArgs{} }
 func (*PermArgs) ProtoMessage()               {}
-func (*PermArgs) Descriptor() ([]byte, []int) { return fileDescriptorPermission, []int{2} }
+func (*PermArgs) Descriptor() ([]byte, []int) { return fileDescriptorPermission, []int{4} }
 
 func (m *PermArgs) GetAction() PermFlag {
 	if m != nil {
@@ -136,12 +236,30 @@ func (m *PermArgs) GetValue() bool {
 	return false
 }
 
+func (m *PermArgs) GetExpiryHeight() uint64 {
+	if m != nil && m.ExpiryHeight != nil {
+		return *m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *PermArgs) GetExpiryTime() int64 {
+	if m != nil && m.ExpiryTime != nil {
+		return *m.ExpiryTime
+	}
+	return 0
+}
+
 func (*PermArgs) XXX_MessageName() string {
 	return "permission.PermArgs"
 }
 func init() {
 	proto.RegisterType((*AccountPermissions)(nil), "permission.AccountPermissions")
 	golang_proto.RegisterType((*AccountPermissions)(nil), "permission.AccountPermissions")
+	proto.RegisterType((*PermissionGrant)(nil), "permission.PermissionGrant")
+	golang_proto.RegisterType((*PermissionGrant)(nil), "permission.PermissionGrant")
+	proto.RegisterType((*CallGrant)(nil), "permission.CallGrant")
+	golang_proto.RegisterType((*CallGrant)(nil), "permission.CallGrant")
 	proto.RegisterType((*BasePermissions)(nil), "permission.BasePermissions")
 	golang_proto.RegisterType((*BasePermissions)(nil), "permission.BasePermissions")
 	proto.RegisterType((*PermArgs)(nil), "permission.PermArgs")
@@ -185,6 +303,113 @@ func (m *AccountPermissions) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
+	if len(m.CallACL) > 0 {
+		for _, msg := range m.CallACL {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintPermission(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Grants) > 0 {
+		for _, msg := range m.Grants {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintPermission(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *PermissionGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PermissionGrant) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintPermission(dAtA, i, uint64(m.Perms))
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintPermission(dAtA, i, uint64(m.ExpiryHeight))
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintPermission(dAtA, i, uint64(m.ExpiryTime))
+	if m.Grantor != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPermission(dAtA, i, uint64(m.Grantor.Size()))
+		n2, err := m.Grantor.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *CallGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CallGrant) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Address != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPermission(dAtA, i, uint64(m.Address.Size()))
+		n3, err := m.Address.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintPermission(dAtA, i, uint64(len(m.Role)))
+	i += copy(dAtA[i:], m.Role)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintPermission(dAtA, i, uint64(m.Function.Size()))
+	n4, err := m.Function.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n4
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -240,11 +465,11 @@ func (m *PermArgs) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPermission(dAtA, i, uint64(m.Target.Size()))
-		n2, err := m.Target.MarshalTo(dAtA[i:])
+		n5, err := m.Target.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n2
+		i += n5
 	}
 	if m.Permission != nil {
 		dAtA[i] = 0x18
@@ -267,6 +492,36 @@ func (m *PermArgs) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if m.Caller != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintPermission(dAtA, i, uint64(m.Caller.Size()))
+		n6, err := m.Caller.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if m.Function != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintPermission(dAtA, i, uint64(m.Function.Size()))
+		n7, err := m.Function.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	if m.ExpiryHeight != nil {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintPermission(dAtA, i, uint64(*m.ExpiryHeight))
+	}
+	if m.ExpiryTime != nil {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintPermission(dAtA, i, uint64(*m.ExpiryTime))
+	}
 	return i, nil
 }
 
@@ -290,6 +545,51 @@ func (m *AccountPermissions) Size() (n int) {
 			n += 1 + l + sovPermission(uint64(l))
 		}
 	}
+	if len(m.CallACL) > 0 {
+		for _, e := range m.CallACL {
+			l = e.Size()
+			n += 1 + l + sovPermission(uint64(l))
+		}
+	}
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovPermission(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PermissionGrant) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovPermission(uint64(m.Perms))
+	n += 1 + sovPermission(uint64(m.ExpiryHeight))
+	n += 1 + sovPermission(uint64(m.ExpiryTime))
+	if m.Grantor != nil {
+		l = m.Grantor.Size()
+		n += 1 + l + sovPermission(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CallGrant) Size() (n int) {
+	var l int
+	_ = l
+	if m.Address != nil {
+		l = m.Address.Size()
+		n += 1 + l + sovPermission(uint64(l))
+	}
+	l = len(m.Role)
+	n += 1 + l + sovPermission(uint64(l))
+	l = m.Function.Size()
+	n += 1 + l + sovPermission(uint64(l))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -325,6 +625,20 @@ func (m *PermArgs) Size() (n int) {
 	if m.Value != nil {
 		n += 2
 	}
+	if m.Caller != nil {
+		l = m.Caller.Size()
+		n += 1 + l + sovPermission(uint64(l))
+	}
+	if m.Function != nil {
+		l = m.Function.Size()
+		n += 1 + l + sovPermission(uint64(l))
+	}
+	if m.ExpiryHeight != nil {
+		n += 1 + sovPermission(uint64(*m.ExpiryHeight))
+	}
+	if m.ExpiryTime != nil {
+		n += 1 + sovPermission(uint64(*m.ExpiryTime))
+	}
 	return n
 }
 
@@ -429,6 +743,68 @@ func (m *AccountPermissions) Unmarshal(dAtA []byte) error {
 			}
 			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallACL", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermission
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPermission
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallACL = append(m.CallACL, CallGrant{})
+			if err := m.CallACL[len(m.CallACL)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermission
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPermission
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grants = append(m.Grants, PermissionGrant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPermission(dAtA[iNdEx:])
@@ -451,7 +827,7 @@ func (m *AccountPermissions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BasePermissions) Unmarshal(dAtA []byte) error {
+func (m *PermissionGrant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -474,10 +850,10 @@ func (m *BasePermissions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BasePermissions: wiretype end group for non-group")
+			return fmt.Errorf("proto: PermissionGrant: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BasePermissions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PermissionGrant: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -501,9 +877,9 @@ func (m *BasePermissions) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SetBit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
 			}
-			m.SetBit = 0
+			m.ExpiryHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPermission
@@ -513,22 +889,304 @@ func (m *BasePermissions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SetBit |= (PermFlag(b) & 0x7F) << shift
+				m.ExpiryHeight |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPermission(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPermission
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.ExpiryTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermission
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryTime |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermission
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPermission
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_hyperledger_burrow_crypto.Address
+			m.Grantor = &v
+			if err := m.Grantor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermission(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPermission
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CallGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermission
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CallGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CallGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermission
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPermission
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_hyperledger_burrow_crypto.Address
+			m.Address = &v
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermission
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermission
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Function", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermission
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPermission
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Function.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermission(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPermission
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BasePermissions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermission
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BasePermissions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BasePermissions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Perms", wireType)
+			}
+			m.Perms = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermission
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Perms |= (PermFlag(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetBit", wireType)
+			}
+			m.SetBit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermission
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SetBit |= (PermFlag(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermission(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPermission
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
 			iNdEx += skippy
@@ -691,6 +1349,110 @@ func (m *PermArgs) Unmarshal(dAtA []byte) error {
 			}
 			b := bool(v != 0)
 			m.Value = &b
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermission
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPermission
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_hyperledger_burrow_crypto.Address
+			m.Caller = &v
+			if err := m.Caller.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Function", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermission
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPermission
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_hyperledger_burrow_binary.HexBytes
+			m.Function = &v
+			if err := m.Function.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermission
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExpiryHeight = &v
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermission
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExpiryTime = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPermission(dAtA[iNdEx:])
@@ -821,28 +1583,45 @@ func init() { proto.RegisterFile("permission.proto", fileDescriptorPermission) }
 func init() { golang_proto.RegisterFile("permission.proto", fileDescriptorPermission) }
 
 var fileDescriptorPermission = []byte{
-	// 367 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0x31, 0x4f, 0xc2, 0x40,
-	0x14, 0xc7, 0x7b, 0x50, 0x48, 0x39, 0x49, 0x24, 0x17, 0x87, 0x46, 0x93, 0xb6, 0x61, 0x30, 0x1d,
-	0xb0, 0x35, 0x26, 0x2e, 0x0c, 0x26, 0xed, 0x60, 0x1c, 0x4d, 0x35, 0x0e, 0x6e, 0xa5, 0x9c, 0xa5,
-	0xb1, 0x70, 0xf5, 0xee, 0x1a, 0xc3, 0xb7, 0x70, 0x64, 0x54, 0x3e, 0x89, 0x23, 0x23, 0xb3, 0x03,
-	0x21, 0xf0, 0x2d, 0x9c, 0xcc, 0x5d, 0x09, 0x54, 0x13, 0xdc, 0xee, 0xff, 0xfe, 0xff, 0xf7, 0x7e,
-	0x7d, 0xaf, 0xb0, 0x95, 0x61, 0x3a, 0x4c, 0x18, 0x4b, 0xc8, 0xc8, 0xc9, 0x28, 0xe1, 0x04, 0xc1,
-	0x5d, 0xe5, 0xf8, 0x2c, 0x4e, 0xf8, 0x20, 0xef, 0x39, 0x11, 0x19, 0xba, 0x31, 0x89, 0x89, 0x2b,
-	0x23, 0xbd, 0xfc, 0x49, 0x2a, 0x29, 0xe4, 0xab, 0x68, 0x6d, 0x3f, 0x43, 0xe4, 0x45, 0x11, 0xc9,
-	0x47, 0xfc, 0x76, 0x3b, 0x83, 0xa1, 0x4b, 0xa8, 0xfa, 0x21, 0xc3, 0x3a, 0xb0, 0x80, 0x7d, 0x70,
-	0x71, 0xe2, 0x94, 0x88, 0xa2, 0x5e, 0x8a, 0xfa, 0xea, 0x6c, 0x61, 0x2a, 0x81, 0x8c, 0xa3, 0x23,
-	0x58, 0x0b, 0x48, 0x8a, 0x99, 0x5e, 0xb1, 0xaa, 0x76, 0x23, 0x28, 0x44, 0x57, 0x7b, 0x9b, 0x9a,
-	0xca, 0x64, 0x6a, 0x2a, 0xed, 0x17, 0x78, 0xf8, 0xa7, 0x1d, 0x9d, 0xc2, 0x9a, 0x90, 0x4c, 0xa2,
-	0x54, 0xbf, 0x25, 0xa6, 0x7d, 0x2f, 0x4c, 0x4d, 0x14, 0xaf, 0xd3, 0x30, 0x0e, 0x0a, 0x1b, 0xd9,
-	0xb0, 0x7e, 0x87, 0xb9, 0x9f, 0x70, 0xbd, 0xb2, 0x27, 0xb8, 0xf1, 0xbb, 0xcd, 0xc9, 0xbb, 0xa9,
-	0x6c, 0x91, 0x4b, 0x00, 0x65, 0xc4, 0xa3, 0xb1, 0x1c, 0xe2, 0x45, 0x3c, 0x21, 0xa3, 0xbd, 0xb4,
-	0x8d, 0x8f, 0x6e, 0x60, 0xfd, 0x3e, 0xa4, 0x31, 0x2e, 0x70, 0x4d, 0xff, 0xfc, 0x6b, 0x61, 0x76,
-	0x4a, 0x97, 0x1d, 0x8c, 0x33, 0x4c, 0x53, 0xdc, 0x8f, 0x31, 0x75, 0x7b, 0x39, 0xa5, 0xe4, 0xd5,
-	0x8d, 0xe8, 0x38, 0xe3, 0xc4, 0xf1, 0xfa, 0x7d, 0x8a, 0x19, 0x0b, 0x36, 0xfd, 0xa8, 0x03, 0xe1,
-	0x6e, 0x5f, 0xbd, 0x2a, 0xb9, 0xcd, 0x5f, 0xcc, 0x92, 0x8f, 0x10, 0x54, 0xc5, 0xd1, 0x74, 0xd5,
-	0x02, 0x76, 0x23, 0x90, 0x6f, 0x71, 0xd5, 0x87, 0x30, 0xcd, 0xb1, 0x5e, 0xb3, 0x80, 0xad, 0x05,
-	0x85, 0xe8, 0x6a, 0x62, 0xcd, 0xf9, 0x87, 0xa9, 0xf8, 0x57, 0xb3, 0x95, 0x01, 0xe6, 0x2b, 0x03,
-	0x2c, 0x57, 0x06, 0xf8, 0x5c, 0x1b, 0x60, 0xb6, 0x36, 0xc0, 0xa3, 0xfd, 0xff, 0xd7, 0xee, 0xfe,
-	0xe8, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb6, 0xa6, 0x3c, 0xf5, 0x50, 0x02, 0x00, 0x00,
+	// 637 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x4f, 0xd4, 0x4e,
+	0x18, 0xde, 0xd9, 0x76, 0x97, 0xee, 0xb0, 0xf9, 0xc1, 0x6f, 0x82, 0xa4, 0xfe, 0x49, 0xdb, 0x70,
+	0x30, 0x8d, 0x81, 0x5d, 0x25, 0xf1, 0x42, 0x8c, 0xc9, 0x0e, 0x11, 0x89, 0x4a, 0x62, 0x2a, 0xf1,
+	0xe0, 0xad, 0xbb, 0x8c, 0xa5, 0x49, 0xb7, 0x53, 0xa7, 0x6d, 0xa4, 0x5f, 0xc0, 0xb3, 0xde, 0x38,
+	0x2a, 0xdf, 0xc3, 0xc4, 0x23, 0x47, 0xce, 0x1c, 0x1a, 0x03, 0x37, 0x3e, 0x80, 0x07, 0x4f, 0x66,
+	0xa6, 0xb3, 0xdb, 0x61, 0x8d, 0x44, 0xb9, 0x75, 0xde, 0xf7, 0x79, 0x9f, 0xe7, 0xfd, 0x5b, 0xb8,
+	0x98, 0x10, 0x36, 0x0e, 0xd3, 0x34, 0xa4, 0x71, 0x2f, 0x61, 0x34, 0xa3, 0x08, 0xd6, 0x96, 0x5b,
+	0x6b, 0x41, 0x98, 0xed, 0xe7, 0xc3, 0xde, 0x88, 0x8e, 0xfb, 0x01, 0x0d, 0x68, 0x5f, 0x40, 0x86,
+	0xf9, 0x5b, 0xf1, 0x12, 0x0f, 0xf1, 0x55, 0x85, 0xae, 0x7c, 0x68, 0x42, 0x34, 0x18, 0x8d, 0x68,
+	0x1e, 0x67, 0x2f, 0xa7, 0x24, 0x29, 0x7a, 0x08, 0x75, 0xec, 0xa7, 0xc4, 0x04, 0x0e, 0x70, 0xe7,
+	0xd7, 0x6f, 0xf7, 0x14, 0x49, 0x6e, 0x57, 0xa0, 0x58, 0x3f, 0x2e, 0xed, 0x86, 0x27, 0xe0, 0x68,
+	0x09, 0xb6, 0x3c, 0x1a, 0x91, 0xd4, 0x6c, 0x3a, 0x9a, 0xdb, 0xf1, 0xaa, 0x07, 0x7a, 0x06, 0xe7,
+	0x36, 0xfd, 0x28, 0x1a, 0x6c, 0xbe, 0x30, 0x35, 0x47, 0x73, 0xe7, 0xd7, 0x6f, 0xa8, 0x7c, 0xdc,
+	0xf5, 0x94, 0xf9, 0x71, 0x86, 0x6f, 0x72, 0xa6, 0x8b, 0xd2, 0xfe, 0x5f, 0xa2, 0x57, 0xe9, 0x38,
+	0xcc, 0xc8, 0x38, 0xc9, 0x0a, 0x6f, 0x42, 0x80, 0x76, 0x60, 0x5b, 0x80, 0x53, 0x53, 0x77, 0xb4,
+	0xd9, 0xd4, 0xea, 0xb4, 0x2a, 0x42, 0x53, 0x12, 0x2e, 0x56, 0x21, 0x0a, 0x9f, 0x24, 0xd9, 0x30,
+	0x3e, 0x1e, 0xd9, 0x8d, 0xc3, 0x23, 0xbb, 0xb1, 0xf2, 0xa9, 0x09, 0x17, 0x66, 0xe2, 0xd1, 0x5d,
+	0xd8, 0xe2, 0xa6, 0x54, 0xb4, 0x41, 0xc7, 0x8b, 0x9c, 0xee, 0x67, 0x69, 0x1b, 0xdc, 0xb8, 0x15,
+	0xf9, 0x81, 0x57, 0xb9, 0x11, 0x86, 0xdd, 0x27, 0x07, 0x49, 0xc8, 0x8a, 0x6d, 0x12, 0x06, 0xfb,
+	0x99, 0xd9, 0x14, 0x70, 0x4b, 0xaa, 0x2f, 0xab, 0x3e, 0x25, 0x87, 0x4b, 0x31, 0xe8, 0x11, 0x84,
+	0xd5, 0x7b, 0x37, 0x1c, 0x13, 0x53, 0x73, 0x80, 0xab, 0xe1, 0x3b, 0x92, 0x61, 0xa9, 0xf6, 0x28,
+	0xf1, 0x0a, 0x9e, 0xb7, 0x58, 0xa4, 0x4c, 0x99, 0xa9, 0x3b, 0xc0, 0xed, 0xe2, 0xfb, 0xa7, 0xa5,
+	0xbd, 0xaa, 0xac, 0xc2, 0x7e, 0x91, 0x10, 0x16, 0x91, 0xbd, 0x80, 0xb0, 0xfe, 0x30, 0x67, 0x8c,
+	0xbe, 0xef, 0x8f, 0x58, 0x91, 0x64, 0xb4, 0x37, 0xd8, 0xdb, 0x63, 0x24, 0x4d, 0xbd, 0x09, 0x81,
+	0xd2, 0x93, 0x1f, 0x00, 0x76, 0xa6, 0xe3, 0xe1, 0x1a, 0x12, 0x6b, 0x82, 0xeb, 0x6a, 0xc8, 0x0f,
+	0x74, 0x0f, 0xea, 0x7c, 0x37, 0x44, 0xa7, 0x3a, 0x78, 0x59, 0xd6, 0xf9, 0x1f, 0xb7, 0x29, 0x15,
+	0x0a, 0x0c, 0x0a, 0xa0, 0xb1, 0x95, 0xc7, 0xa3, 0x2c, 0xa4, 0xb1, 0xe8, 0x4b, 0x17, 0x3f, 0xe7,
+	0xf8, 0xd3, 0xd2, 0x5e, 0xbb, 0x5a, 0x7c, 0x18, 0xc6, 0x3e, 0x2b, 0x7a, 0xdb, 0xe4, 0x00, 0x17,
+	0x19, 0x49, 0x2f, 0x4a, 0x1b, 0x4d, 0x88, 0x14, 0x91, 0x29, 0xb9, 0x52, 0xf8, 0x3b, 0xb8, 0x30,
+	0xb3, 0xe6, 0x7f, 0xbd, 0x0b, 0x2e, 0x6c, 0xbf, 0x22, 0x19, 0x0e, 0x27, 0x5b, 0xf0, 0x3b, 0x50,
+	0xfa, 0x37, 0xba, 0x87, 0x9f, 0xed, 0xc6, 0x54, 0xf2, 0xab, 0x06, 0x05, 0x64, 0xc0, 0x02, 0x41,
+	0x32, 0xa8, 0x0a, 0xfe, 0x93, 0x9a, 0xf4, 0xa3, 0x6d, 0xd8, 0xde, 0xf5, 0x59, 0x40, 0x2a, 0xb9,
+	0xeb, 0xcc, 0x44, 0xc6, 0xa3, 0x55, 0x08, 0xeb, 0x7a, 0x45, 0xa3, 0x75, 0xdc, 0xbd, 0xa4, 0xa9,
+	0xf8, 0x11, 0x92, 0x03, 0xe4, 0xdb, 0xd6, 0x91, 0x83, 0x5a, 0x82, 0xad, 0xd7, 0x7e, 0x94, 0x13,
+	0xb3, 0xe5, 0x00, 0xd7, 0xf0, 0xaa, 0x07, 0xcf, 0x90, 0xef, 0x10, 0x61, 0x66, 0xfb, 0xba, 0x19,
+	0x56, 0xf1, 0x68, 0x47, 0x59, 0x84, 0x39, 0xc1, 0xf5, 0xe0, 0x9f, 0x97, 0xa0, 0x1e, 0x37, 0x5a,
+	0x99, 0xb9, 0x5a, 0x83, 0x97, 0x3c, 0x73, 0x95, 0xd6, 0xa5, 0xab, 0xec, 0xf0, 0xab, 0x54, 0xef,
+	0x6e, 0xc3, 0xe0, 0x33, 0x3c, 0xf9, 0x62, 0x37, 0xf0, 0xe3, 0xe3, 0x33, 0x0b, 0x9c, 0x9c, 0x59,
+	0xe0, 0xfb, 0x99, 0x05, 0xbe, 0x9d, 0x5b, 0xe0, 0xf8, 0xdc, 0x02, 0x6f, 0xdc, 0xab, 0x93, 0xab,
+	0xff, 0x5d, 0xbf, 0x06, 0x00, 0x3b, 0x82, 0x62, 0xb1, 0xd6, 0x05, 0x00, 0x00,
 }
@@ -25,7 +25,7 @@ import (
 // PermissionsTx.PermArgs interface and argument encoding
 
 func (pa PermArgs) String() string {
-	body := make([]string, 0, 5)
+	body := make([]string, 0, 9)
 	body = append(body, fmt.Sprintf("PermFlag: %v", String(pa.Action)))
 	if pa.Target != nil {
 		body = append(body, fmt.Sprintf("Address: %s", *pa.Target))
@@ -39,6 +39,18 @@ func (pa PermArgs) String() string {
 	if pa.Value != nil {
 		body = append(body, fmt.Sprintf("Value: %v", *pa.Value))
 	}
+	if pa.Caller != nil {
+		body = append(body, fmt.Sprintf("Caller: %s", *pa.Caller))
+	}
+	if pa.Function != nil {
+		body = append(body, fmt.Sprintf("Function: %v", *pa.Function))
+	}
+	if pa.ExpiryHeight != nil {
+		body = append(body, fmt.Sprintf("ExpiryHeight: %v", *pa.ExpiryHeight))
+	}
+	if pa.ExpiryTime != nil {
+		body = append(body, fmt.Sprintf("ExpiryTime: %v", *pa.ExpiryTime))
+	}
 	return fmt.Sprintf("PermArgs{%s}", strings.Join(body, ", "))
 }
 
@@ -48,7 +60,13 @@ func (pa PermArgs) EnsureValid() error {
 	if pa.Target == nil && pf != SetGlobal {
 		return fmt.Errorf("PermArgs for PermFlag %v requires Address to be provided but was nil", pf)
 	}
-	if pf == HasRole || pf == AddRole || pf == RemoveRole {
+	if pf == GrantCall || pf == RevokeCall || pf == CanCall {
+		// Caller or Role, and Function
+		err := pa.CallGrant().EnsureValid()
+		if err != nil {
+			return err
+		}
+	} else if pf == HasRole || pf == AddRole || pf == RemoveRole {
 		// Role
 		if pa.Role == nil {
 			return fmt.Errorf("PermArgs for PermFlag %v requires Role to be provided but was nil", pf)
@@ -60,6 +78,16 @@ func (pa PermArgs) EnsureValid() error {
 	} else if (pf == SetBase || pf == SetGlobal) && pa.Value == nil {
 		return fmt.Errorf("PermArgs for PermFlag %v requires Value to be provided but was nil", pf)
 	}
+	// Expiry
+	if pf == Delegate || pa.ExpiryHeight != nil || pa.ExpiryTime != nil {
+		if pf != SetBase && pf != Delegate {
+			return fmt.Errorf("PermArgs for PermFlag %v does not take an expiry", pf)
+		}
+		if pf == SetBase && !*pa.Value {
+			return fmt.Errorf("PermArgs for PermFlag %v can only set a permission to true until an expiry", pf)
+		}
+		return pa.Grant(nil).EnsureValid()
+	}
 	return nil
 }
 
@@ -80,6 +108,12 @@ func SetBaseArgs(address crypto.Address, permFlag PermFlag, value bool) PermArgs
 	}
 }
 
+// Sets permFlag on address until the block at expiryHeight or the first block whose time reaches expiryTime (a Unix
+// time in seconds), where a zero expiry is not checked
+func SetBaseUntilArgs(address crypto.Address, permFlag PermFlag, expiryHeight uint64, expiryTime int64) PermArgs {
+	return withExpiry(SetBaseArgs(address, permFlag, true), expiryHeight, expiryTime)
+}
+
 func UnsetBaseArgs(address crypto.Address, permFlag PermFlag) PermArgs {
 	return PermArgs{
 		Action:     UnsetBase,
@@ -119,3 +153,77 @@ func RemoveRoleArgs(address crypto.Address, role string) PermArgs {
 		Role:   &role,
 	}
 }
+
+// The call grant described by PermArgs for GrantCall, RevokeCall, and CanCall
+func (pa PermArgs) CallGrant() CallGrant {
+	grant := CallGrant{
+		Address: pa.Caller,
+	}
+	if pa.Role != nil {
+		grant.Role = *pa.Role
+	}
+	if pa.Function != nil {
+		grant.Function = *pa.Function
+	}
+	return grant
+}
+
+func GrantCallArgs(contract crypto.Address, grant CallGrant) PermArgs {
+	return callGrantArgs(GrantCall, contract, grant)
+}
+
+func RevokeCallArgs(contract crypto.Address, grant CallGrant) PermArgs {
+	return callGrantArgs(RevokeCall, contract, grant)
+}
+
+func CanCallArgs(contract crypto.Address, grant CallGrant) PermArgs {
+	return callGrantArgs(CanCall, contract, grant)
+}
+
+func callGrantArgs(action PermFlag, contract crypto.Address, grant CallGrant) PermArgs {
+	pa := PermArgs{
+		Action: action,
+		Target: &contract,
+		Caller: grant.Address,
+	}
+	if grant.Role != "" {
+		pa.Role = &grant.Role
+	}
+	if len(grant.Function) > 0 {
+		pa.Function = &grant.Function
+	}
+	return pa
+}
+
+// Delegates permFlag, which the delegating account must hold, to address until the block at expiryHeight or the first
+// block whose time reaches expiryTime
+func DelegateArgs(address crypto.Address, permFlag PermFlag, expiryHeight uint64, expiryTime int64) PermArgs {
+	return withExpiry(PermArgs{
+		Action:     Delegate,
+		Target:     &address,
+		Permission: &permFlag,
+	}, expiryHeight, expiryTime)
+}
+
+// The permission grant described by PermArgs for SetBase with an expiry or for Delegate, made by grantor if delegated
+func (pa PermArgs) Grant(grantor *crypto.Address) PermissionGrant {
+	grant := PermissionGrant{
+		Grantor:      grantor,
+		ExpiryHeight: pa.GetExpiryHeight(),
+		ExpiryTime:   pa.GetExpiryTime(),
+	}
+	if pa.Permission != nil {
+		grant.Perms = *pa.Permission
+	}
+	return grant
+}
+
+func withExpiry(pa PermArgs, expiryHeight uint64, expiryTime int64) PermArgs {
+	if expiryHeight != 0 {
+		pa.ExpiryHeight = &expiryHeight
+	}
+	if expiryTime != 0 {
+		pa.ExpiryTime = &expiryTime
+	}
+	return pa
+}
@@ -50,6 +50,39 @@ var History relic.ImmutableHistory = relic.NewHistory("Hyperledger Burrow", "htt
 	MustDeclareReleases("",
 		`### Fixed
 - [Tendermint] Disable default Tendermint TxIndexer - for which we have no use but puts extra load on DB
+- [Deploy] Errors broadcasting send, register and permission jobs no longer go unreported
+- [RPC] GRPC interceptors no longer race on (and accumulate fields in) their shared logger
+- [ABI] Addresses can be packed from crypto.Address and []byte, integers from *big.Int, and arrays unpacked into typed slices
+- [ABI] Arguments following a dynamic array and fixed size arrays of dynamic types are now packed and unpacked at the correct offsets
+
+### Added
+- [Vent] Added burrow vent rewind to revert projection tables to a previous height from the log and burrow vent reproject to rebuild a single table after its spec changes
+- [Vent] Spec and ABI files can be watched with --watch-every so that new or changed event classes are applied live, new tables are back-filled from the chain's history
+- [Execution] A contract's metadata (such as its ABI) can be stored alongside its code by setting ContractMeta (of up to 64KiB, using a unit of gas per byte) on the creating CallTx and retrieved with rpcquery.GetContractMeta
+- [Deploy] Deploy jobs can set store-abi to store the contract's ABI on chain
+- [Vent] ABIs stored on chain are fetched for contracts whose events vent has not seen before, ABI files are now optional
+- [Vent] Vent's HTTP server exposes Prometheus metrics on /metrics including consumer lag, rows written per table, decode errors, DB latency and gRPC reconnects
+- [Vent] Rows can be written to sinks other than SQL with --sink: a newline-delimited JSON file, an HTTP webhook (with retries), or a message queue via sink.Publisher, each keeping its own checkpoint
+- [Deploy] burrow deploy --dry-run runs playbooks against an in-memory fork of the chain's state and prints a plan of the accounts, contracts, names and permissions that would change and the gas used
+- [Deploy] burrow deploy keeps a <playbook>.state.json file recording the inputs, addresses and tx hashes of completed transacting jobs so that re-running a playbook skips unchanged jobs and resumes from the first that failed, pass --force to run every job
+- [Deploy] burrow deploy --parallel-jobs runs independent jobs of a playbook concurrently, inferring dependencies from $variable references and treating jobs with implicit effects (queries, sends, permissions, account changes) as barriers
+- [Deploy] Added assert-revert, assert-events and assert-balance jobs to expect a call to revert (with a given reason), an earlier job to have emitted Solidity events and an account balance to have changed by an amount
+- [Tools] Added burrow abigen to generate typed Go bindings (deploy, call, simulate and event filtering) from contract .bin and ABI files
+- [ABI] Added tuples (ABIEncoderV2 structs), arrays of tuples and nested arrays to the ABI packer, Go structs map onto tuples by field name, deploy jobs take struct arguments as mappings and vent decodes struct event fields as <field>.<component>
+- [Deploy] Added if and foreach jobs to run nested jobs on a condition or once for each of a list of items
+- [Deploy] Added proxy option to deploy jobs to deploy a contract behind an upgradeable EIP-1967 proxy and an upgrade job that swaps its implementation after checking storage layout compatibility
+- [Deploy] Added the compiler version, settings and source hashes of each contract deployed to the deployment state and burrow verify to check deployed code against its sources
+- [Deploy] Added a compiler section to playbooks for the solc version, optimizer runs and import remappings, solc is chosen from a directory of installed versions by each contract's version pragma, and compiler outputs are cached until a source or setting changes
+- [Keys] Added a PKCS#11 key client so that validator and account keys (Ed25519 or secp256k1) can be generated and held in an HSM, configured by the PKCS11 section of Keys and built with the pkcs11 tag
+- [Keys] Added burrow keys seed to create or recover a BIP-39 mnemonic seed and --seed/--path options to burrow keys gen (and GenerateKey) to derive secp256k1 (BIP-32) and Ed25519 (SLIP-10) keys from it, burrow keys list shows the seed and path of derived keys
+- [Keys] burrow keys import (and ImportJSON) reads Ethereum Web3 Secret Storage (V3) key files written by geth and MetaMask and burrow keys export --format=ethereum writes them for secp256k1 keys
+- [Keys] burrow keys server can serve mutual TLS and authorise each client by its certificate against policies of the methods and keys it may use with per-key signing rate limits, and writes an audit log of every request that uses a key
+- [Execution] Added multisig accounts, whose transactions must be signed by a threshold number of their keys, created with GovTx or the update-account deploy job
+- [Execution] An account's public key can be rotated with a GovTx, signed by the account itself or by root, keeping its address, balance, permissions and code
+- [Keys] Validators can sign votes and proposals through the keys service with the [Keys] SignConsensus option, which persists the last height, round and step signed to protect the validator key from double signing across restarts
+- [Governance] Proposals can expire at a block height, require a quorum set by the chain or the proposal, and take votes against, abstentions and withdrawn votes, with a ProposalEvent emitted whenever a proposal is created or changes state
+- [Execution] Contracts can hold a call ACL granting particular accounts or role holders calls to the contract or to particular functions of it, enforced for CallTx and EVM calls in place of the Call permission and managed with the grantCall, revokeCall and canCall SNatives and PermsTx actions
+- [Execution] Permissions can be granted until a block height or time with a PermsTx setBase action given an expiry, and accounts with the new delegate permission can delegate permissions they hold until an expiry, with grants checked wherever permissions are and reported by the new GetPermissions query
 `,
 		"0.25.1 - 2019-05-03",
 		`### Changed
@@ -19,7 +19,7 @@ func unaryInterceptor(logger *logging.Logger) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler) (resp interface{}, err error) {
 
-		logger = logger.With("method", info.FullMethod)
+		logger := logger.With("method", info.FullMethod)
 
 		defer func() {
 			if r := recover(); r != nil {
@@ -35,7 +35,7 @@ func unaryInterceptor(logger *logging.Logger) grpc.UnaryServerInterceptor {
 func streamInterceptor(logger *logging.Logger) grpc.StreamServerInterceptor {
 	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
 		handler grpc.StreamHandler) (err error) {
-		logger = logger.With("method", info.FullMethod,
+		logger := logger.With("method", info.FullMethod,
 			"is_client_stream", info.IsClientStream,
 			"is_server_stream", info.IsServerStream)
 
@@ -97,6 +97,12 @@ func (ees *executionEventsServer) Events(request *BlocksRequest, stream Executio
 		case sev.EndBlock != nil && len(response.Events) > 0:
 			return stream.Send(response)
 
+		case sev.Event != nil && len(stack) == 0:
+			// Events of the block itself rather than a transaction
+			if qry.Matches(sev.Event.Tagged()) {
+				response.Events = append(response.Events, sev.Event)
+			}
+
 		default:
 			// We need to consume transaction to exclude events belong to an exceptional transaction
 			txe := stack.Consume(sev)
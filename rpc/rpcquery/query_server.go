@@ -59,11 +59,39 @@ func (qs *queryServer) GetAccount(ctx context.Context, param *GetAccountParam) (
 	return acc, err
 }
 
+// Gets the permissions of an account as they will be checked in the next block, that is with any grants that will not
+// have expired by then
+func (qs *queryServer) GetPermissions(ctx context.Context, param *GetPermissionsParam) (*PermissionsValue, error) {
+	acc, err := qs.accounts.GetAccount(param.Address)
+	if err != nil {
+		return nil, err
+	}
+	if acc == nil {
+		return &PermissionsValue{}, nil
+	}
+	blockHeight, blockTime := qs.blockchain.LastBlockHeight()+1, qs.blockchain.LastBlockTime().Unix()
+	return &PermissionsValue{
+		Base:   acc.Permissions.BaseAt(blockHeight, blockTime),
+		Grants: acc.Permissions.GrantsAt(blockHeight, blockTime),
+	}, nil
+}
+
 func (qs *queryServer) GetStorage(ctx context.Context, param *GetStorageParam) (*StorageValue, error) {
 	val, err := qs.accounts.GetStorage(param.Address, param.Key)
 	return &StorageValue{Value: val}, err
 }
 
+func (qs *queryServer) GetContractMeta(ctx context.Context, param *GetContractMetaParam) (*ContractMetaValue, error) {
+	acc, err := qs.accounts.GetAccount(param.Address)
+	if err != nil {
+		return nil, err
+	}
+	if acc == nil {
+		return nil, fmt.Errorf("account %v not found", param.Address)
+	}
+	return &ContractMetaValue{ContractMeta: acc.ContractMeta}, nil
+}
+
 func (qs *queryServer) ListAccounts(param *ListAccountsParam, stream Query_ListAccountsServer) error {
 	qry, err := query.NewOrEmpty(param.Query)
 	var streamErr error
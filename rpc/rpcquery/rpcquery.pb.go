@@ -12,6 +12,10 @@
 		GetAccountParam
 		GetStorageParam
 		StorageValue
+		GetContractMetaParam
+		ContractMetaValue
+		GetPermissionsParam
+		PermissionsValue
 		ListAccountsParam
 		GetNameParam
 		ListNamesParam
@@ -36,6 +40,7 @@ import _ "github.com/gogo/protobuf/gogoproto"
 import types "github.com/tendermint/tendermint/abci/types"
 import names "github.com/hyperledger/burrow/execution/names"
 import acm "github.com/hyperledger/burrow/acm"
+import permission "github.com/hyperledger/burrow/permission"
 import validator "github.com/hyperledger/burrow/acm/validator"
 import rpc "github.com/hyperledger/burrow/rpc"
 import payload "github.com/hyperledger/burrow/txs/payload"
@@ -128,6 +133,82 @@ func (*StorageValue) XXX_MessageName() string {
 	return "rpcquery.StorageValue"
 }
 
+type GetContractMetaParam struct {
+	Address github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+}
+
+func (m *GetContractMetaParam) Reset()                    { *m = GetContractMetaParam{} }
+func (m *GetContractMetaParam) String() string            { return proto.CompactTextString(m) }
+func (*GetContractMetaParam) ProtoMessage()               {}
+func (*GetContractMetaParam) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{4} }
+
+func (*GetContractMetaParam) XXX_MessageName() string {
+	return "rpcquery.GetContractMetaParam"
+}
+
+type ContractMetaValue struct {
+	ContractMeta string `protobuf:"bytes,1,opt,name=ContractMeta,proto3" json:"ContractMeta,omitempty"`
+}
+
+func (m *ContractMetaValue) Reset()                    { *m = ContractMetaValue{} }
+func (m *ContractMetaValue) String() string            { return proto.CompactTextString(m) }
+func (*ContractMetaValue) ProtoMessage()               {}
+func (*ContractMetaValue) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{5} }
+
+func (m *ContractMetaValue) GetContractMeta() string {
+	if m != nil {
+		return m.ContractMeta
+	}
+	return ""
+}
+
+func (*ContractMetaValue) XXX_MessageName() string {
+	return "rpcquery.ContractMetaValue"
+}
+
+type GetPermissionsParam struct {
+	Address github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+}
+
+func (m *GetPermissionsParam) Reset()                    { *m = GetPermissionsParam{} }
+func (m *GetPermissionsParam) String() string            { return proto.CompactTextString(m) }
+func (*GetPermissionsParam) ProtoMessage()               {}
+func (*GetPermissionsParam) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{6} }
+
+func (*GetPermissionsParam) XXX_MessageName() string {
+	return "rpcquery.GetPermissionsParam"
+}
+
+type PermissionsValue struct {
+	// The account's base permissions including those of its grants, as they will be checked in the next block
+	Base permission.BasePermissions `protobuf:"bytes,1,opt,name=Base" json:"Base"`
+	// The account's grants that have not expired by the next block
+	Grants []permission.PermissionGrant `protobuf:"bytes,2,rep,name=Grants" json:"Grants"`
+}
+
+func (m *PermissionsValue) Reset()                    { *m = PermissionsValue{} }
+func (m *PermissionsValue) String() string            { return proto.CompactTextString(m) }
+func (*PermissionsValue) ProtoMessage()               {}
+func (*PermissionsValue) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{7} }
+
+func (m *PermissionsValue) GetBase() permission.BasePermissions {
+	if m != nil {
+		return m.Base
+	}
+	return permission.BasePermissions{}
+}
+
+func (m *PermissionsValue) GetGrants() []permission.PermissionGrant {
+	if m != nil {
+		return m.Grants
+	}
+	return nil
+}
+
+func (*PermissionsValue) XXX_MessageName() string {
+	return "rpcquery.PermissionsValue"
+}
+
 type ListAccountsParam struct {
 	Query string `protobuf:"bytes,1,opt,name=Query,proto3" json:"Query,omitempty"`
 }
@@ -135,7 +216,7 @@ type ListAccountsParam struct {
 func (m *ListAccountsParam) Reset()                    { *m = ListAccountsParam{} }
 func (m *ListAccountsParam) String() string            { return proto.CompactTextString(m) }
 func (*ListAccountsParam) ProtoMessage()               {}
-func (*ListAccountsParam) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{4} }
+func (*ListAccountsParam) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{8} }
 
 func (m *ListAccountsParam) GetQuery() string {
 	if m != nil {
@@ -155,7 +236,7 @@ type GetNameParam struct {
 func (m *GetNameParam) Reset()                    { *m = GetNameParam{} }
 func (m *GetNameParam) String() string            { return proto.CompactTextString(m) }
 func (*GetNameParam) ProtoMessage()               {}
-func (*GetNameParam) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{5} }
+func (*GetNameParam) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{9} }
 
 func (m *GetNameParam) GetName() string {
 	if m != nil {
@@ -175,7 +256,7 @@ type ListNamesParam struct {
 func (m *ListNamesParam) Reset()                    { *m = ListNamesParam{} }
 func (m *ListNamesParam) String() string            { return proto.CompactTextString(m) }
 func (*ListNamesParam) ProtoMessage()               {}
-func (*ListNamesParam) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{6} }
+func (*ListNamesParam) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{10} }
 
 func (m *ListNamesParam) GetQuery() string {
 	if m != nil {
@@ -194,7 +275,7 @@ type GetValidatorSetParam struct {
 func (m *GetValidatorSetParam) Reset()                    { *m = GetValidatorSetParam{} }
 func (m *GetValidatorSetParam) String() string            { return proto.CompactTextString(m) }
 func (*GetValidatorSetParam) ProtoMessage()               {}
-func (*GetValidatorSetParam) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{7} }
+func (*GetValidatorSetParam) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{11} }
 
 func (*GetValidatorSetParam) XXX_MessageName() string {
 	return "rpcquery.GetValidatorSetParam"
@@ -209,7 +290,7 @@ func (m *GetValidatorSetHistoryParam) Reset()         { *m = GetValidatorSetHist
 func (m *GetValidatorSetHistoryParam) String() string { return proto.CompactTextString(m) }
 func (*GetValidatorSetHistoryParam) ProtoMessage()    {}
 func (*GetValidatorSetHistoryParam) Descriptor() ([]byte, []int) {
-	return fileDescriptorRpcquery, []int{8}
+	return fileDescriptorRpcquery, []int{12}
 }
 
 func (m *GetValidatorSetHistoryParam) GetIncludePrevious() int64 {
@@ -230,7 +311,7 @@ type ValidatorSetHistory struct {
 func (m *ValidatorSetHistory) Reset()                    { *m = ValidatorSetHistory{} }
 func (m *ValidatorSetHistory) String() string            { return proto.CompactTextString(m) }
 func (*ValidatorSetHistory) ProtoMessage()               {}
-func (*ValidatorSetHistory) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{9} }
+func (*ValidatorSetHistory) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{13} }
 
 func (m *ValidatorSetHistory) GetHistory() []*ValidatorSet {
 	if m != nil {
@@ -251,7 +332,7 @@ type ValidatorSet struct {
 func (m *ValidatorSet) Reset()                    { *m = ValidatorSet{} }
 func (m *ValidatorSet) String() string            { return proto.CompactTextString(m) }
 func (*ValidatorSet) ProtoMessage()               {}
-func (*ValidatorSet) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{10} }
+func (*ValidatorSet) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{14} }
 
 func (m *ValidatorSet) GetHeight() uint64 {
 	if m != nil {
@@ -278,7 +359,7 @@ type GetProposalParam struct {
 func (m *GetProposalParam) Reset()                    { *m = GetProposalParam{} }
 func (m *GetProposalParam) String() string            { return proto.CompactTextString(m) }
 func (*GetProposalParam) ProtoMessage()               {}
-func (*GetProposalParam) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{11} }
+func (*GetProposalParam) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{15} }
 
 func (m *GetProposalParam) GetHash() []byte {
 	if m != nil {
@@ -298,7 +379,7 @@ type ListProposalsParam struct {
 func (m *ListProposalsParam) Reset()                    { *m = ListProposalsParam{} }
 func (m *ListProposalsParam) String() string            { return proto.CompactTextString(m) }
 func (*ListProposalsParam) ProtoMessage()               {}
-func (*ListProposalsParam) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{12} }
+func (*ListProposalsParam) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{16} }
 
 func (m *ListProposalsParam) GetProposed() bool {
 	if m != nil {
@@ -319,7 +400,7 @@ type ProposalResult struct {
 func (m *ProposalResult) Reset()                    { *m = ProposalResult{} }
 func (m *ProposalResult) String() string            { return proto.CompactTextString(m) }
 func (*ProposalResult) ProtoMessage()               {}
-func (*ProposalResult) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{13} }
+func (*ProposalResult) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{17} }
 
 func (m *ProposalResult) GetHash() []byte {
 	if m != nil {
@@ -345,7 +426,7 @@ type GetStatsParam struct {
 func (m *GetStatsParam) Reset()                    { *m = GetStatsParam{} }
 func (m *GetStatsParam) String() string            { return proto.CompactTextString(m) }
 func (*GetStatsParam) ProtoMessage()               {}
-func (*GetStatsParam) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{14} }
+func (*GetStatsParam) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{18} }
 
 func (*GetStatsParam) XXX_MessageName() string {
 	return "rpcquery.GetStatsParam"
@@ -359,7 +440,7 @@ type Stats struct {
 func (m *Stats) Reset()                    { *m = Stats{} }
 func (m *Stats) String() string            { return proto.CompactTextString(m) }
 func (*Stats) ProtoMessage()               {}
-func (*Stats) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{15} }
+func (*Stats) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{19} }
 
 func (m *Stats) GetAccountsWithCode() uint64 {
 	if m != nil {
@@ -386,7 +467,7 @@ type GetBlockParam struct {
 func (m *GetBlockParam) Reset()                    { *m = GetBlockParam{} }
 func (m *GetBlockParam) String() string            { return proto.CompactTextString(m) }
 func (*GetBlockParam) ProtoMessage()               {}
-func (*GetBlockParam) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{16} }
+func (*GetBlockParam) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{20} }
 
 func (m *GetBlockParam) GetHeight() uint64 {
 	if m != nil {
@@ -407,6 +488,14 @@ func init() {
 	golang_proto.RegisterType((*GetStorageParam)(nil), "rpcquery.GetStorageParam")
 	proto.RegisterType((*StorageValue)(nil), "rpcquery.StorageValue")
 	golang_proto.RegisterType((*StorageValue)(nil), "rpcquery.StorageValue")
+	proto.RegisterType((*GetContractMetaParam)(nil), "rpcquery.GetContractMetaParam")
+	golang_proto.RegisterType((*GetContractMetaParam)(nil), "rpcquery.GetContractMetaParam")
+	proto.RegisterType((*ContractMetaValue)(nil), "rpcquery.ContractMetaValue")
+	golang_proto.RegisterType((*ContractMetaValue)(nil), "rpcquery.ContractMetaValue")
+	proto.RegisterType((*GetPermissionsParam)(nil), "rpcquery.GetPermissionsParam")
+	golang_proto.RegisterType((*GetPermissionsParam)(nil), "rpcquery.GetPermissionsParam")
+	proto.RegisterType((*PermissionsValue)(nil), "rpcquery.PermissionsValue")
+	golang_proto.RegisterType((*PermissionsValue)(nil), "rpcquery.PermissionsValue")
 	proto.RegisterType((*ListAccountsParam)(nil), "rpcquery.ListAccountsParam")
 	golang_proto.RegisterType((*ListAccountsParam)(nil), "rpcquery.ListAccountsParam")
 	proto.RegisterType((*GetNameParam)(nil), "rpcquery.GetNameParam")
@@ -449,6 +538,8 @@ type QueryClient interface {
 	Status(ctx context.Context, in *StatusParam, opts ...grpc.CallOption) (*rpc.ResultStatus, error)
 	GetAccount(ctx context.Context, in *GetAccountParam, opts ...grpc.CallOption) (*acm.Account, error)
 	GetStorage(ctx context.Context, in *GetStorageParam, opts ...grpc.CallOption) (*StorageValue, error)
+	GetContractMeta(ctx context.Context, in *GetContractMetaParam, opts ...grpc.CallOption) (*ContractMetaValue, error)
+	GetPermissions(ctx context.Context, in *GetPermissionsParam, opts ...grpc.CallOption) (*PermissionsValue, error)
 	ListAccounts(ctx context.Context, in *ListAccountsParam, opts ...grpc.CallOption) (Query_ListAccountsClient, error)
 	GetName(ctx context.Context, in *GetNameParam, opts ...grpc.CallOption) (*names.Entry, error)
 	ListNames(ctx context.Context, in *ListNamesParam, opts ...grpc.CallOption) (Query_ListNamesClient, error)
@@ -495,6 +586,24 @@ func (c *queryClient) GetStorage(ctx context.Context, in *GetStorageParam, opts
 	return out, nil
 }
 
+func (c *queryClient) GetContractMeta(ctx context.Context, in *GetContractMetaParam, opts ...grpc.CallOption) (*ContractMetaValue, error) {
+	out := new(ContractMetaValue)
+	err := grpc.Invoke(ctx, "/rpcquery.Query/GetContractMeta", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetPermissions(ctx context.Context, in *GetPermissionsParam, opts ...grpc.CallOption) (*PermissionsValue, error) {
+	out := new(PermissionsValue)
+	err := grpc.Invoke(ctx, "/rpcquery.Query/GetPermissions", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ListAccounts(ctx context.Context, in *ListAccountsParam, opts ...grpc.CallOption) (Query_ListAccountsClient, error) {
 	stream, err := grpc.NewClientStream(ctx, &_Query_serviceDesc.Streams[0], c.cc, "/rpcquery.Query/ListAccounts", opts...)
 	if err != nil {
@@ -651,6 +760,8 @@ type QueryServer interface {
 	Status(context.Context, *StatusParam) (*rpc.ResultStatus, error)
 	GetAccount(context.Context, *GetAccountParam) (*acm.Account, error)
 	GetStorage(context.Context, *GetStorageParam) (*StorageValue, error)
+	GetContractMeta(context.Context, *GetContractMetaParam) (*ContractMetaValue, error)
+	GetPermissions(context.Context, *GetPermissionsParam) (*PermissionsValue, error)
 	ListAccounts(*ListAccountsParam, Query_ListAccountsServer) error
 	GetName(context.Context, *GetNameParam) (*names.Entry, error)
 	ListNames(*ListNamesParam, Query_ListNamesServer) error
@@ -720,6 +831,42 @@ func _Query_GetStorage_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetContractMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContractMetaParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetContractMeta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcquery.Query/GetContractMeta",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetContractMeta(ctx, req.(*GetContractMetaParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPermissionsParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcquery.Query/GetPermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPermissions(ctx, req.(*GetPermissionsParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ListAccounts_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(ListAccountsParam)
 	if err := stream.RecvMsg(m); err != nil {
@@ -907,6 +1054,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetStorage",
 			Handler:    _Query_GetStorage_Handler,
 		},
+		{
+			MethodName: "GetContractMeta",
+			Handler:    _Query_GetContractMeta_Handler,
+		},
+		{
+			MethodName: "GetPermissions",
+			Handler:    _Query_GetPermissions_Handler,
+		},
 		{
 			MethodName: "GetName",
 			Handler:    _Query_GetName_Handler,
@@ -1068,6 +1223,120 @@ func (m *StorageValue) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *GetContractMetaParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetContractMetaParam) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintRpcquery(dAtA, i, uint64(m.Address.Size()))
+	n5, err := m.Address.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n5
+	return i, nil
+}
+
+func (m *ContractMetaValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractMetaValue) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractMeta) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRpcquery(dAtA, i, uint64(len(m.ContractMeta)))
+		i += copy(dAtA[i:], m.ContractMeta)
+	}
+	return i, nil
+}
+
+func (m *GetPermissionsParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPermissionsParam) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintRpcquery(dAtA, i, uint64(m.Address.Size()))
+	n6, err := m.Address.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n6
+	return i, nil
+}
+
+func (m *PermissionsValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PermissionsValue) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintRpcquery(dAtA, i, uint64(m.Base.Size()))
+	n7, err := m.Base.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n7
+	if len(m.Grants) > 0 {
+		for _, msg := range m.Grants {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintRpcquery(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
 func (m *ListAccountsParam) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1323,11 +1592,11 @@ func (m *ProposalResult) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintRpcquery(dAtA, i, uint64(m.Ballot.Size()))
-		n5, err := m.Ballot.MarshalTo(dAtA[i:])
+		n8, err := m.Ballot.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
+		i += n8
 	}
 	return i, nil
 }
@@ -1450,6 +1719,46 @@ func (m *StorageValue) Size() (n int) {
 	return n
 }
 
+func (m *GetContractMetaParam) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	return n
+}
+
+func (m *ContractMetaValue) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContractMeta)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetPermissionsParam) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	return n
+}
+
+func (m *PermissionsValue) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Base.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovRpcquery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ListAccountsParam) Size() (n int) {
 	var l int
 	_ = l
@@ -1973,6 +2282,356 @@ func (m *StorageValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GetContractMetaParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetContractMetaParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetContractMetaParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractMetaValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractMetaValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractMetaValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractMeta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractMeta = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPermissionsParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPermissionsParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPermissionsParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PermissionsValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PermissionsValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PermissionsValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Base.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grants = append(m.Grants, permission.PermissionGrant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ListAccountsParam) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3091,60 +3750,68 @@ func init() { proto.RegisterFile("rpcquery.proto", fileDescriptorRpcquery) }
 func init() { golang_proto.RegisterFile("rpcquery.proto", fileDescriptorRpcquery) }
 
 var fileDescriptorRpcquery = []byte{
-	// 872 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4f, 0x8f, 0xdb, 0x44,
-	0x14, 0xc7, 0xbb, 0xdd, 0xec, 0xee, 0xcb, 0xbf, 0x76, 0xba, 0x84, 0xe0, 0x42, 0x5a, 0x8d, 0xc4,
-	0x76, 0xa9, 0xc0, 0x89, 0x42, 0x17, 0x10, 0x1c, 0xa0, 0x41, 0x90, 0x2c, 0x7f, 0x56, 0x8b, 0x83,
-	0x5a, 0xa9, 0x07, 0xa4, 0x89, 0x3d, 0x24, 0x16, 0x8e, 0xc7, 0x8c, 0xc7, 0x45, 0xfe, 0x48, 0x7c,
-	0x0b, 0x8e, 0x7b, 0xe4, 0xcc, 0xa1, 0x42, 0xdb, 0x6f, 0xc1, 0x09, 0x79, 0xfe, 0x38, 0xb6, 0x37,
-	0xad, 0xe0, 0xc0, 0x25, 0x7a, 0xef, 0xcd, 0xef, 0xfd, 0x9e, 0xf3, 0xe6, 0xfd, 0xde, 0x40, 0x87,
-	0xc7, 0xde, 0x2f, 0x29, 0xe5, 0x99, 0x13, 0x73, 0x26, 0x18, 0x3a, 0x30, 0xbe, 0xfd, 0xfe, 0x32,
-	0x10, 0xab, 0x74, 0xe1, 0x78, 0x6c, 0x3d, 0x5c, 0xb2, 0x25, 0x1b, 0x4a, 0xc0, 0x22, 0xfd, 0x49,
-	0x7a, 0xd2, 0x91, 0x96, 0x4a, 0xb4, 0x3f, 0x2a, 0xc1, 0x05, 0x8d, 0x7c, 0xca, 0xd7, 0x41, 0x24,
-	0xca, 0x26, 0x59, 0x78, 0xc1, 0x50, 0x64, 0x31, 0x4d, 0xd4, 0xaf, 0x4e, 0x6c, 0x46, 0x64, 0x5d,
-	0x38, 0x87, 0xc4, 0x5b, 0x6b, 0xb3, 0xfb, 0x8c, 0x84, 0x81, 0x4f, 0x04, 0xe3, 0xe6, 0x8c, 0xc7,
-	0x9e, 0x36, 0xdb, 0x31, 0xc9, 0x42, 0x46, 0x7c, 0xe5, 0xe2, 0x00, 0x9a, 0x73, 0x41, 0x44, 0x9a,
-	0x5c, 0x10, 0x4e, 0xd6, 0xe8, 0x04, 0xba, 0x93, 0x90, 0x79, 0x3f, 0xff, 0x10, 0xac, 0xe9, 0x93,
-	0x40, 0xac, 0x82, 0xa8, 0x6f, 0xdd, 0xb3, 0x4e, 0x0e, 0xdd, 0x7a, 0x18, 0x8d, 0xe0, 0xb6, 0x0c,
-	0xcd, 0x29, 0x8d, 0x4a, 0xe8, 0x1d, 0x89, 0xde, 0x76, 0x84, 0x09, 0x74, 0xa7, 0x54, 0x3c, 0xf2,
-	0x3c, 0x96, 0x46, 0x42, 0x95, 0x3b, 0x87, 0xfd, 0x47, 0xbe, 0xcf, 0x69, 0x92, 0xc8, 0x32, 0xad,
-	0xc9, 0xc3, 0xcb, 0xe7, 0x77, 0x5f, 0xfb, 0xf3, 0xf9, 0xdd, 0xf7, 0x4a, 0x2d, 0x59, 0x65, 0x31,
-	0xe5, 0x21, 0xf5, 0x97, 0x94, 0x0f, 0x17, 0x29, 0xe7, 0xec, 0xd7, 0xa1, 0xc7, 0xb3, 0x58, 0x30,
-	0x47, 0xe7, 0xba, 0x86, 0x04, 0xff, 0x66, 0xc9, 0x1a, 0x73, 0xc1, 0x38, 0x59, 0xd2, 0xff, 0xa5,
-	0x06, 0xfa, 0x0a, 0x76, 0xbf, 0xa1, 0x99, 0xfc, 0xa3, 0xff, 0x9a, 0x6b, 0x11, 0x44, 0x84, 0x67,
-	0xce, 0x13, 0xc6, 0xfd, 0xf1, 0xe9, 0x87, 0x6e, 0x4e, 0x80, 0x9f, 0x42, 0x4b, 0x7f, 0xe7, 0x63,
-	0x12, 0xa6, 0x14, 0x7d, 0x0d, 0x7b, 0xd2, 0xf8, 0x6f, 0x5f, 0x59, 0x63, 0x56, 0x14, 0xf8, 0x5d,
-	0xb8, 0xf5, 0x6d, 0x90, 0x98, 0x5e, 0xeb, 0xbb, 0x3d, 0x82, 0xbd, 0xef, 0xf3, 0xf1, 0xd4, 0x37,
-	0xaa, 0x1c, 0x8c, 0xa1, 0x35, 0xa5, 0xe2, 0x9c, 0xac, 0x75, 0xbb, 0x10, 0xdc, 0xc8, 0x1d, 0x0d,
-	0x92, 0x36, 0x3e, 0x86, 0x4e, 0x4e, 0x97, 0xdb, 0xaf, 0xe4, 0xea, 0xc1, 0xd1, 0x94, 0x8a, 0xc7,
-	0x66, 0xf8, 0xe6, 0x54, 0x5d, 0x33, 0x9e, 0xc2, 0x9d, 0x5a, 0x7c, 0x16, 0x24, 0x82, 0xf1, 0xac,
-	0x18, 0xba, 0xb3, 0xc8, 0x0b, 0x53, 0x9f, 0x5e, 0x70, 0xfa, 0x2c, 0x60, 0xa9, 0xba, 0xa9, 0x5d,
-	0xb7, 0x1e, 0xc6, 0x53, 0xb8, 0xbd, 0x85, 0x05, 0x8d, 0x60, 0x5f, 0x9b, 0x7d, 0xeb, 0xde, 0xee,
-	0x49, 0x73, 0xdc, 0x73, 0x0a, 0x6d, 0x96, 0xf1, 0xae, 0x81, 0xe1, 0x73, 0x68, 0x95, 0x0f, 0x50,
-	0x0f, 0x1a, 0x2b, 0x1a, 0x2c, 0x57, 0x42, 0x56, 0xbe, 0xe1, 0x6a, 0x0f, 0x1d, 0xc3, 0xee, 0x9c,
-	0x8a, 0xfe, 0x8e, 0x64, 0x3d, 0x72, 0x36, 0xba, 0x2a, 0xb2, 0xdd, 0x1c, 0x80, 0x8f, 0xe1, 0xe6,
-	0x94, 0x8a, 0x0b, 0xce, 0x62, 0x96, 0x90, 0xb0, 0xe8, 0xe4, 0x8c, 0x24, 0x2b, 0x75, 0x9f, 0xae,
-	0xb4, 0xf1, 0x08, 0x50, 0xde, 0x49, 0x03, 0xd4, 0xdd, 0xb4, 0xe1, 0x40, 0x45, 0xa8, 0x2f, 0xd1,
-	0x07, 0x6e, 0xe1, 0xe3, 0xef, 0xa0, 0x63, 0xd0, 0x2e, 0x4d, 0xd2, 0x50, 0x6c, 0xe3, 0x45, 0xf7,
-	0xa1, 0x31, 0x21, 0x61, 0xc8, 0x84, 0x9c, 0xcb, 0xe6, 0xb8, 0xeb, 0x18, 0x99, 0xab, 0xb0, 0xab,
-	0x8f, 0x71, 0x17, 0xda, 0x52, 0x20, 0x44, 0x4f, 0x05, 0xa6, 0xb0, 0x27, 0x3d, 0xf4, 0x00, 0x6e,
-	0x9a, 0x79, 0xc9, 0x05, 0xfb, 0x05, 0xf3, 0xa9, 0x6e, 0xc6, 0xb5, 0x78, 0x2e, 0xfe, 0x72, 0x8c,
-	0xa5, 0x42, 0xc2, 0x77, 0x24, 0x7c, 0xdb, 0x11, 0xbe, 0x2f, 0xeb, 0xca, 0xb5, 0xa0, 0xfe, 0x73,
-	0x0f, 0x1a, 0xb3, 0x4a, 0xc7, 0x95, 0x37, 0xfe, 0x7b, 0x4f, 0x8f, 0x16, 0x1a, 0x43, 0x43, 0xad,
-	0x26, 0xf4, 0xfa, 0xe6, 0x3a, 0x4b, 0xcb, 0xca, 0xbe, 0x95, 0x87, 0x1d, 0xd5, 0x15, 0x8d, 0x3c,
-	0x05, 0xd8, 0xec, 0x18, 0xf4, 0xe6, 0x26, 0xaf, 0xb6, 0x79, 0xec, 0x96, 0x93, 0xaf, 0x4b, 0x03,
-	0xfc, 0x4c, 0xa6, 0x69, 0x39, 0xd6, 0xd2, 0xca, 0xcb, 0xc4, 0xee, 0x95, 0xbf, 0xa4, 0x24, 0xde,
-	0x4f, 0xa1, 0x55, 0x16, 0x1c, 0xba, 0xb3, 0xc1, 0x5d, 0x13, 0x62, 0xb5, 0xf6, 0xc8, 0x42, 0x43,
-	0xd8, 0xd7, 0x12, 0x44, 0xbd, 0x4a, 0xe9, 0x42, 0x95, 0x76, 0xcb, 0x51, 0xab, 0xfe, 0xcb, 0x48,
-	0xf0, 0x0c, 0x9d, 0xc2, 0x61, 0xa1, 0x47, 0xd4, 0xaf, 0x96, 0xda, 0x88, 0xb4, 0x9a, 0x34, 0xb2,
-	0xd0, 0x99, 0x5c, 0x8e, 0x95, 0xb9, 0x1f, 0x54, 0xea, 0x5d, 0x53, 0xae, 0xfd, 0x12, 0x21, 0xa1,
-	0x1f, 0xa1, 0xb7, 0x5d, 0xd1, 0xe8, 0x9d, 0x97, 0x32, 0x96, 0x35, 0x6f, 0xbf, 0xbd, 0x9d, 0xd8,
-	0xb0, 0x7c, 0x02, 0xcd, 0x92, 0x9e, 0x90, 0x5d, 0x21, 0xad, 0xc8, 0xcc, 0xae, 0x8f, 0x3a, 0x3a,
-	0x83, 0x76, 0x45, 0x63, 0xe8, 0xad, 0x6a, 0x87, 0xaa, 0xe2, 0xb3, 0x4b, 0xfd, 0xab, 0x0a, 0x6d,
-	0x64, 0xa1, 0x87, 0x70, 0x60, 0xd4, 0x82, 0xde, 0xa8, 0x4d, 0x85, 0x51, 0x90, 0xdd, 0xad, 0x4e,
-	0x67, 0x82, 0x3e, 0x86, 0x8e, 0x99, 0xf5, 0x19, 0x25, 0x3e, 0xe5, 0xb5, 0xdc, 0x8d, 0x0a, 0xec,
-	0xb6, 0xa3, 0xde, 0x73, 0x85, 0x9b, 0x7c, 0x7e, 0x79, 0x35, 0xb0, 0xfe, 0xb8, 0x1a, 0x58, 0x7f,
-	0x5d, 0x0d, 0xac, 0xdf, 0x5f, 0x0c, 0xac, 0xcb, 0x17, 0x03, 0xeb, 0xe9, 0x83, 0x57, 0x3f, 0x01,
-	0x3c, 0xf6, 0x86, 0x86, 0x7e, 0xd1, 0x90, 0xcf, 0xfa, 0x07, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff,
-	0x3d, 0x0c, 0x60, 0xec, 0x9d, 0x08, 0x00, 0x00,
+	// 1005 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x2e, 0xfd, 0x23, 0xdb, 0x23, 0x59, 0xb2, 0xd7, 0xae, 0xaa, 0xd2, 0x8d, 0x12, 0x2c, 0x50,
+	0x27, 0x0d, 0x5a, 0x4a, 0x50, 0xe3, 0xa6, 0x3f, 0x87, 0x36, 0x0a, 0x5a, 0xd9, 0x4d, 0x63, 0xb8,
+	0x54, 0x91, 0x00, 0x39, 0x14, 0x58, 0x91, 0x1b, 0x89, 0x28, 0xc5, 0x65, 0x97, 0xcb, 0x14, 0xba,
+	0xf7, 0x1d, 0xfa, 0x0c, 0x7d, 0x8b, 0x1e, 0x7d, 0xec, 0xb9, 0x87, 0xa0, 0x70, 0x5e, 0xa4, 0xe0,
+	0xee, 0x52, 0x5a, 0x52, 0x4a, 0x80, 0x22, 0xf0, 0x45, 0x98, 0x99, 0xfd, 0x66, 0x86, 0xfa, 0x38,
+	0xf3, 0x2d, 0xa1, 0xce, 0x63, 0xef, 0xd7, 0x94, 0xf2, 0x99, 0x13, 0x73, 0x26, 0x18, 0xda, 0xce,
+	0x7d, 0xfb, 0x93, 0x71, 0x20, 0x26, 0xe9, 0xc8, 0xf1, 0xd8, 0xb4, 0x33, 0x66, 0x63, 0xd6, 0x91,
+	0x80, 0x51, 0xfa, 0x5c, 0x7a, 0xd2, 0x91, 0x96, 0x4a, 0xb4, 0xef, 0x1b, 0x70, 0x41, 0x23, 0x9f,
+	0xf2, 0x69, 0x10, 0x09, 0xd3, 0x24, 0x23, 0x2f, 0xe8, 0x88, 0x59, 0x4c, 0x13, 0xf5, 0xab, 0x13,
+	0xab, 0x11, 0x99, 0xce, 0x9d, 0x1d, 0xe2, 0x4d, 0xb5, 0xb9, 0x17, 0x67, 0x79, 0x49, 0x12, 0xb0,
+	0x48, 0x47, 0x1a, 0x2f, 0x48, 0x18, 0xf8, 0x44, 0x30, 0x9e, 0xa3, 0x79, 0xec, 0x69, 0x73, 0x37,
+	0x26, 0xb3, 0x90, 0x11, 0x5f, 0xb9, 0x38, 0x80, 0xea, 0x50, 0x10, 0x91, 0x26, 0x17, 0x84, 0x93,
+	0x29, 0xba, 0x03, 0x8d, 0x7e, 0xc8, 0xbc, 0x5f, 0x7e, 0x0a, 0xa6, 0xf4, 0x69, 0x20, 0x26, 0x41,
+	0xd4, 0xb2, 0x6e, 0x59, 0x77, 0x76, 0xdc, 0x72, 0x18, 0x75, 0xe1, 0x40, 0x86, 0x86, 0x94, 0x46,
+	0x06, 0x7a, 0x4d, 0xa2, 0x57, 0x1d, 0x61, 0x02, 0x8d, 0x01, 0x15, 0x0f, 0x3c, 0x8f, 0xa5, 0x91,
+	0x50, 0xed, 0xce, 0x61, 0xeb, 0x81, 0xef, 0x73, 0x9a, 0x24, 0xb2, 0x4d, 0xad, 0x7f, 0xef, 0xf2,
+	0xe5, 0xcd, 0x77, 0xfe, 0x79, 0x79, 0xf3, 0x63, 0x83, 0xa4, 0xc9, 0x2c, 0xa6, 0x3c, 0xa4, 0xfe,
+	0x98, 0xf2, 0xce, 0x28, 0xe5, 0x9c, 0xfd, 0xd6, 0xf1, 0xf8, 0x2c, 0x16, 0xcc, 0xd1, 0xb9, 0x6e,
+	0x5e, 0x04, 0xff, 0x69, 0xc9, 0x1e, 0x43, 0xc1, 0x38, 0x19, 0xd3, 0x6b, 0xe9, 0x81, 0xbe, 0x83,
+	0xf5, 0x47, 0x74, 0xd6, 0x5a, 0xfb, 0x3f, 0xb5, 0x46, 0x41, 0x44, 0xf8, 0xcc, 0x79, 0xca, 0xb8,
+	0xdf, 0x3b, 0xf9, 0xcc, 0xcd, 0x0a, 0xe0, 0x67, 0x50, 0xd3, 0xcf, 0xf9, 0x84, 0x84, 0x29, 0x45,
+	0xdf, 0xc3, 0xa6, 0x34, 0x5a, 0xd6, 0x5b, 0x54, 0x56, 0x25, 0xf0, 0x73, 0x38, 0x1c, 0x50, 0xf1,
+	0x90, 0x45, 0x82, 0x13, 0x4f, 0x3c, 0xa6, 0x82, 0x5c, 0x0f, 0xdf, 0xf7, 0x61, 0xdf, 0x6c, 0xa2,
+	0xfe, 0x08, 0x86, 0x9a, 0x19, 0xd4, 0x03, 0x54, 0x88, 0x61, 0x0a, 0x07, 0x03, 0x2a, 0x2e, 0xe6,
+	0x83, 0x9b, 0x5c, 0xcf, 0xf3, 0xfd, 0x6e, 0xc1, 0x9e, 0xd1, 0x44, 0x3d, 0xdf, 0x09, 0x6c, 0xf4,
+	0x49, 0xa2, 0x78, 0xae, 0xf6, 0x8e, 0x1c, 0x63, 0x7d, 0xb2, 0xb8, 0x81, 0xef, 0x6f, 0x64, 0xed,
+	0x5d, 0x09, 0x47, 0x5f, 0x40, 0x65, 0xc0, 0x49, 0x24, 0x92, 0xd6, 0xda, 0xad, 0xf5, 0x72, 0xe2,
+	0x22, 0x49, 0x62, 0x74, 0xa2, 0x4e, 0xc0, 0x1f, 0xc1, 0xfe, 0x0f, 0x41, 0x92, 0x8f, 0xbe, 0xfe,
+	0xaf, 0x87, 0xb0, 0xf9, 0x63, 0xa6, 0x1f, 0x9a, 0x1f, 0xe5, 0x60, 0x0c, 0xb5, 0x01, 0x15, 0xe7,
+	0x64, 0xaa, 0xa7, 0x17, 0xc1, 0x46, 0xe6, 0x68, 0x90, 0xb4, 0xf1, 0x31, 0xd4, 0xb3, 0x72, 0x99,
+	0xfd, 0xc6, 0x5a, 0x4d, 0x39, 0x05, 0x4f, 0x72, 0x2d, 0x18, 0x52, 0xb5, 0x75, 0x78, 0x00, 0x47,
+	0xa5, 0xf8, 0x69, 0x90, 0x08, 0xc6, 0x67, 0x73, 0x0d, 0x38, 0x8b, 0xbc, 0x30, 0xf5, 0xe9, 0x05,
+	0xa7, 0x2f, 0x02, 0x96, 0xaa, 0x97, 0xb1, 0xee, 0x96, 0xc3, 0x78, 0x00, 0x07, 0x2b, 0xaa, 0xa0,
+	0x2e, 0x6c, 0x69, 0xb3, 0x65, 0x49, 0xaa, 0x9a, 0xce, 0x5c, 0x3c, 0x4d, 0xbc, 0x9b, 0xc3, 0xf0,
+	0x39, 0xd4, 0xcc, 0x03, 0xd4, 0x84, 0xca, 0x84, 0x06, 0xe3, 0x89, 0x90, 0x9d, 0x37, 0x5c, 0xed,
+	0xa1, 0x63, 0x58, 0x1f, 0x52, 0xa1, 0x5f, 0xc0, 0xa1, 0xb3, 0x90, 0xb9, 0x79, 0xb6, 0x9b, 0x01,
+	0xf0, 0x31, 0xec, 0x65, 0xe3, 0xc5, 0x59, 0xcc, 0x12, 0x12, 0xce, 0x99, 0x3c, 0x25, 0xc9, 0x44,
+	0x0d, 0x96, 0x2b, 0x6d, 0xdc, 0x05, 0x94, 0x31, 0x99, 0x03, 0x35, 0x9b, 0x36, 0x6c, 0xab, 0x08,
+	0xf5, 0x25, 0x7a, 0xdb, 0x9d, 0xfb, 0xf8, 0x31, 0xd4, 0x73, 0xb4, 0x4b, 0x93, 0x34, 0x14, 0xab,
+	0xea, 0xa2, 0xdb, 0x50, 0xe9, 0x93, 0x30, 0x64, 0x42, 0xca, 0x44, 0xb5, 0xd7, 0x70, 0x72, 0xd5,
+	0x55, 0x61, 0x57, 0x1f, 0xe3, 0x06, 0xec, 0x4a, 0xbd, 0x22, 0x7a, 0x2a, 0x30, 0x85, 0x4d, 0xe9,
+	0xa1, 0xbb, 0xb0, 0x97, 0xcf, 0x4b, 0xa6, 0x9f, 0x0f, 0x99, 0x4f, 0x35, 0x19, 0x4b, 0xf1, 0x4c,
+	0x8b, 0xcd, 0x18, 0x4b, 0x85, 0x84, 0xaf, 0x49, 0xf8, 0xaa, 0x23, 0x7c, 0x5b, 0xf6, 0x95, 0x2a,
+	0xad, 0xfe, 0x73, 0x13, 0x2a, 0xa7, 0x05, 0xc6, 0x95, 0xd7, 0xfb, 0x63, 0x4b, 0x8f, 0x16, 0xea,
+	0x41, 0x45, 0xdd, 0x14, 0xe8, 0xdd, 0xc5, 0xeb, 0x34, 0xee, 0x0e, 0x7b, 0x3f, 0x0b, 0x3b, 0x8a,
+	0x15, 0x8d, 0x3c, 0x01, 0x58, 0x48, 0x3e, 0x7a, 0x7f, 0x91, 0x57, 0xba, 0x08, 0xec, 0x9a, 0x93,
+	0xdd, 0x67, 0x39, 0xf0, 0x6b, 0x99, 0xa6, 0xd5, 0xb1, 0x94, 0x66, 0x6a, 0xbb, 0xdd, 0x34, 0x9f,
+	0xc4, 0xd0, 0xd2, 0x73, 0x79, 0x0d, 0x98, 0x8a, 0x83, 0xda, 0x85, 0x2a, 0x4b, 0xd2, 0x68, 0x1f,
+	0x2d, 0xce, 0x97, 0x25, 0xed, 0x11, 0xd4, 0x8b, 0x72, 0x85, 0x6e, 0x14, 0xca, 0x95, 0x85, 0xcc,
+	0xb6, 0x17, 0xc7, 0x4b, 0xfa, 0xf3, 0x15, 0xd4, 0x4c, 0x35, 0x40, 0x46, 0xe7, 0x25, 0x95, 0x28,
+	0x12, 0xd3, 0xb5, 0x50, 0x07, 0xb6, 0xb4, 0x3e, 0xa0, 0x66, 0xe1, 0x11, 0xe6, 0x92, 0x61, 0xd7,
+	0x1c, 0xf5, 0xa1, 0xf0, 0x6d, 0x24, 0xf8, 0x0c, 0x9d, 0xc0, 0xce, 0x5c, 0x2c, 0x50, 0xab, 0xd8,
+	0x6a, 0xa1, 0x20, 0xc5, 0xa4, 0xae, 0x85, 0xce, 0x24, 0x83, 0x85, 0xa5, 0x2c, 0x32, 0xb8, 0x24,
+	0x2b, 0xf6, 0x6b, 0xb6, 0x1c, 0xfd, 0x0c, 0xcd, 0xd5, 0x72, 0x83, 0x3e, 0x7c, 0x6d, 0x45, 0x53,
+	0x90, 0xec, 0x1b, 0xab, 0x0b, 0xe7, 0x55, 0xbe, 0x84, 0xaa, 0xb1, 0xec, 0xc8, 0x2e, 0xbe, 0x19,
+	0x53, 0x03, 0xec, 0xf2, 0x1e, 0xa2, 0x33, 0xd8, 0x2d, 0x08, 0x00, 0xfa, 0xa0, 0xc8, 0x50, 0x51,
+	0x19, 0x6c, 0x83, 0xbf, 0xa2, 0x0a, 0x74, 0x2d, 0x74, 0x0f, 0xb6, 0xf3, 0x55, 0x46, 0xef, 0x95,
+	0x46, 0x36, 0x5f, 0x6f, 0xbb, 0x51, 0x5c, 0x9d, 0x04, 0x7d, 0x0e, 0xf5, 0x7c, 0x11, 0x4f, 0x29,
+	0xf1, 0x29, 0x2f, 0xe5, 0x2e, 0x56, 0xd4, 0xde, 0x75, 0xd4, 0xd7, 0xa0, 0xc2, 0xf5, 0xbf, 0xb9,
+	0xbc, 0x6a, 0x5b, 0x7f, 0x5f, 0xb5, 0xad, 0x7f, 0xaf, 0xda, 0xd6, 0x5f, 0xaf, 0xda, 0xd6, 0xe5,
+	0xab, 0xb6, 0xf5, 0xec, 0xee, 0x9b, 0x2f, 0x4a, 0x1e, 0x7b, 0x9d, 0xbc, 0xfc, 0xa8, 0x22, 0x3f,
+	0x01, 0x3f, 0xfd, 0x6f, 0x00, 0x98, 0x72, 0x56, 0xb1, 0xdb, 0x0a, 0x00, 0x00,
 }
@@ -0,0 +1,20 @@
+pragma solidity ^0.5.4;
+
+contract Escrow {
+    event Deposited(address indexed from, uint amount);
+    event Withdrawn(address indexed to, uint amount);
+
+    mapping(address => uint) public deposits;
+
+    function deposit() public payable {
+        deposits[msg.sender] += msg.value;
+        emit Deposited(msg.sender, msg.value);
+    }
+
+    function withdraw(uint amount) public {
+        require(deposits[msg.sender] >= amount, "insufficient deposit");
+        deposits[msg.sender] -= amount;
+        msg.sender.transfer(amount);
+        emit Withdrawn(msg.sender, amount);
+    }
+}
@@ -0,0 +1,38 @@
+pragma solidity ^0.5.4;
+pragma experimental ABIEncoderV2;
+
+contract Orders {
+    struct Item {
+        string name;
+        uint quantity;
+    }
+
+    struct Order {
+        address buyer;
+        uint price;
+        Item[] items;
+    }
+
+    event Placed(address indexed buyer, uint total, Order order);
+
+    uint public total;
+    string public lastItem;
+
+    function place(Order memory order) public {
+        uint quantity = 0;
+        for (uint i = 0; i < order.items.length; i++) {
+            quantity += order.items[i].quantity;
+        }
+        total = order.price * quantity;
+        lastItem = order.items[order.items.length - 1].name;
+        emit Placed(order.buyer, total, order);
+    }
+
+    function sum(uint[][] memory grid) public pure returns (uint result) {
+        for (uint i = 0; i < grid.length; i++) {
+            for (uint j = 0; j < grid[i].length; j++) {
+                result += grid[i][j];
+            }
+        }
+    }
+}
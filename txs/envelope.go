@@ -1,6 +1,7 @@
 package txs
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/hyperledger/burrow/acm"
@@ -48,8 +49,16 @@ func (s *Signatory) RealisePublicKey(getter acmstate.AccountGetter) error {
 		if err != nil {
 			return fmt.Errorf("%s: could not get account %v: %v", errPrefix, *s.Address, err)
 		}
+		if acc == nil {
+			return fmt.Errorf("%s: account %v does not exist", errPrefix, *s.Address)
+		}
 		publicKey := acc.PublicKey
 		s.PublicKey = &publicKey
+		if !s.PublicKey.IsValid() {
+			return fmt.Errorf("%s: public key %v is invalid", errPrefix, *s.PublicKey)
+		}
+		// The account's key may have been rotated, in which case it does not match the account's address
+		return nil
 	}
 	if !s.PublicKey.IsValid() {
 		return fmt.Errorf("%s: public key %v is invalid", errPrefix, *s.PublicKey)
@@ -92,7 +101,9 @@ func (sig *Signatory) Validate() error {
 }
 
 // Verifies the validity of the Signatories' Signatures in the Envelope. The Signatories must
-// appear in the same order as the inputs as returned by Tx.GetInputs().
+// appear in the same order as the inputs as returned by Tx.GetInputs(). The input of a multisig account is signed by
+// the threshold number of Signatories, one for each of its keys that signs in the order the keys are held in the account.
+// Once an account's public key is known (or has been rotated) its Signatory must use that key.
 func (txEnv *Envelope) Verify(getter acmstate.AccountGetter, chainID string) error {
 	err := txEnv.Validate()
 	if err != nil {
@@ -104,24 +115,59 @@ func (txEnv *Envelope) Verify(getter acmstate.AccountGetter, chainID string) err
 			errPrefix, txEnv.Tx.ChainID, chainID)
 	}
 	inputs := txEnv.Tx.GetInputs()
-	if len(inputs) != len(txEnv.Signatories) {
-		return fmt.Errorf("%s: number of inputs (= %v) should equal number of signatories (= %v)",
-			errPrefix, len(inputs), len(txEnv.Signatories))
+	accounts, err := getInputAccounts(getter, inputs)
+	if err != nil {
+		return fmt.Errorf("%s: %v", errPrefix, err)
+	}
+	multisigs := getMultisigs(accounts)
+	numSignatories := len(inputs)
+	for _, in := range inputs {
+		if ms, ok := multisigs[in.Address]; ok {
+			numSignatories += int(ms.Threshold) - 1
+		}
+	}
+	if numSignatories != len(txEnv.Signatories) {
+		if len(multisigs) == 0 {
+			return fmt.Errorf("%s: number of inputs (= %v) should equal number of signatories (= %v)",
+				errPrefix, len(inputs), len(txEnv.Signatories))
+		}
+		return fmt.Errorf("%s: inputs (including multisig inputs) require %v signatories but there are %v",
+			errPrefix, numSignatories, len(txEnv.Signatories))
 	}
 	signBytes, err := txEnv.Tx.SignBytes()
 	if err != nil {
 		return fmt.Errorf("%s: could not generate SignBytes: %v", errPrefix, err)
 	}
 	// Expect order to match (we could build lookup but we want Verify to be quicker than Sign which does order sigs)
-	for i, s := range txEnv.Signatories {
-		if inputs[i].Address != *s.Address {
+	i := 0
+	for j, in := range inputs {
+		if ms, ok := multisigs[in.Address]; ok {
+			err = verifyMultisig(ms, in.Address, signBytes, txEnv.Signatories[i:i+int(ms.Threshold)])
+			if err != nil {
+				return err
+			}
+			i += int(ms.Threshold)
+			continue
+		}
+		s := txEnv.Signatories[i]
+		if in.Address != *s.Address {
 			return fmt.Errorf("signatory %v has address %v but input %v has address %v",
-				i, *s.Address, i, inputs[i].Address)
+				i, *s.Address, j, in.Address)
+		}
+		if acc := accounts[in.Address]; acc != nil && acc.PublicKey.IsSet() {
+			if !bytes.Equal(acc.PublicKey.EncodeFixedWidth(), s.PublicKey.EncodeFixedWidth()) {
+				return fmt.Errorf("signatory %v has public key %v but the account has public key %v",
+					i, *s.PublicKey, acc.PublicKey)
+			}
+		} else if s.PublicKey.GetAddress() != in.Address {
+			return fmt.Errorf("signatory %v has public key %v whose address does not match input %v address %v",
+				i, *s.PublicKey, j, in.Address)
 		}
 		err = s.PublicKey.Verify(signBytes, s.Signature)
 		if err != nil {
 			return fmt.Errorf("invalid signature in signatory %v: %v", *s.Address, err)
 		}
+		i++
 	}
 	return nil
 }
@@ -129,22 +175,29 @@ func (txEnv *Envelope) Verify(getter acmstate.AccountGetter, chainID string) err
 // Sign the Tx Envelope by adding Signatories containing the signatures for each TxInput.
 // signing accounts for each input must be provided (in any order).
 func (txEnv *Envelope) Sign(signingAccounts ...acm.AddressableSigner) error {
+	return txEnv.SignMultisig(nil, signingAccounts...)
+}
+
+// Sign the Tx Envelope like Sign, but sign the inputs of any multisig accounts, as found with getter, with the first
+// threshold number of their keys for which signing accounts are provided
+func (txEnv *Envelope) SignMultisig(getter acmstate.AccountGetter, signingAccounts ...acm.AddressableSigner) error {
 	// Clear any existing
 	txEnv.Signatories = nil
 	signBytes, err := txEnv.Tx.SignBytes()
 	if err != nil {
 		return err
 	}
+	inputs := txEnv.Tx.GetInputs()
+	accounts, err := getInputAccounts(getter, inputs)
+	if err != nil {
+		return err
+	}
+	multisigs := getMultisigs(accounts)
 	signingAccountMap := make(map[crypto.Address]acm.AddressableSigner)
 	for _, sa := range signingAccounts {
 		signingAccountMap[sa.GetAddress()] = sa
 	}
-	// Sign in order of inputs
-	for i, in := range txEnv.Tx.GetInputs() {
-		sa, ok := signingAccountMap[in.Address]
-		if !ok {
-			return fmt.Errorf("account to sign %v (position %v) not passed to Sign, passed: %v", in, i, signingAccounts)
-		}
+	sign := func(sa acm.AddressableSigner) error {
 		sig, err := sa.Sign(signBytes)
 		if err != nil {
 			return err
@@ -156,6 +209,94 @@ func (txEnv *Envelope) Sign(signingAccounts ...acm.AddressableSigner) error {
 			PublicKey: &publicKey,
 			Signature: sig,
 		})
+		return nil
+	}
+	// Sign in order of inputs
+	for i, in := range inputs {
+		if ms, ok := multisigs[in.Address]; ok {
+			var signed uint64
+			for _, publicKey := range ms.PublicKeys {
+				sa, ok := signingAccountMap[publicKey.GetAddress()]
+				if !ok {
+					continue
+				}
+				err = sign(sa)
+				if err != nil {
+					return err
+				}
+				signed++
+				if signed == ms.Threshold {
+					break
+				}
+			}
+			if signed < ms.Threshold {
+				return fmt.Errorf("multisig account %v (position %v) needs %v signatures but only %v of its keys "+
+					"were passed to Sign", in.Address, i, ms.Threshold, signed)
+			}
+			continue
+		}
+		sa, ok := signingAccountMap[in.Address]
+		if !ok {
+			return fmt.Errorf("account to sign %v (position %v) not passed to Sign, passed: %v", in, i, signingAccounts)
+		}
+		err = sign(sa)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Returns the accounts of inputs that exist
+func getInputAccounts(getter acmstate.AccountGetter, inputs []*payload.TxInput) (map[crypto.Address]*acm.Account, error) {
+	accounts := make(map[crypto.Address]*acm.Account)
+	if getter == nil {
+		return accounts, nil
+	}
+	for _, in := range inputs {
+		acc, err := getter.GetAccount(in.Address)
+		if err != nil {
+			return nil, fmt.Errorf("could not get input account %v: %v", in.Address, err)
+		}
+		if acc != nil {
+			accounts[in.Address] = acc
+		}
+	}
+	return accounts, nil
+}
+
+// Returns the multisigs of those accounts that are multisig accounts
+func getMultisigs(accounts map[crypto.Address]*acm.Account) map[crypto.Address]*acm.Multisig {
+	multisigs := make(map[crypto.Address]*acm.Multisig)
+	for address, acc := range accounts {
+		if acc.Multisig != nil {
+			multisigs[address] = acc.Multisig
+		}
+	}
+	return multisigs
+}
+
+// Checks signatories are signatures by distinct keys of the multisig in the order they are held
+func verifyMultisig(ms *acm.Multisig, address crypto.Address, signBytes []byte, signatories []Signatory) error {
+	k := 0
+	for _, s := range signatories {
+		for k < len(ms.PublicKeys) && ms.PublicKeys[k].GetAddress() != *s.Address {
+			k++
+		}
+		if k == len(ms.PublicKeys) {
+			return fmt.Errorf("signatory %v is not one of the keys of multisig account %v, or is not in the "+
+				"order of its keys", *s.Address, address)
+		}
+		publicKey := ms.PublicKeys[k]
+		if !bytes.Equal(publicKey.EncodeFixedWidth(), s.PublicKey.EncodeFixedWidth()) {
+			return fmt.Errorf("signatory %v has a different public key to multisig account %v", *s.Address, address)
+		}
+		err := publicKey.Verify(signBytes, s.Signature)
+		if err != nil {
+			return fmt.Errorf("invalid signature in signatory %v for multisig account %v: %v", *s.Address, address,
+				err)
+		}
+		k++
 	}
 	return nil
 }
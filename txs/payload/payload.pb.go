@@ -51,6 +51,30 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
+type Vote_VoteChoice int32
+
+const (
+	Vote_FOR     Vote_VoteChoice = 0
+	Vote_AGAINST Vote_VoteChoice = 1
+	Vote_ABSTAIN Vote_VoteChoice = 2
+)
+
+var Vote_VoteChoice_name = map[int32]string{
+	0: "FOR",
+	1: "AGAINST",
+	2: "ABSTAIN",
+}
+var Vote_VoteChoice_value = map[string]int32{
+	"FOR":     0,
+	"AGAINST": 1,
+	"ABSTAIN": 2,
+}
+
+func (x Vote_VoteChoice) String() string {
+	return proto.EnumName(Vote_VoteChoice_name, int32(x))
+}
+func (Vote_VoteChoice) EnumDescriptor() ([]byte, []int) { return fileDescriptorPayload, []int{12, 0} }
+
 type Ballot_ProposalState int32
 
 const (
@@ -58,17 +82,21 @@ const (
 	Ballot_PROPOSED Ballot_ProposalState = 0
 	Ballot_EXECUTED Ballot_ProposalState = 1
 	Ballot_FAILED   Ballot_ProposalState = 2
+	// The proposal was not executed by its ExpiryHeight
+	Ballot_EXPIRED Ballot_ProposalState = 3
 )
 
 var Ballot_ProposalState_name = map[int32]string{
 	0: "PROPOSED",
 	1: "EXECUTED",
 	2: "FAILED",
+	3: "EXPIRED",
 }
 var Ballot_ProposalState_value = map[string]int32{
 	"PROPOSED": 0,
 	"EXECUTED": 1,
 	"FAILED":   2,
+	"EXPIRED":  3,
 }
 
 func (x Ballot_ProposalState) String() string {
@@ -230,6 +258,8 @@ type CallTx struct {
 	Fee uint64 `protobuf:"varint,4,opt,name=Fee,proto3" json:"Fee,omitempty"`
 	// EVM bytecode payload
 	Data github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,5,opt,name=Data,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Data"`
+	// Metadata (such as the ABI) to store alongside the code if we are creating a contract
+	ContractMeta string `protobuf:"bytes,6,opt,name=ContractMeta,proto3" json:"ContractMeta,omitempty"`
 }
 
 func (m *CallTx) Reset()                    { *m = CallTx{} }
@@ -257,6 +287,13 @@ func (m *CallTx) GetFee() uint64 {
 	return 0
 }
 
+func (m *CallTx) GetContractMeta() string {
+	if m != nil {
+		return m.ContractMeta
+	}
+	return ""
+}
+
 func (*CallTx) XXX_MessageName() string {
 	return "payload.CallTx"
 }
@@ -400,6 +437,10 @@ type ProposalTx struct {
 	VotingWeight int64                                          `protobuf:"varint,2,opt,name=VotingWeight,proto3" json:"VotingWeight,omitempty"`
 	ProposalHash *github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,3,opt,name=ProposalHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"ProposalHash,omitempty"`
 	Proposal     *Proposal                                      `protobuf:"bytes,4,opt,name=Proposal" json:"Proposal,omitempty"`
+	// How the inputs vote
+	Choice Vote_VoteChoice `protobuf:"varint,5,opt,name=Choice,proto3,enum=payload.Vote_VoteChoice" json:"Choice,omitempty"`
+	// Withdraw the inputs' votes from the proposal rather than voting
+	Withdraw bool `protobuf:"varint,6,opt,name=Withdraw,proto3" json:"Withdraw,omitempty"`
 }
 
 func (m *ProposalTx) Reset()                    { *m = ProposalTx{} }
@@ -426,6 +467,7 @@ func (*BatchTx) XXX_MessageName() string {
 type Vote struct {
 	Address      github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
 	VotingWeight int64                                        `protobuf:"varint,2,opt,name=VotingWeight,proto3" json:"VotingWeight,omitempty"`
+	Choice       Vote_VoteChoice                              `protobuf:"varint,3,opt,name=Choice,proto3,enum=payload.Vote_VoteChoice" json:"Choice,omitempty"`
 }
 
 func (m *Vote) Reset()                    { *m = Vote{} }
@@ -440,6 +482,11 @@ type Proposal struct {
 	Name        string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
 	Description string   `protobuf:"bytes,2,opt,name=Description,proto3" json:"Description,omitempty"`
 	BatchTx     *BatchTx `protobuf:"bytes,3,opt,name=BatchTx" json:"BatchTx,omitempty"`
+	// The last block height at which the proposal may be voted on and executed, or 0 if it does not expire
+	ExpiryHeight uint64 `protobuf:"varint,4,opt,name=ExpiryHeight,proto3" json:"ExpiryHeight,omitempty"`
+	// The number of accounts that must vote for, against or abstain before the proposal can execute, which may raise
+	// but not lower the chain's ProposalQuorum
+	Quorum uint64 `protobuf:"varint,5,opt,name=Quorum,proto3" json:"Quorum,omitempty"`
 }
 
 func (m *Proposal) Reset()                    { *m = Proposal{} }
@@ -517,6 +564,8 @@ func init() {
 	golang_proto.RegisterType((*Proposal)(nil), "payload.Proposal")
 	proto.RegisterType((*Ballot)(nil), "payload.Ballot")
 	golang_proto.RegisterType((*Ballot)(nil), "payload.Ballot")
+	proto.RegisterEnum("payload.Vote_VoteChoice", Vote_VoteChoice_name, Vote_VoteChoice_value)
+	golang_proto.RegisterEnum("payload.Vote_VoteChoice", Vote_VoteChoice_name, Vote_VoteChoice_value)
 	proto.RegisterEnum("payload.Ballot_ProposalState", Ballot_ProposalState_name, Ballot_ProposalState_value)
 	golang_proto.RegisterEnum("payload.Ballot_ProposalState", Ballot_ProposalState_name, Ballot_ProposalState_value)
 }
@@ -748,6 +797,12 @@ func (m *CallTx) MarshalTo(dAtA []byte) (int, error) {
 		return 0, err
 	}
 	i += n14
+	if len(m.ContractMeta) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(len(m.ContractMeta)))
+		i += copy(dAtA[i:], m.ContractMeta)
+	}
 	return i, nil
 }
 
@@ -1049,6 +1104,21 @@ func (m *ProposalTx) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n22
 	}
+	if m.Choice != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.Choice))
+	}
+	if m.Withdraw {
+		dAtA[i] = 0x30
+		i++
+		if m.Withdraw {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -1122,6 +1192,11 @@ func (m *Vote) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.VotingWeight))
 	}
+	if m.Choice != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.Choice))
+	}
 	return i, nil
 }
 
@@ -1162,6 +1237,16 @@ func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n24
 	}
+	if m.ExpiryHeight != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.ExpiryHeight))
+	}
+	if m.Quorum != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.Quorum))
+	}
 	return i, nil
 }
 
@@ -1315,6 +1400,10 @@ func (m *CallTx) Size() (n int) {
 	}
 	l = m.Data.Size()
 	n += 1 + l + sovPayload(uint64(l))
+	l = len(m.ContractMeta)
+	if l > 0 {
+		n += 1 + l + sovPayload(uint64(l))
+	}
 	return n
 }
 
@@ -1438,6 +1527,12 @@ func (m *ProposalTx) Size() (n int) {
 		l = m.Proposal.Size()
 		n += 1 + l + sovPayload(uint64(l))
 	}
+	if m.Choice != 0 {
+		n += 1 + sovPayload(uint64(m.Choice))
+	}
+	if m.Withdraw {
+		n += 2
+	}
 	return n
 }
 
@@ -1467,6 +1562,9 @@ func (m *Vote) Size() (n int) {
 	if m.VotingWeight != 0 {
 		n += 1 + sovPayload(uint64(m.VotingWeight))
 	}
+	if m.Choice != 0 {
+		n += 1 + sovPayload(uint64(m.Choice))
+	}
 	return n
 }
 
@@ -1485,6 +1583,12 @@ func (m *Proposal) Size() (n int) {
 		l = m.BatchTx.Size()
 		n += 1 + l + sovPayload(uint64(l))
 	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovPayload(uint64(m.ExpiryHeight))
+	}
+	if m.Quorum != 0 {
+		n += 1 + sovPayload(uint64(m.Quorum))
+	}
 	return n
 }
 
@@ -2306,6 +2410,35 @@ func (m *CallTx) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractMeta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractMeta = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPayload(dAtA[iNdEx:])
@@ -3214,6 +3347,45 @@ func (m *ProposalTx) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Choice", wireType)
+			}
+			m.Choice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Choice |= (Vote_VoteChoice(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdraw", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Withdraw = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPayload(dAtA[iNdEx:])
@@ -3425,6 +3597,25 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Choice", wireType)
+			}
+			m.Choice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Choice |= (Vote_VoteChoice(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPayload(dAtA[iNdEx:])
@@ -3566,6 +3757,44 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			m.Quorum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quorum |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPayload(dAtA[iNdEx:])
@@ -3861,65 +4090,73 @@ func init() { proto.RegisterFile("payload.proto", fileDescriptorPayload) }
 func init() { golang_proto.RegisterFile("payload.proto", fileDescriptorPayload) }
 
 var fileDescriptorPayload = []byte{
-	// 958 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4b, 0x6f, 0x23, 0x45,
-	0x10, 0x4e, 0x67, 0xc6, 0x8f, 0xad, 0x75, 0x82, 0xb7, 0x79, 0xc8, 0x8a, 0x84, 0xbd, 0x32, 0x08,
-	0x96, 0x47, 0x6c, 0xd8, 0xe5, 0x21, 0xe5, 0x82, 0x3c, 0xb1, 0xb3, 0x09, 0x5a, 0x25, 0x51, 0x67,
-	0xb2, 0x20, 0x24, 0x0e, 0x63, 0xbb, 0xb1, 0x47, 0x8c, 0xa7, 0x87, 0x99, 0xf6, 0x32, 0xe6, 0xc4,
-	0x81, 0x03, 0x57, 0xc4, 0x85, 0x63, 0x0e, 0xfc, 0x01, 0xfe, 0x01, 0xc7, 0x1c, 0x39, 0x73, 0x58,
-	0xa1, 0xec, 0x85, 0xff, 0xc0, 0x05, 0x75, 0x4f, 0xf7, 0xb8, 0xed, 0x85, 0x5d, 0x27, 0xa0, 0xbd,
-	0x4d, 0x55, 0x7d, 0xdd, 0x55, 0xf5, 0xd5, 0xa3, 0x07, 0x36, 0x22, 0x6f, 0x16, 0x30, 0x6f, 0xd8,
-	0x8a, 0x62, 0xc6, 0x19, 0x2e, 0x29, 0x71, 0x6b, 0x7b, 0xe4, 0xf3, 0xf1, 0xb4, 0xdf, 0x1a, 0xb0,
-	0x49, 0x7b, 0xc4, 0x46, 0xac, 0x2d, 0xed, 0xfd, 0xe9, 0x17, 0x52, 0x92, 0x82, 0xfc, 0xca, 0xce,
-	0x6d, 0x55, 0x23, 0x1a, 0x4f, 0xfc, 0x24, 0xf1, 0x59, 0xa8, 0x34, 0x90, 0x44, 0x74, 0x90, 0x7d,
-	0x37, 0x7f, 0xb0, 0xc0, 0xea, 0x84, 0x33, 0xfc, 0x3a, 0x14, 0x77, 0xbd, 0x20, 0x70, 0xd3, 0x1a,
-	0xba, 0x89, 0x6e, 0x5d, 0xbf, 0xfd, 0x5c, 0x4b, 0x7b, 0xcf, 0xd4, 0x44, 0x99, 0x05, 0xf0, 0x84,
-	0x86, 0x43, 0x37, 0xad, 0xad, 0x2f, 0x01, 0x33, 0x35, 0x51, 0x66, 0x01, 0x3c, 0xf4, 0x26, 0xd4,
-	0x4d, 0x6b, 0xd6, 0x12, 0x30, 0x53, 0x13, 0x65, 0xc6, 0x6f, 0x42, 0xe9, 0x98, 0xc6, 0x93, 0xc4,
-	0x4d, 0x6b, 0xb6, 0x44, 0x56, 0x73, 0xa4, 0xd2, 0x13, 0x0d, 0xc0, 0xaf, 0x42, 0xe1, 0x2e, 0x7b,
-	0xe0, 0xa6, 0xb5, 0x82, 0x44, 0x6e, 0xe6, 0x48, 0xa9, 0x25, 0x99, 0x51, 0xb8, 0x76, 0x98, 0x8c,
-	0xb1, 0xb8, 0xe4, 0x3a, 0x53, 0x13, 0x65, 0xc6, 0xdb, 0x50, 0x3e, 0x0d, 0xfb, 0x19, 0xb4, 0x24,
-	0xa1, 0x37, 0x72, 0xa8, 0x36, 0x90, 0x1c, 0x22, 0x22, 0x75, 0x3c, 0x3e, 0x18, 0xbb, 0x69, 0xad,
-	0xbc, 0x14, 0xa9, 0xd2, 0x13, 0x0d, 0xc0, 0x77, 0x00, 0x8e, 0x63, 0x16, 0xb1, 0xc4, 0x13, 0xa4,
-	0x5e, 0x93, 0xf0, 0xe7, 0xe7, 0x89, 0xe5, 0x26, 0x62, 0xc0, 0x76, 0xec, 0xf3, 0xb3, 0x06, 0x6a,
-	0xfe, 0x88, 0xa0, 0xe4, 0xa6, 0x07, 0x61, 0x34, 0xe5, 0xf8, 0x10, 0x4a, 0x9d, 0xe1, 0x30, 0xa6,
-	0x49, 0x22, 0x0b, 0x53, 0x71, 0xde, 0x3b, 0x7f, 0xd8, 0x58, 0xfb, 0xfd, 0x61, 0xe3, 0x6d, 0xa3,
-	0x0b, 0xc6, 0xb3, 0x88, 0xc6, 0x01, 0x1d, 0x8e, 0x68, 0xdc, 0xee, 0x4f, 0xe3, 0x98, 0x7d, 0xdd,
-	0x1e, 0xc4, 0xb3, 0x88, 0xb3, 0x96, 0x3a, 0x4b, 0xf4, 0x25, 0xf8, 0x25, 0x28, 0x76, 0x26, 0x6c,
-	0x1a, 0x72, 0x59, 0x3e, 0x9b, 0x28, 0x09, 0x6f, 0x41, 0xf9, 0x84, 0x7e, 0x35, 0xa5, 0xe1, 0x80,
-	0xca, 0x7a, 0xd9, 0x24, 0x97, 0x77, 0xec, 0x9f, 0xce, 0x1a, 0x6b, 0xcd, 0x14, 0xca, 0x6e, 0x7a,
-	0x34, 0xe5, 0xcf, 0x30, 0x2a, 0xe5, 0xf9, 0x2f, 0xa4, 0x9b, 0x13, 0xbf, 0x06, 0x05, 0xc9, 0x8b,
-	0xea, 0xd2, 0x39, 0xff, 0x8a, 0x2f, 0x92, 0x99, 0xf1, 0xc7, 0xf3, 0x00, 0xd7, 0x65, 0x80, 0xef,
-	0x5c, 0x3d, 0xb8, 0x2d, 0x28, 0xdf, 0xf5, 0x92, 0x7b, 0xfe, 0xc4, 0xe7, 0x9a, 0x1a, 0x2d, 0xe3,
-	0x2a, 0x58, 0x7b, 0x94, 0xca, 0xbe, 0xb5, 0x89, 0xf8, 0xc4, 0x07, 0x60, 0x77, 0x3d, 0xee, 0xc9,
-	0x06, 0xad, 0x38, 0xef, 0x2b, 0x5e, 0xb6, 0x9f, 0xec, 0xba, 0xef, 0x87, 0x5e, 0x3c, 0x6b, 0xed,
-	0xd3, 0xd4, 0x99, 0x71, 0x9a, 0x10, 0x79, 0x85, 0xca, 0xde, 0xd7, 0x03, 0x87, 0x6f, 0x41, 0x51,
-	0x66, 0x27, 0x48, 0xb7, 0xfe, 0x31, 0x7b, 0x65, 0xc7, 0x6f, 0x41, 0x29, 0xab, 0x94, 0x48, 0xdf,
-	0x5a, 0x68, 0x6b, 0x5d, 0x43, 0xa2, 0x11, 0x3b, 0xe5, 0xef, 0xcf, 0x1a, 0x6b, 0xd2, 0x15, 0xcb,
-	0x27, 0x71, 0x65, 0xa2, 0x3f, 0x80, 0xb2, 0x38, 0xd2, 0x89, 0x47, 0x89, 0x5a, 0x08, 0x2f, 0xb4,
-	0x8c, 0x85, 0xa3, 0x6d, 0x8e, 0x2d, 0x88, 0x20, 0x39, 0x56, 0xe5, 0x16, 0xe9, 0x1d, 0xb1, 0xb2,
-	0x3f, 0x0c, 0xb6, 0x38, 0x21, 0x7d, 0x5d, 0x23, 0xf2, 0x5b, 0xe8, 0x24, 0xe5, 0x56, 0xa6, 0x13,
-	0xdf, 0x8f, 0x17, 0x46, 0x79, 0xfc, 0x52, 0xaf, 0x86, 0x4b, 0xb0, 0x39, 0xdf, 0x12, 0xec, 0xdf,
-	0xe9, 0xcc, 0x21, 0x06, 0x9f, 0x3f, 0xa3, 0xf9, 0x7e, 0x59, 0x39, 0xc3, 0xc3, 0xe5, 0xd6, 0xfd,
-	0xef, 0xb3, 0xb5, 0x4f, 0xfd, 0xd1, 0x58, 0x37, 0xaf, 0x92, 0x8c, 0x30, 0xbf, 0x45, 0x6a, 0xab,
-	0x5e, 0x82, 0x93, 0x5d, 0xd8, 0xec, 0x0c, 0x06, 0x62, 0x48, 0x4f, 0xa3, 0xa1, 0xc7, 0xa9, 0x6e,
-	0xb4, 0x17, 0x5b, 0xf2, 0x71, 0x71, 0xe9, 0x24, 0x0a, 0x3c, 0x4e, 0x15, 0x46, 0x96, 0x1f, 0x91,
-	0xa5, 0x23, 0x46, 0x08, 0x7f, 0x22, 0x73, 0x5d, 0xae, 0xcc, 0x55, 0x13, 0x2a, 0xf7, 0x19, 0xf7,
-	0xc3, 0xd1, 0x27, 0x59, 0x86, 0x82, 0x30, 0x8b, 0x2c, 0xe8, 0xf0, 0x29, 0x54, 0xf4, 0xcd, 0xfb,
-	0x5e, 0x32, 0x96, 0x2c, 0x54, 0x9c, 0x77, 0x2f, 0x3f, 0x94, 0x0b, 0xd7, 0x88, 0xa6, 0xd0, 0xb2,
-	0x7a, 0xb6, 0x6e, 0x3c, 0xb6, 0xdd, 0x49, 0x0e, 0x31, 0x52, 0xfd, 0x3c, 0x7f, 0x44, 0x2e, 0x41,
-	0x77, 0x1d, 0x2c, 0x37, 0xd5, 0x1c, 0x57, 0x72, 0x58, 0x27, 0x9c, 0x11, 0x61, 0x30, 0xae, 0xff,
-	0x0e, 0x81, 0x7d, 0x9f, 0x71, 0xfa, 0xbf, 0xef, 0xe8, 0x15, 0xb8, 0x36, 0xc2, 0x78, 0x30, 0xa7,
-	0x27, 0x9f, 0x59, 0x64, 0xcc, 0xec, 0x4d, 0xb8, 0xde, 0xa5, 0xc9, 0x20, 0xf6, 0x23, 0xee, 0xb3,
-	0x50, 0x8d, 0xb3, 0xa9, 0x32, 0x1f, 0x5b, 0xeb, 0x29, 0x8f, 0xad, 0xe1, 0xf7, 0x97, 0x75, 0x28,
-	0x3a, 0x5e, 0x10, 0x30, 0xbe, 0x50, 0x21, 0xf4, 0xd4, 0x0a, 0x89, 0x3e, 0xd9, 0xf3, 0x43, 0x2f,
-	0xf0, 0xbf, 0xf1, 0xc3, 0x91, 0xfa, 0xbd, 0xb9, 0x5a, 0x9f, 0x98, 0xd7, 0xe0, 0x5d, 0xd8, 0x88,
-	0x94, 0x8b, 0x13, 0xee, 0xf1, 0x6c, 0x25, 0x6d, 0xde, 0x7e, 0xd9, 0x48, 0x46, 0x44, 0x9b, 0x47,
-	0x24, 0x41, 0x64, 0xf1, 0x0c, 0x7e, 0x05, 0x0a, 0xa2, 0xa6, 0x49, 0xad, 0x20, 0x1b, 0x60, 0x23,
-	0x3f, 0x2c, 0xb4, 0x24, 0xb3, 0x35, 0x3f, 0x84, 0x8d, 0x85, 0x4b, 0x70, 0x05, 0xca, 0xc7, 0xe4,
-	0xe8, 0xf8, 0xe8, 0xa4, 0xd7, 0xad, 0xae, 0x09, 0xa9, 0xf7, 0x69, 0x6f, 0xf7, 0xd4, 0xed, 0x75,
-	0xab, 0x08, 0x03, 0x14, 0xf7, 0x3a, 0x07, 0xf7, 0x7a, 0xdd, 0xea, 0xba, 0xf3, 0xd1, 0xf9, 0x45,
-	0x1d, 0xfd, 0x76, 0x51, 0x47, 0x7f, 0x5c, 0xd4, 0xd1, 0xaf, 0x8f, 0xea, 0xe8, 0xfc, 0x51, 0x1d,
-	0x7d, 0xf6, 0xc6, 0x93, 0xb3, 0xe6, 0x69, 0xd2, 0x56, 0x51, 0xf4, 0x8b, 0xf2, 0x5f, 0xf2, 0xce,
-	0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xa2, 0x4c, 0xec, 0xa1, 0xb2, 0x0a, 0x00, 0x00,
+	// 1081 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcd, 0x6f, 0xe3, 0x44,
+	0x14, 0xaf, 0x63, 0x37, 0xc9, 0xbe, 0xba, 0xc5, 0x3b, 0x7c, 0x28, 0xaa, 0x44, 0xba, 0x0a, 0x08,
+	0x96, 0x8f, 0x26, 0x4b, 0x17, 0x38, 0xf4, 0x82, 0xec, 0x24, 0x6d, 0x83, 0x96, 0x36, 0x4c, 0x9c,
+	0xdd, 0x15, 0x12, 0x07, 0xc7, 0x19, 0x12, 0x8b, 0xc4, 0x63, 0xec, 0x09, 0xeb, 0x70, 0xe2, 0xc8,
+	0x15, 0x71, 0xe1, 0x46, 0x0f, 0xfc, 0x05, 0x1c, 0x38, 0x22, 0x8e, 0x3d, 0x72, 0xe6, 0xb0, 0x42,
+	0xdd, 0x03, 0xff, 0x06, 0x9a, 0xf1, 0xd8, 0x71, 0xb2, 0xb0, 0x9b, 0x2e, 0x88, 0x8b, 0x35, 0xef,
+	0xbd, 0xdf, 0xcc, 0x7b, 0xf3, 0x7b, 0x1f, 0x63, 0xd8, 0x0e, 0x9c, 0xf9, 0x84, 0x3a, 0xc3, 0x7a,
+	0x10, 0x52, 0x46, 0x51, 0x49, 0x8a, 0xbb, 0xfb, 0x23, 0x8f, 0x8d, 0x67, 0x83, 0xba, 0x4b, 0xa7,
+	0x8d, 0x11, 0x1d, 0xd1, 0x86, 0xb0, 0x0f, 0x66, 0x9f, 0x09, 0x49, 0x08, 0x62, 0x95, 0xec, 0xdb,
+	0x35, 0x02, 0x12, 0x4e, 0xbd, 0x28, 0xf2, 0xa8, 0x2f, 0x35, 0x10, 0x05, 0xc4, 0x4d, 0xd6, 0xb5,
+	0x6f, 0x55, 0x50, 0x4d, 0x7f, 0x8e, 0x5e, 0x87, 0x62, 0xd3, 0x99, 0x4c, 0xec, 0xb8, 0xa2, 0xdc,
+	0x50, 0x6e, 0x6e, 0x1d, 0x3c, 0x57, 0x4f, 0xbd, 0x27, 0x6a, 0x2c, 0xcd, 0x1c, 0xd8, 0x23, 0xfe,
+	0xd0, 0x8e, 0x2b, 0x85, 0x15, 0x60, 0xa2, 0xc6, 0xd2, 0xcc, 0x81, 0xa7, 0xce, 0x94, 0xd8, 0x71,
+	0x45, 0x5d, 0x01, 0x26, 0x6a, 0x2c, 0xcd, 0xe8, 0x4d, 0x28, 0x75, 0x49, 0x38, 0x8d, 0xec, 0xb8,
+	0xa2, 0x09, 0xa4, 0x91, 0x21, 0xa5, 0x1e, 0xa7, 0x00, 0xf4, 0x2a, 0x6c, 0x1e, 0xd3, 0x2f, 0xed,
+	0xb8, 0xb2, 0x29, 0x90, 0x3b, 0x19, 0x52, 0x68, 0x71, 0x62, 0xe4, 0xae, 0x2d, 0x2a, 0x62, 0x2c,
+	0xae, 0xb8, 0x4e, 0xd4, 0x58, 0x9a, 0xd1, 0x3e, 0x94, 0xfb, 0xfe, 0x20, 0x81, 0x96, 0x04, 0xf4,
+	0x7a, 0x06, 0x4d, 0x0d, 0x38, 0x83, 0xf0, 0x48, 0x2d, 0x87, 0xb9, 0x63, 0x3b, 0xae, 0x94, 0x57,
+	0x22, 0x95, 0x7a, 0x9c, 0x02, 0xd0, 0x6d, 0x80, 0x6e, 0x48, 0x03, 0x1a, 0x39, 0x9c, 0xd4, 0x6b,
+	0x02, 0xfe, 0xfc, 0xe2, 0x62, 0x99, 0x09, 0xe7, 0x60, 0x87, 0xda, 0xc5, 0xf9, 0x9e, 0x52, 0xfb,
+	0x4e, 0x81, 0x92, 0x1d, 0x77, 0xfc, 0x60, 0xc6, 0xd0, 0x29, 0x94, 0xcc, 0xe1, 0x30, 0x24, 0x51,
+	0x24, 0x12, 0xa3, 0x5b, 0xef, 0x5e, 0x3c, 0xdc, 0xdb, 0xf8, 0xfd, 0xe1, 0xde, 0xdb, 0xb9, 0x2a,
+	0x18, 0xcf, 0x03, 0x12, 0x4e, 0xc8, 0x70, 0x44, 0xc2, 0xc6, 0x60, 0x16, 0x86, 0xf4, 0x41, 0xc3,
+	0x0d, 0xe7, 0x01, 0xa3, 0x75, 0xb9, 0x17, 0xa7, 0x87, 0xa0, 0x97, 0xa0, 0x68, 0x4e, 0xe9, 0xcc,
+	0x67, 0x22, 0x7d, 0x1a, 0x96, 0x12, 0xda, 0x85, 0x72, 0x8f, 0x7c, 0x31, 0x23, 0xbe, 0x4b, 0x44,
+	0xbe, 0x34, 0x9c, 0xc9, 0x87, 0xda, 0xf7, 0xe7, 0x7b, 0x1b, 0xb5, 0x18, 0xca, 0x76, 0x7c, 0x36,
+	0x63, 0xff, 0x63, 0x54, 0xd2, 0xf3, 0x0f, 0x85, 0xb4, 0x38, 0xd1, 0x6b, 0xb0, 0x29, 0x78, 0xa9,
+	0x28, 0x2b, 0xfc, 0x4b, 0xbe, 0x70, 0x62, 0x46, 0x1f, 0x2e, 0x02, 0x2c, 0x88, 0x00, 0x6f, 0x3d,
+	0x7b, 0x70, 0xbb, 0x50, 0x3e, 0x76, 0xa2, 0x3b, 0xde, 0xd4, 0x63, 0x29, 0x35, 0xa9, 0x8c, 0x0c,
+	0x50, 0x8f, 0x08, 0x11, 0x75, 0xab, 0x61, 0xbe, 0x44, 0x1d, 0xd0, 0x5a, 0x0e, 0x73, 0x44, 0x81,
+	0xea, 0xd6, 0x7b, 0x92, 0x97, 0xfd, 0x27, 0xbb, 0x1e, 0x78, 0xbe, 0x13, 0xce, 0xeb, 0x27, 0x24,
+	0xb6, 0xe6, 0x8c, 0x44, 0x58, 0x1c, 0x81, 0x6a, 0xa0, 0x37, 0xa9, 0xcf, 0x42, 0xc7, 0x65, 0x1f,
+	0x11, 0xe6, 0x88, 0x62, 0xbe, 0x86, 0x97, 0x74, 0x92, 0x21, 0x2f, 0x6d, 0x4a, 0x74, 0x13, 0x8a,
+	0x82, 0x01, 0x9e, 0x18, 0xf5, 0x6f, 0x19, 0x92, 0x76, 0xf4, 0x16, 0x94, 0x92, 0x6c, 0x72, 0x8a,
+	0xd4, 0xa5, 0xd2, 0x4f, 0xf3, 0x8c, 0x53, 0xc4, 0x61, 0xf9, 0x9b, 0xf3, 0xbd, 0x0d, 0xe1, 0x8a,
+	0x66, 0xdd, 0xba, 0x76, 0x32, 0xde, 0x87, 0x32, 0xdf, 0x62, 0x86, 0xa3, 0x48, 0x0e, 0x8d, 0x17,
+	0xea, 0xb9, 0xa1, 0x94, 0xda, 0x2c, 0x8d, 0x93, 0x85, 0x33, 0xac, 0xbc, 0x5b, 0x90, 0xce, 0x91,
+	0xb5, 0xfd, 0x21, 0xd0, 0xf8, 0x0e, 0xe1, 0xeb, 0x1a, 0x16, 0x6b, 0xae, 0x13, 0x69, 0x51, 0x13,
+	0x1d, 0x5f, 0x3f, 0x9e, 0x3c, 0xe9, 0xf1, 0xf3, 0x74, 0x7c, 0x5c, 0x81, 0xcd, 0xc5, 0x24, 0xa1,
+	0xff, 0x4c, 0x67, 0x06, 0xc9, 0xf1, 0xf9, 0xa3, 0xb2, 0x98, 0x41, 0x6b, 0xdf, 0xf0, 0x74, 0xb5,
+	0xbc, 0xff, 0x7d, 0xff, 0x9d, 0x10, 0x6f, 0x34, 0x4e, 0x0b, 0x5c, 0x4a, 0xb9, 0x30, 0xbf, 0x56,
+	0xe4, 0xe4, 0xbd, 0x02, 0x27, 0x4d, 0xd8, 0x31, 0x5d, 0x97, 0x37, 0x72, 0x3f, 0x18, 0x3a, 0x8c,
+	0xa4, 0x85, 0xf6, 0x62, 0x5d, 0x3c, 0x40, 0x36, 0x99, 0x06, 0x13, 0x87, 0x11, 0x89, 0x11, 0xe9,
+	0x57, 0xf0, 0xca, 0x96, 0x5c, 0x08, 0x3f, 0x17, 0xf2, 0x23, 0x75, 0x6d, 0xae, 0x6a, 0xa0, 0xdf,
+	0xa5, 0xcc, 0xf3, 0x47, 0xf7, 0x92, 0x1b, 0x72, 0xc2, 0x54, 0xbc, 0xa4, 0x43, 0x7d, 0xd0, 0xd3,
+	0x93, 0x4f, 0x9c, 0x68, 0x2c, 0x58, 0xd0, 0xad, 0x77, 0xae, 0xde, 0xb8, 0x4b, 0xc7, 0xf0, 0xa2,
+	0x48, 0x65, 0xf9, 0xb4, 0x5d, 0x7f, 0xec, 0x05, 0xc0, 0x19, 0x04, 0xdd, 0x82, 0x62, 0x73, 0x4c,
+	0x3d, 0x97, 0x88, 0xe1, 0xb1, 0x73, 0x50, 0xc9, 0xc0, 0x77, 0x29, 0x23, 0xe2, 0x93, 0xd8, 0xb1,
+	0xc4, 0xf1, 0xd1, 0x74, 0xcf, 0x63, 0xe3, 0x61, 0xe8, 0x3c, 0x10, 0xd3, 0xa1, 0x8c, 0x33, 0x39,
+	0x47, 0xdc, 0xa7, 0xd9, 0xb3, 0x75, 0x85, 0xe4, 0x55, 0x41, 0xb5, 0xe3, 0x34, 0x63, 0x7a, 0x06,
+	0x33, 0xfd, 0x39, 0xe6, 0x86, 0xdc, 0xf1, 0x7f, 0x2a, 0xa0, 0xf1, 0xd8, 0xfe, 0xf3, 0x57, 0x61,
+	0x9d, 0xcc, 0x2d, 0x38, 0x53, 0xd7, 0xe3, 0xac, 0xd6, 0x00, 0x58, 0x68, 0x51, 0x09, 0xd4, 0xa3,
+	0x33, 0x6c, 0x6c, 0xa0, 0x2d, 0x28, 0x99, 0xc7, 0x66, 0xe7, 0xb4, 0x67, 0x1b, 0x8a, 0x10, 0xac,
+	0x9e, 0x6d, 0x76, 0x4e, 0x8d, 0x42, 0xee, 0xa6, 0x3f, 0x29, 0x8b, 0x84, 0x66, 0x53, 0x46, 0xc9,
+	0x4d, 0x99, 0x1b, 0xb0, 0xd5, 0x22, 0x91, 0x1b, 0x7a, 0x01, 0xf3, 0xa8, 0x2f, 0x07, 0x50, 0x5e,
+	0x95, 0xff, 0x85, 0x50, 0x9f, 0xf6, 0x0b, 0x51, 0x03, 0xbd, 0x1d, 0x07, 0x5e, 0x38, 0x97, 0xbd,
+	0x99, 0x0c, 0xaa, 0x25, 0x1d, 0xef, 0xdc, 0x8f, 0x67, 0x34, 0x9c, 0x4d, 0x45, 0xcd, 0x68, 0x58,
+	0x4a, 0xb9, 0xa0, 0x7f, 0x29, 0x40, 0xd1, 0x72, 0x26, 0x13, 0xca, 0x96, 0xea, 0x51, 0x79, 0x7a,
+	0x3d, 0xf6, 0x41, 0x3f, 0xf2, 0x7c, 0x67, 0xe2, 0x7d, 0xe5, 0xf9, 0x23, 0xf9, 0xc3, 0xf7, 0x6c,
+	0x5d, 0x91, 0x3f, 0x06, 0x35, 0x61, 0x3b, 0x90, 0x2e, 0x7a, 0xcc, 0x61, 0xc9, 0x00, 0xde, 0x39,
+	0x78, 0x39, 0x47, 0x04, 0x8f, 0xb6, 0xde, 0xcd, 0x83, 0xf0, 0xf2, 0x1e, 0xf4, 0x0a, 0x6c, 0xf2,
+	0x2c, 0x46, 0x95, 0x4d, 0x51, 0xa0, 0xdb, 0x4b, 0x69, 0xc7, 0x89, 0xad, 0xd6, 0x82, 0xed, 0xa5,
+	0x43, 0x90, 0x0e, 0xe5, 0x2e, 0x3e, 0xeb, 0x9e, 0xf5, 0xda, 0x2d, 0x63, 0x83, 0x4b, 0xed, 0xfb,
+	0xed, 0x66, 0xdf, 0x6e, 0xb7, 0x0c, 0x05, 0x01, 0x14, 0x8f, 0xcc, 0xce, 0x9d, 0x76, 0xcb, 0x28,
+	0xf0, 0xfc, 0xb7, 0xef, 0x77, 0x3b, 0xb8, 0xdd, 0x32, 0x54, 0xeb, 0x83, 0x8b, 0xcb, 0xaa, 0xf2,
+	0xdb, 0x65, 0x55, 0xf9, 0xe3, 0xb2, 0xaa, 0xfc, 0xfa, 0xa8, 0xaa, 0x5c, 0x3c, 0xaa, 0x2a, 0x9f,
+	0xbc, 0xf1, 0x64, 0x0a, 0x58, 0x1c, 0x35, 0x64, 0x48, 0x83, 0xa2, 0xf8, 0xd5, 0xbe, 0xfd, 0xd7,
+	0x00, 0x32, 0x33, 0xfc, 0x42, 0xd1, 0x0b, 0x00, 0x00,
 }
@@ -3,6 +3,7 @@ package config
 import (
 	"time"
 
+	"github.com/hyperledger/burrow/vent/sink"
 	"github.com/hyperledger/burrow/vent/types"
 )
 
@@ -21,6 +22,15 @@ type VentConfig struct {
 	DBBlockTx      bool
 	// Announce status every AnnouncePeriod
 	AnnounceEvery time.Duration
+	// Poll spec and ABI files for changes to apply every WatchEvery, disabled when zero
+	WatchEvery time.Duration
+	// Sink to write rows to, one of 'sql' (the database given by DBAdapter and DBURL), 'jsonfile' or 'webhook'
+	Sink string
+	// File to append rows to for the jsonfile sink or URL to post rows to for the webhook sink
+	SinkTarget string
+	// File holding the checkpoint of a jsonfile or webhook sink, defaults to SinkTarget with a .checkpoint suffix for
+	// the jsonfile sink
+	SinkCheckpoint string
 }
 
 // DefaultFlags returns a configuration with default values
@@ -34,5 +44,6 @@ func DefaultVentConfig() *VentConfig {
 		LogLevel:      "debug",
 		DBBlockTx:     false,
 		AnnounceEvery: time.Second * 5,
+		Sink:          sink.SQL,
 	}
 }
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"sync"
 	"time"
 
+	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/rpc"
 
 	"github.com/hyperledger/burrow/execution/exec"
@@ -15,6 +17,7 @@ import (
 	"github.com/hyperledger/burrow/rpc/rpcquery"
 	"github.com/hyperledger/burrow/vent/config"
 	"github.com/hyperledger/burrow/vent/logger"
+	"github.com/hyperledger/burrow/vent/sink"
 	"github.com/hyperledger/burrow/vent/sqldb"
 	"github.com/hyperledger/burrow/vent/sqlsol"
 	"github.com/hyperledger/burrow/vent/types"
@@ -25,60 +28,310 @@ import (
 
 // Consumer contains basic configuration for consumer to run
 type Consumer struct {
-	Config         *config.VentConfig
-	Log            *logger.Logger
-	Closing        bool
-	DB             *sqldb.SQLDB
+	Config  *config.VentConfig
+	Log     *logger.Logger
+	Closing bool
+	DB      *sqldb.SQLDB
+	// Sink to write rows to instead of the one configured, if set before running the consumer
+	Sink           sink.Sink
 	GRPCConnection *grpc.ClientConn
 	// external events channel used for when vent is leveraged as a library
 	EventsChannel chan types.EventData
+	Metrics       *Metrics
 	Status
+	// Protects Status, which is read when metrics are scraped
+	statusMtx sync.RWMutex
+	// the sink rows are being written to
+	out sink.Sink
 }
 
 // Status announcement
 type Status struct {
 	LastProcessedHeight uint64
-	Burrow              rpc.ResultStatus
+	// The latest height of the chain seen in a status update or a block received
+	ChainHeight uint64
+	Burrow      rpc.ResultStatus
 }
 
 // NewConsumer constructs a new consumer configuration.
 // The event channel will be passed a collection of rows generated from all of the events in a single block
 // It will be closed by the consumer when it is finished
 func NewConsumer(cfg *config.VentConfig, log *logger.Logger, eventChannel chan types.EventData) *Consumer {
-	return &Consumer{
+	consumer := &Consumer{
 		Config:        cfg,
 		Log:           log,
 		Closing:       false,
 		EventsChannel: eventChannel,
 	}
+	consumer.Metrics = NewMetrics(consumer)
+	return consumer
+}
+
+// LoadAbiSpec loads the ABIs in abiFileOrDirs, which may be empty when relying on ABIs stored on chain
+func LoadAbiSpec(abiFileOrDirs []string) (*abi.AbiSpec, error) {
+	if len(abiFileOrDirs) == 0 {
+		return abi.MergeAbiSpec(nil), nil
+	}
+	return abi.LoadPath(abiFileOrDirs...)
 }
 
 // Run connects to a grpc service and subscribes to log events,
 // then gets tables structures, maps them & parse event data.
 // Store data in SQL event tables, it runs forever
 func (c *Consumer) Run(projection *sqlsol.Projection, abiSpec *abi.AbiSpec, stream bool) error {
+	// fingerprint the files the projection was presumably loaded from as early as possible to catch later changes
+	fingerprint, err := specFingerprint(c.Config.SpecFileOrDirs, c.Config.AbiFileOrDirs)
+	if err != nil && c.Config.WatchEvery != 0 {
+		c.Log.Error("msg", "could not fingerprint spec and ABI files", "err", err)
+	}
+
+	chainStatus, err := c.connectChain()
+	if err != nil {
+		return err
+	}
+	defer c.GRPCConnection.Close()
+	defer close(c.EventsChannel)
+
+	if len(projection.EventSpec) == 0 {
+		c.Log.Info("msg", "No events specifications found")
+		return nil
+	}
+
+	if err = c.connectSink(chainStatus); err != nil {
+		return err
+	}
+	defer c.out.Close()
+
+	c.Log.Info("msg", "Synchronizing config and database projection structures")
+
+	start := time.Now()
+	err = c.out.SynchronizeDB(projection.Tables)
+	c.Metrics.observeDB("synchronize_db", start)
+	if err != nil {
+		return errors.Wrap(err, "Error trying to synchronize database")
+	}
+
+	c.Log.Info("msg", "Getting last processed block number from SQL log table")
+
+	// NOTE [Silas]: I am preserving the comment below that dates from the early days of Vent. I have looked at the
+	// bosmarmot git history and I cannot see why the original author thought that it was the case that there was
+	// no way of knowing if the last block of events was committed since the block and its associated log is
+	// committed atomically in a transaction and this is a core part of he design of Vent - in order that it does not
+	// repeat
+
+	// [ORIGINAL COMMENT]
+	// right now there is no way to know if the last block of events was completely read
+	// so we have to begin processing from the last block number stored in database
+	// and update event data if already present
+	start = time.Now()
+	fromBlock, err := c.out.GetLastBlockHeight()
+	c.Metrics.observeDB("get_last_block_height", start)
+	if err != nil {
+		return errors.Wrapf(err, "Error trying to get last processed block number from SQL log table")
+	}
+	c.setLastProcessedHeight(fromBlock)
+
+	startingBlock := fromBlock
+	// Start the block after the last one successfully committed - apart from if this is the first block
+	// We include block 0 because it is where we currently place dump/restored transactions
+	if startingBlock > 0 {
+		startingBlock++
+	}
+
+	// setup block range to get needed blocks server side
+	var end *rpcevents.Bound
+	if stream {
+		end = rpcevents.StreamBound()
+	} else {
+		end = rpcevents.LatestBound()
+	}
+
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+	go c.announceEvery(doneCh)
+	go c.countReconnects(doneCh)
+
+	// spec and ABI changes can only be picked up while streaming
+	var specCh chan *specReload
+	if stream && c.Config.WatchEvery != 0 {
+		specCh = make(chan *specReload)
+		go c.watchSpecs(fingerprint, doneCh, specCh)
+	}
+
+	return c.consumeBlocks(projection, abiSpec, rpcevents.NewBlockRange(rpcevents.AbsoluteBound(startingBlock), end),
+		specCh, false)
+}
+
+// Rewind connects to the SQL database and reverts all tables to their state at the given height, the next run of
+// the consumer resumes from the block following height
+func (c *Consumer) Rewind(height uint64) error {
+	chainStatus, err := c.connectChain()
+	if err != nil {
+		return err
+	}
+	defer c.GRPCConnection.Close()
+
+	if err = c.connectDB(chainStatus); err != nil {
+		return err
+	}
+	defer c.DB.Close()
+
+	lastHeight, err := c.DB.GetLastBlockHeight()
+	if err != nil {
+		return errors.Wrapf(err, "Error trying to get last processed block number from SQL log table")
+	}
+
+	if height > lastHeight {
+		return fmt.Errorf("cannot rewind to height %d beyond last processed height %d", height, lastHeight)
+	}
+
+	if err = c.DB.RewindToHeight(height); err != nil {
+		return errors.Wrapf(err, "Error trying to rewind database to height %d", height)
+	}
+
+	c.Log.Info("msg", "Rewound database", "height", height)
+	return nil
+}
+
+// Reproject drops the table tableName and rebuilds it from the chain's history up to the last processed height
+// using its current specification, all other tables are left untouched. It is intended to be run while the consumer
+// is stopped after the specification of a single table has changed
+func (c *Consumer) Reproject(projection *sqlsol.Projection, abiSpec *abi.AbiSpec, tableName string) error {
+	tableProjection, err := projection.ForTable(tableName)
+	if err != nil {
+		return err
+	}
+
+	chainStatus, err := c.connectChain()
+	if err != nil {
+		return err
+	}
+	defer c.GRPCConnection.Close()
+
+	if err = c.connectDB(chainStatus); err != nil {
+		return err
+	}
+	defer c.DB.Close()
+
+	lastHeight, err := c.DB.GetLastBlockHeight()
+	if err != nil {
+		return errors.Wrapf(err, "Error trying to get last processed block number from SQL log table")
+	}
+
+	if err = c.DB.ResetTable(tableProjection.Tables[tableName]); err != nil {
+		return errors.Wrapf(err, "Error trying to reset table %s", tableName)
+	}
+
+	c.Log.Info("msg", "Reprojecting table", "table", tableName, "height", lastHeight)
+
+	err = c.backfill(tableProjection, abiSpec, tableName, lastHeight)
+	if err != nil {
+		return err
+	}
+
+	// Rows written while back-filling are logged after those of the other tables so record where to resume from
+	return c.DB.MarkReprojected(tableName, lastHeight)
+}
+
+// backfill projects the chain's history up to and including height onto the single table covered by projection
+func (c *Consumer) backfill(projection *sqlsol.Projection, abiSpec *abi.AbiSpec, tableName string, height uint64) error {
+	c.Log.Info("msg", "Back-filling table", "table", tableName, "height", height)
+
+	return c.consumeBlocks(projection, abiSpec,
+		rpcevents.NewBlockRange(rpcevents.AbsoluteBound(0), rpcevents.AbsoluteBound(height)), nil, true)
+}
+
+// applySpec synchronizes the database with a reloaded projection, back-filling any new tables, and any new event
+// classes projecting into existing tables, with the chain's history below the height from which the reloaded
+// projection will be used
+func (c *Consumer) applySpec(projection *sqlsol.Projection, reload *specReload) error {
+	c.Log.Info("msg", "Applying reloaded projection", "height", reload.height)
+
+	err := c.out.SynchronizeDB(reload.projection.Tables)
+	if err != nil {
+		return errors.Wrap(err, "Error trying to synchronize database")
+	}
+
+	// Only the database keeps the log needed to back-fill, other sinks receive reloaded tables going forward
+	if _, ok := c.out.(*sqldb.SQLDB); !ok || reload.height == 0 {
+		return nil
+	}
+
+	// Creating, altering and back-filling tables all log rows that are not at the last processed height
+	var changed []string
+	for tableName, table := range reload.projection.Tables {
+		var history *sqlsol.Projection
+		oldTable, ok := projection.Tables[tableName]
+		if ok {
+			history, err = reload.projection.NewEventClasses(projection, tableName)
+		} else {
+			history, err = reload.projection.ForTable(tableName)
+		}
+		if err != nil {
+			return err
+		}
+
+		if history != nil {
+			err = c.backfill(history, reload.abiSpec, tableName, reload.height-1)
+			if err != nil {
+				return errors.Wrapf(err, "Error trying to back-fill table %s", tableName)
+			}
+		}
+		if !ok || history != nil || !sameColumns(oldTable, table) {
+			changed = append(changed, tableName)
+		}
+	}
+
+	// So record where to resume from once they are all done
+	for _, tableName := range changed {
+		err = c.DB.MarkReprojected(tableName, reload.height-1)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sameColumns returns true if the tables have columns of the same names
+func sameColumns(table, other *types.SQLTable) bool {
+	if len(table.Columns) != len(other.Columns) {
+		return false
+	}
+	for _, column := range table.Columns {
+		if other.GetColumn(column.Name) == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// connectChain connects to the Burrow gRPC server and returns the chain status
+func (c *Consumer) connectChain() (*rpc.ResultStatus, error) {
 	var err error
 
 	c.Log.Info("msg", "Connecting to Burrow gRPC server")
 
 	c.GRPCConnection, err = grpc.Dial(c.Config.GRPCAddr, grpc.WithInsecure())
 	if err != nil {
-		return errors.Wrapf(err, "Error connecting to Burrow gRPC server at %s", c.Config.GRPCAddr)
+		return nil, errors.Wrapf(err, "Error connecting to Burrow gRPC server at %s", c.Config.GRPCAddr)
 	}
-	defer c.GRPCConnection.Close()
-	defer close(c.EventsChannel)
 
 	// get the chain ID to compare with the one stored in the db
 	qCli := rpcquery.NewQueryClient(c.GRPCConnection)
 	chainStatus, err := qCli.Status(context.Background(), &rpcquery.StatusParam{})
 	if err != nil {
-		return errors.Wrapf(err, "Error getting chain status")
+		c.GRPCConnection.Close()
+		return nil, errors.Wrapf(err, "Error getting chain status")
 	}
+	c.setBurrowStatus(chainStatus)
 
-	if len(projection.EventSpec) == 0 {
-		c.Log.Info("msg", "No events specifications found")
-		return nil
-	}
+	return chainStatus, nil
+}
+
+// connectDB connects to the SQL database for the chain
+func (c *Consumer) connectDB(chainStatus *rpc.ResultStatus) error {
+	var err error
 
 	c.Log.Info("msg", "Connecting to SQL database")
 
@@ -93,63 +346,76 @@ func (c *Consumer) Run(projection *sqlsol.Projection, abiSpec *abi.AbiSpec, stre
 	if err != nil {
 		return fmt.Errorf("error connecting to SQL database: %v", err)
 	}
-	defer c.DB.Close()
+	c.out = c.DB
 
-	c.Log.Info("msg", "Synchronizing config and database projection structures")
+	return nil
+}
 
-	err = c.DB.SynchronizeDB(projection.Tables)
-	if err != nil {
-		return errors.Wrap(err, "Error trying to synchronize database")
+// connectSink connects to the sink rows are to be written to, that is Sink if it is set or the configured sink
+func (c *Consumer) connectSink(chainStatus *rpc.ResultStatus) error {
+	if c.Sink != nil {
+		c.out = c.Sink
+		return nil
+	}
+
+	var err error
+	cfg := c.Config
+
+	switch cfg.Sink {
+	case "", sink.SQL:
+		return c.connectDB(chainStatus)
+
+	case sink.JSONFile:
+		if cfg.SinkTarget == "" {
+			return fmt.Errorf("the %s sink requires a file to write to", cfg.Sink)
+		}
+		checkpointFile := cfg.SinkCheckpoint
+		if checkpointFile == "" {
+			checkpointFile = cfg.SinkTarget + ".checkpoint"
+		}
+		c.Log.Info("msg", "Writing to JSON file", "file", cfg.SinkTarget, "checkpoint", checkpointFile)
+		c.out, err = sink.NewJSONFileSink(cfg.SinkTarget, sink.NewCheckpoint(checkpointFile, chainStatus.ChainID),
+			chainStatus.ChainID)
+		return err
+
+	case sink.Webhook:
+		if cfg.SinkTarget == "" || cfg.SinkCheckpoint == "" {
+			return fmt.Errorf("the %s sink requires a URL to post to and a checkpoint file", cfg.Sink)
+		}
+		c.Log.Info("msg", "Posting to webhook", "url", cfg.SinkTarget, "checkpoint", cfg.SinkCheckpoint)
+		c.out = sink.NewWebhookSink(cfg.SinkTarget, sink.NewCheckpoint(cfg.SinkCheckpoint, chainStatus.ChainID),
+			chainStatus.ChainID, c.Log)
+		return nil
+
+	default:
+		return fmt.Errorf("unknown sink '%s', expected one of %s, %s or %s", cfg.Sink, sink.SQL, sink.JSONFile,
+			sink.Webhook)
 	}
+}
 
+// consumeBlocks streams the blocks in blockRange and commits the rows they project to the database, it returns
+// when the range is exhausted or the consumer is shut down. Projections received on specCh replace the current one
+// from the next block onwards. When back-filling history the blocks are not reflected in the consumer's status, which
+// tracks its progress through the chain.
+func (c *Consumer) consumeBlocks(projection *sqlsol.Projection, abiSpec *abi.AbiSpec, blockRange *rpcevents.BlockRange,
+	specCh <-chan *specReload, backfilling bool) error {
 	// doneCh is used for sending a "done" signal from each goroutine to the main thread
 	// eventCh is used for sending received events to the main thread to be stored in the db
+	// reloadCh is used for handing reloaded projections to the main thread to be applied to the db
 	doneCh := make(chan struct{})
 	errCh := make(chan error, 1)
 	eventCh := make(chan types.EventData)
+	reloadCh := make(chan *specReload)
 
 	go func() {
 		defer func() {
 			close(doneCh)
 		}()
-		go c.announceEvery(doneCh)
-
-		c.Log.Info("msg", "Getting last processed block number from SQL log table")
-
-		// NOTE [Silas]: I am preserving the comment below that dates from the early days of Vent. I have looked at the
-		// bosmarmot git history and I cannot see why the original author thought that it was the case that there was
-		// no way of knowing if the last block of events was committed since the block and its associated log is
-		// committed atomically in a transaction and this is a core part of he design of Vent - in order that it does not
-		// repeat
-
-		// [ORIGINAL COMMENT]
-		// right now there is no way to know if the last block of events was completely read
-		// so we have to begin processing from the last block number stored in database
-		// and update event data if already present
-		fromBlock, err := c.DB.GetLastBlockHeight()
-		if err != nil {
-			errCh <- errors.Wrapf(err, "Error trying to get last processed block number from SQL log table")
-			return
-		}
-
-		startingBlock := fromBlock
-		// Start the block after the last one successfully committed - apart from if this is the first block
-		// We include block 0 because it is where we currently place dump/restored transactions
-		if startingBlock > 0 {
-			startingBlock++
-		}
 
-		// setup block range to get needed blocks server side
 		cli := rpcevents.NewExecutionEventsClient(c.GRPCConnection)
-		var end *rpcevents.Bound
-		if stream {
-			end = rpcevents.StreamBound()
-		} else {
-			end = rpcevents.LatestBound()
-		}
 
 		request := &rpcevents.BlocksRequest{
-			BlockRange: rpcevents.NewBlockRange(rpcevents.AbsoluteBound(startingBlock), end),
+			BlockRange: blockRange,
 		}
 
 		// gets blocks in given range based on last processed block taken from database
@@ -163,7 +429,8 @@ func (c *Consumer) Run(projection *sqlsol.Projection, abiSpec *abi.AbiSpec, stre
 
 		c.Log.Debug("msg", "Waiting for blocks...")
 
-		err = rpcevents.ConsumeBlockExecutions(stream, c.makeBlockConsumer(projection, abiSpec, eventCh))
+		err = rpcevents.ConsumeBlockExecutions(stream, c.makeBlockConsumer(projection, abiSpec, eventCh, specCh, reloadCh,
+			backfilling))
 
 		if err != nil {
 			if err == io.EOF {
@@ -189,6 +456,16 @@ func (c *Consumer) Run(projection *sqlsol.Projection, abiSpec *abi.AbiSpec, stre
 				return err
 			}
 
+		// Apply reloaded projection, all blocks consumed under the previous projection have been committed
+		case reload := <-reloadCh:
+			err := c.applySpec(projection, reload)
+			reload.done <- err
+			if err != nil {
+				c.Log.Info("msg", "error applying reloaded projection", "err", err)
+				return err
+			}
+			projection = reload.projection
+
 		// Await completion
 		case <-doneCh:
 			select {
@@ -208,26 +485,50 @@ func (c *Consumer) Run(projection *sqlsol.Projection, abiSpec *abi.AbiSpec, stre
 }
 
 func (c *Consumer) makeBlockConsumer(projection *sqlsol.Projection, abiSpec *abi.AbiSpec,
-	eventCh chan<- types.EventData) func(blockExecution *exec.BlockExecution) error {
+	eventCh chan<- types.EventData, specCh <-chan *specReload, reloadCh chan<- *specReload,
+	backfilling bool) func(blockExecution *exec.BlockExecution) error {
+
+	// addresses of contracts whose ABI we have already looked for on chain
+	seenContracts := make(map[crypto.Address]bool)
 
 	return func(blockExecution *exec.BlockExecution) error {
 		if c.Closing {
 			return io.EOF
 		}
 
+		// switch projection between blocks so that each block is consumed under a single projection
+		select {
+		case reload := <-specCh:
+			reload.height = blockExecution.Height
+			reloadCh <- reload
+			if err := <-reload.done; err != nil {
+				return errors.Wrapf(err, "Error applying reloaded projection")
+			}
+			projection, abiSpec = reload.projection, reload.abiSpec
+			seenContracts = make(map[crypto.Address]bool)
+		default:
+		}
+
 		// set new block number
 		fromBlock := blockExecution.Height
 
-		defer func() {
-			c.Status.LastProcessedHeight = fromBlock
-		}()
+		if !backfilling {
+			c.blockReceived(fromBlock)
+			defer func() {
+				c.setLastProcessedHeight(fromBlock)
+			}()
+		}
 
 		c.Log.Debug("msg", "Block received", "height", blockExecution.Height, "num_txs", len(blockExecution.TxExecutions))
 
 		// create a fresh new structure to store block data at this height
 		blockData := sqlsol.NewBlockData(fromBlock)
 
-		if c.Config.DBBlockTx {
+		// block & tx tables may be absent when only reprojecting a single table
+		_, hasBlockTable := projection.Tables[types.SQLBlockTableName]
+		_, hasTxTable := projection.Tables[types.SQLTxTableName]
+
+		if c.Config.DBBlockTx && hasBlockTable {
 			blkRawData, err := buildBlkData(projection.Tables, blockExecution)
 			if err != nil {
 				return errors.Wrapf(err, "Error building block raw data")
@@ -240,7 +541,7 @@ func (c *Consumer) makeBlockConsumer(projection *sqlsol.Projection, abiSpec *abi
 		for _, txe := range blockExecution.TxExecutions {
 			c.Log.Debug("msg", "Getting transaction", "TxHash", txe.TxHash, "num_events", len(txe.Events))
 
-			if c.Config.DBBlockTx {
+			if c.Config.DBBlockTx && hasTxTable {
 				txRawData, err := buildTxData(txe)
 				if err != nil {
 					return errors.Wrapf(err, "Error building tx raw data")
@@ -256,7 +557,7 @@ func (c *Consumer) makeBlockConsumer(projection *sqlsol.Projection, abiSpec *abi
 				origin := txe.Origin
 				if origin == nil {
 					origin = &exec.Origin{
-						ChainID: c.DB.ChainID,
+						ChainID: c.Burrow.ChainID,
 						Height:  txe.Height,
 					}
 				}
@@ -280,9 +581,22 @@ func (c *Consumer) makeBlockConsumer(projection *sqlsol.Projection, abiSpec *abi
 							c.Log.Info("msg", fmt.Sprintf("Matched event header: %v", event.Header),
 								"filter", eventClass.Filter)
 
+							// pick up the ABI of any contract we have not seen before from chain
+							if event.Log != nil && !seenContracts[event.Log.Address] {
+								seenContracts[event.Log.Address] = true
+								contractAbiSpec, err := c.mergeContractAbi(abiSpec, event.Log.Address)
+								if err != nil {
+									c.Log.Info("msg", "could not get ABI stored on chain", "address", event.Log.Address,
+										"err", err)
+								} else {
+									abiSpec = contractAbiSpec
+								}
+							}
+
 							// unpack, decode & build event data
 							eventData, err := buildEventData(projection, eventClass, event, origin, abiSpec, c.Log)
 							if err != nil {
+								c.Metrics.decodeErrors.Inc()
 								return errors.Wrapf(err, "Error building event data")
 							}
 
@@ -308,11 +622,33 @@ func (c *Consumer) makeBlockConsumer(projection *sqlsol.Projection, abiSpec *abi
 	}
 }
 
+// mergeContractAbi merges any ABI stored on chain alongside the code of the contract at address into abiSpec
+func (c *Consumer) mergeContractAbi(abiSpec *abi.AbiSpec, address crypto.Address) (*abi.AbiSpec, error) {
+	cli := rpcquery.NewQueryClient(c.GRPCConnection)
+	contractMeta, err := cli.GetContractMeta(context.Background(), &rpcquery.GetContractMetaParam{Address: address})
+	if err != nil {
+		return nil, err
+	}
+	if contractMeta.ContractMeta == "" {
+		return abiSpec, nil
+	}
+	contractAbiSpec, err := abi.ReadAbiSpec([]byte(contractMeta.ContractMeta))
+	if err != nil {
+		return nil, fmt.Errorf("could not read ABI stored for contract %v: %v", address, err)
+	}
+	c.Log.Info("msg", "Using ABI stored on chain", "address", address)
+	return abi.MergeAbiSpec([]*abi.AbiSpec{abiSpec, contractAbiSpec}), nil
+}
+
 func (c *Consumer) commitBlock(projection *sqlsol.Projection, blockEvents types.EventData) error {
 	// upsert rows in specific SQL event tables and update block number
-	if err := c.DB.SetBlock(projection.Tables, blockEvents); err != nil {
+	start := time.Now()
+	err := c.out.SetBlock(projection.Tables, blockEvents)
+	c.Metrics.observeDB("set_block", start)
+	if err != nil {
 		return fmt.Errorf("error upserting rows in database: %v", err)
 	}
+	c.Metrics.countRows(blockEvents)
 
 	// send to the external events channel in a non-blocking manner
 	select {
@@ -328,13 +664,13 @@ func (c *Consumer) Health() error {
 		return errors.New("closing service")
 	}
 
-	// check db status
-	if c.DB == nil {
-		return errors.New("database disconnected")
+	// check sink status
+	if c.out == nil {
+		return errors.New("sink disconnected")
 	}
 
-	if err := c.DB.Ping(); err != nil {
-		return errors.New("database unavailable")
+	if err := c.out.Ping(); err != nil {
+		return errors.New("sink unavailable")
 	}
 
 	// check grpc connection status
@@ -360,27 +696,100 @@ func (c *Consumer) updateStatus(qcli rpcquery.QueryClient) {
 	stat, err := qcli.Status(context.Background(), &rpcquery.StatusParam{})
 	if err != nil {
 		c.Log.Error("msg", "could not get blockchain status", "err", err)
+		return
 	}
+	c.setBurrowStatus(stat)
+}
+
+// setBurrowStatus records the chain status, and its height if it is the latest we know of
+func (c *Consumer) setBurrowStatus(stat *rpc.ResultStatus) {
+	c.statusMtx.Lock()
+	defer c.statusMtx.Unlock()
 	c.Status.Burrow = *stat
+	if stat.SyncInfo != nil && stat.SyncInfo.LatestBlockHeight > c.Status.ChainHeight {
+		c.Status.ChainHeight = stat.SyncInfo.LatestBlockHeight
+	}
+}
+
+// blockReceived records the height of a block streamed from the chain, which may be more recent than the last status
+func (c *Consumer) blockReceived(height uint64) {
+	c.statusMtx.Lock()
+	defer c.statusMtx.Unlock()
+	if height > c.Status.ChainHeight {
+		c.Status.ChainHeight = height
+	}
+}
+
+func (c *Consumer) setLastProcessedHeight(height uint64) {
+	c.statusMtx.Lock()
+	defer c.statusMtx.Unlock()
+	c.Status.LastProcessedHeight = height
+}
+
+// status returns a copy of the consumer's status
+func (c *Consumer) status() Status {
+	c.statusMtx.RLock()
+	defer c.statusMtx.RUnlock()
+	return c.Status
+}
+
+// lag returns the number of blocks the consumer is behind the chain
+func (s Status) lag() uint64 {
+	if s.ChainHeight < s.LastProcessedHeight {
+		return 0
+	}
+	return s.ChainHeight - s.LastProcessedHeight
 }
 
 func (c *Consumer) statusMessage() []interface{} {
+	status := c.status()
 	var catchUpRatio float64
-	if c.Burrow.SyncInfo.LatestBlockHeight > 0 {
-		catchUpRatio = float64(c.LastProcessedHeight) / float64(c.Burrow.SyncInfo.LatestBlockHeight)
+	if status.ChainHeight > 0 {
+		catchUpRatio = float64(status.LastProcessedHeight) / float64(status.ChainHeight)
 	}
 	return []interface{}{
 		"msg", "status",
-		"last_processed_height", c.LastProcessedHeight,
+		"last_processed_height", status.LastProcessedHeight,
 		"fraction_caught_up", catchUpRatio,
-		"burrow_latest_block_height", c.Burrow.SyncInfo.LatestBlockHeight,
-		"burrow_latest_block_duration", c.Burrow.SyncInfo.LatestBlockDuration,
-		"burrow_latest_block_hash", c.Burrow.SyncInfo.LatestBlockHash,
-		"burrow_latest_app_hash", c.Burrow.SyncInfo.LatestAppHash,
-		"burrow_latest_block_time", c.Burrow.SyncInfo.LatestBlockTime,
-		"burrow_latest_block_seen_time", c.Burrow.SyncInfo.LatestBlockSeenTime,
-		"burrow_node_info", c.Burrow.NodeInfo,
-		"burrow_catching_up", c.Burrow.CatchingUp,
+		"burrow_latest_block_height", status.Burrow.SyncInfo.LatestBlockHeight,
+		"burrow_latest_block_duration", status.Burrow.SyncInfo.LatestBlockDuration,
+		"burrow_latest_block_hash", status.Burrow.SyncInfo.LatestBlockHash,
+		"burrow_latest_app_hash", status.Burrow.SyncInfo.LatestAppHash,
+		"burrow_latest_block_time", status.Burrow.SyncInfo.LatestBlockTime,
+		"burrow_latest_block_seen_time", status.Burrow.SyncInfo.LatestBlockSeenTime,
+		"burrow_node_info", status.Burrow.NodeInfo,
+		"burrow_catching_up", status.Burrow.CatchingUp,
+	}
+}
+
+// countReconnects counts the times the gRPC connection becomes ready again after having been lost
+func (c *Consumer) countReconnects(doneCh <-chan struct{}) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-doneCh:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	lost := false
+	state := c.GRPCConnection.GetState()
+	for c.GRPCConnection.WaitForStateChange(ctx, state) {
+		state = c.GRPCConnection.GetState()
+		switch state {
+		case connectivity.TransientFailure, connectivity.Idle:
+			lost = true
+		case connectivity.Ready:
+			if lost {
+				c.Log.Info("msg", "Reconnected to Burrow gRPC server")
+				c.Metrics.reconnects.Inc()
+				lost = false
+			}
+		case connectivity.Shutdown:
+			return
+		}
 	}
 }
 
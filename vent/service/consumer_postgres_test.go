@@ -32,6 +32,11 @@ func TestPostgresConsumer(t *testing.T) {
 	time.Sleep(2 * time.Second)
 
 	t.Run("Group", func(t *testing.T) {
+		// Must run first since the consumer can only decode events from contracts that have stored their ABI
+		t.Run("PostgresContractAbi", func(t *testing.T) {
+			testContractAbi(t, kern.Blockchain.ChainID(), test.PostgresVentConfig(grpcAddress), tcli, inputAddress)
+		})
+
 		t.Run("PostgresConsumer", func(t *testing.T) {
 			testConsumer(t, kern.Blockchain.ChainID(), test.PostgresVentConfig(grpcAddress), tcli, inputAddress)
 		})
@@ -48,6 +53,18 @@ func TestPostgresConsumer(t *testing.T) {
 			testResume(t, kern.Blockchain.ChainID(), test.PostgresVentConfig(grpcAddress))
 		})
 
+		t.Run("PostgresRewind", func(t *testing.T) {
+			testRewind(t, kern.Blockchain.ChainID(), test.PostgresVentConfig(grpcAddress), tcli, inputAddress)
+		})
+
+		t.Run("PostgresReproject", func(t *testing.T) {
+			testReproject(t, kern.Blockchain.ChainID(), test.PostgresVentConfig(grpcAddress), tcli, inputAddress)
+		})
+
+		t.Run("PostgresHotReload", func(t *testing.T) {
+			testHotReload(t, kern.Blockchain.ChainID(), test.PostgresVentConfig(grpcAddress), tcli, inputAddress)
+		})
+
 		t.Run("PostgresTriggers", func(t *testing.T) {
 			tCli := test.NewTransactClient(t, kern.GRPCListenAddress().String())
 			create := test.CreateContract(t, tCli, inputAddress)
@@ -24,6 +24,11 @@ func TestSqliteConsumer(t *testing.T) {
 	time.Sleep(2 * time.Second)
 
 	t.Run("Group", func(t *testing.T) {
+		// Must run first since the consumer can only decode events from contracts that have stored their ABI
+		t.Run("SqliteContractAbi", func(t *testing.T) {
+			testContractAbi(t, kern.Blockchain.ChainID(), test.SqliteVentConfig(grpcAddress), tcli, inputAddress)
+		})
+
 		t.Run("Consume", func(t *testing.T) {
 			testConsumer(t, kern.Blockchain.ChainID(), test.SqliteVentConfig(grpcAddress), tcli, inputAddress)
 		})
@@ -39,5 +44,21 @@ func TestSqliteConsumer(t *testing.T) {
 		t.Run("SqliteResume", func(t *testing.T) {
 			testResume(t, kern.Blockchain.ChainID(), test.SqliteVentConfig(grpcAddress))
 		})
+
+		t.Run("SqliteRewind", func(t *testing.T) {
+			testRewind(t, kern.Blockchain.ChainID(), test.SqliteVentConfig(grpcAddress), tcli, inputAddress)
+		})
+
+		t.Run("SqliteReproject", func(t *testing.T) {
+			testReproject(t, kern.Blockchain.ChainID(), test.SqliteVentConfig(grpcAddress), tcli, inputAddress)
+		})
+
+		t.Run("SqliteHotReload", func(t *testing.T) {
+			testHotReload(t, kern.Blockchain.ChainID(), test.SqliteVentConfig(grpcAddress), tcli, inputAddress)
+		})
+
+		t.Run("JSONFileSink", func(t *testing.T) {
+			testJSONFileSink(t, kern.Blockchain.ChainID(), test.SqliteVentConfig(grpcAddress), tcli, inputAddress)
+		})
 	})
 }
@@ -3,9 +3,14 @@
 package service_test
 
 import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
 	"math/rand"
+	"os"
 	"path"
 	"runtime"
+	"strings"
 	"testing"
 	"time"
 
@@ -16,6 +21,7 @@ import (
 	"github.com/hyperledger/burrow/vent/config"
 	"github.com/hyperledger/burrow/vent/logger"
 	"github.com/hyperledger/burrow/vent/service"
+	"github.com/hyperledger/burrow/vent/sink"
 	"github.com/hyperledger/burrow/vent/sqlsol"
 	"github.com/hyperledger/burrow/vent/test"
 	"github.com/hyperledger/burrow/vent/types"
@@ -156,6 +162,223 @@ func testResume(t *testing.T, chainid string, cfg *config.VentConfig) {
 	}
 }
 
+func testRewind(t *testing.T, chainid string, cfg *config.VentConfig, tcli rpctransact.TransactClient, inputAddress crypto.Address) {
+	create := test.CreateContract(t, tcli, inputAddress)
+
+	// create test db
+	db, closeDB := test.NewTestDB(t, chainid, cfg)
+	defer closeDB()
+
+	eventColumnName := "EventTest"
+
+	txeA := test.CallAddEvent(t, tcli, inputAddress, create.Receipt.ContractAddress, "TestEventRewindA", "kept")
+	runConsumer(t, cfg)
+
+	txeB := test.CallAddEvent(t, tcli, inputAddress, create.Receipt.ContractAddress, "TestEventRewindB", "rewound")
+	runConsumer(t, cfg)
+
+	eventData, err := db.GetBlock(txeB.Height)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(eventData.Tables[eventColumnName]))
+
+	// Rewind to before the second event
+	err = newConsumer(t, cfg).Rewind(txeA.Height)
+	require.NoError(t, err)
+
+	height, err := db.GetLastBlockHeight()
+	require.NoError(t, err)
+	require.Equal(t, txeA.Height, height)
+
+	eventData, err = db.GetBlock(txeB.Height)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(eventData.Tables))
+
+	eventData, err = db.GetBlock(txeA.Height)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(eventData.Tables[eventColumnName]))
+
+	// Consumption resumes from the block following the rewind
+	ch := runConsumer(t, cfg)
+	ed := <-ch
+	require.Equal(t, txeA.Height+1, ed.BlockHeight)
+
+	eventData, err = db.GetBlock(txeB.Height)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(eventData.Tables[eventColumnName]))
+}
+
+func testContractAbi(t *testing.T, chainid string, cfg *config.VentConfig, tcli rpctransact.TransactClient, inputAddress crypto.Address) {
+	create := test.CreateContractWithAbi(t, tcli, inputAddress)
+
+	// create test db
+	db, closeDB := test.NewTestDB(t, chainid, cfg)
+	defer closeDB()
+
+	eventColumnName := "EventTest"
+
+	txe := test.CallAddEvent(t, tcli, inputAddress, create.Receipt.ContractAddress, "TestEventAbi", "decoded from chain")
+
+	consumer := newConsumer(t, cfg)
+	// Rely solely on the ABI stored alongside the contract's code
+	cfg.AbiFileOrDirs = nil
+
+	projection, err := sqlsol.SpecLoader(cfg.SpecFileOrDirs, cfg.DBBlockTx)
+	require.NoError(t, err)
+
+	abiSpec, err := service.LoadAbiSpec(cfg.AbiFileOrDirs)
+	require.NoError(t, err)
+
+	err = consumer.Run(projection, abiSpec, false)
+	require.NoError(t, err)
+
+	eventData, err := db.GetBlock(txe.Height)
+	require.NoError(t, err)
+	tblData := eventData.Tables[eventColumnName]
+	require.Equal(t, 1, len(tblData))
+	require.Equal(t, "UpdateTestEvents", tblData[0].RowData["_eventname"].(string))
+	require.Equal(t, "TestEventAbi", tblData[0].RowData["testname"].(string))
+}
+
+func testReproject(t *testing.T, chainid string, cfg *config.VentConfig, tcli rpctransact.TransactClient, inputAddress crypto.Address) {
+	create := test.CreateContract(t, tcli, inputAddress)
+
+	// create test db
+	db, closeDB := test.NewTestDB(t, chainid, cfg)
+	defer closeDB()
+
+	eventColumnName := "EventTest"
+
+	txe := test.CallAddEvent(t, tcli, inputAddress, create.Receipt.ContractAddress, "TestEventReproject", "reprojected")
+	runConsumer(t, cfg)
+
+	lastHeight, err := db.GetLastBlockHeight()
+	require.NoError(t, err)
+
+	consumer := newConsumer(t, cfg)
+	projection, err := sqlsol.SpecLoader(cfg.SpecFileOrDirs, cfg.DBBlockTx)
+	require.NoError(t, err)
+
+	abiSpec, err := abi.LoadPath(cfg.AbiFileOrDirs...)
+	require.NoError(t, err)
+
+	err = consumer.Reproject(projection, abiSpec, eventColumnName)
+	require.NoError(t, err)
+
+	// The table is rebuilt and the other tables are untouched
+	eventData, err := db.GetBlock(txe.Height)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(eventData.Tables))
+	require.Equal(t, 1, len(eventData.Tables[eventColumnName]))
+
+	height, err := db.GetLastBlockHeight()
+	require.NoError(t, err)
+	require.Equal(t, lastHeight, height)
+
+	// Consumption resumes from the block following the last one processed before reprojecting
+	for ed := range runConsumer(t, cfg) {
+		require.True(t, ed.BlockHeight > lastHeight, "should not reprocess blocks")
+	}
+}
+
+func testHotReload(t *testing.T, chainid string, cfg *config.VentConfig, tcli rpctransact.TransactClient, inputAddress crypto.Address) {
+	create := test.CreateContract(t, tcli, inputAddress)
+
+	// create test db
+	db, closeDB := test.NewTestDB(t, chainid, cfg)
+	defer closeDB()
+
+	eventColumnName := "EventTest"
+
+	txeA := test.CallAddEvent(t, tcli, inputAddress, create.Receipt.ContractAddress, "TestEventHotReloadA", "back-filled")
+
+	consumer := newConsumer(t, cfg)
+
+	// Split the example spec so that tables can be added one file at a time
+	bs, err := ioutil.ReadFile(cfg.SpecFileOrDirs[0])
+	require.NoError(t, err)
+	eventSpec := types.EventSpec{}
+	require.NoError(t, json.Unmarshal(bs, &eventSpec))
+
+	specDir, err := ioutil.TempDir("", "vent-spec")
+	require.NoError(t, err)
+	defer os.RemoveAll(specDir)
+
+	writeSpec := func(tableName string) {
+		for _, eventClass := range eventSpec {
+			if eventClass.TableName == tableName {
+				bs, err := json.Marshal(types.EventSpec{eventClass})
+				require.NoError(t, err)
+				require.NoError(t, ioutil.WriteFile(path.Join(specDir, tableName+".json"), bs, 0644))
+			}
+		}
+	}
+
+	writeSpec("UserAccounts")
+	cfg.SpecFileOrDirs = []string{specDir}
+	cfg.WatchEvery = 100 * time.Millisecond
+
+	projection, err := sqlsol.SpecLoader(cfg.SpecFileOrDirs, cfg.DBBlockTx)
+	require.NoError(t, err)
+
+	abiSpec, err := abi.LoadPath(cfg.AbiFileOrDirs...)
+	require.NoError(t, err)
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- consumer.Run(projection, abiSpec, true)
+	}()
+
+	waitForRows := func(height uint64) {
+		for i := 0; i < 100; i++ {
+			eventData, err := db.GetBlock(height)
+			require.NoError(t, err)
+			if len(eventData.Tables[eventColumnName]) > 0 {
+				return
+			}
+			time.Sleep(100 * time.Millisecond)
+		}
+		t.Fatalf("timed out waiting for rows in %s at height %d", eventColumnName, height)
+	}
+
+	// Add a table live, it should be back-filled with history
+	time.Sleep(time.Second)
+	writeSpec(eventColumnName)
+	waitForRows(txeA.Height)
+
+	// And then be projected going forward
+	txeB := test.CallAddEvent(t, tcli, inputAddress, create.Receipt.ContractAddress, "TestEventHotReloadB", "streamed")
+	waitForRows(txeB.Height)
+
+	// Add an event class projecting into the existing table, it should be back-filled too
+	eventClass := &types.EventClass{
+		TableName: eventColumnName,
+		Filter:    fmt.Sprintf("EventType = 'LogEvent' AND Address = '%v'", create.Receipt.ContractAddress),
+		FieldMappings: []*types.EventFieldMapping{
+			{Field: "key", ColumnName: "testkey", Type: "bytes32", Primary: true},
+			{Field: "name", ColumnName: "testname", Type: "bytes32", Primary: true, BytesToString: true},
+			{Field: "description", ColumnName: "reloaddescription", Type: "bytes32", BytesToString: true},
+		},
+	}
+	bs, err = json.Marshal(types.EventSpec{eventClass})
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(path.Join(specDir, "EventTestByAddress.json"), bs, 0644))
+
+	for i := 0; ; i++ {
+		eventData, err := db.GetBlock(txeA.Height)
+		require.NoError(t, err)
+		rows := eventData.Tables[eventColumnName]
+		if len(rows) > 0 && rows[0].RowData["reloaddescription"] == "back-filled" {
+			break
+		}
+		require.True(t, i < 100, "timed out waiting for back-filled column in %s at height %d", eventColumnName,
+			txeA.Height)
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	consumer.Shutdown()
+	require.NoError(t, <-errCh)
+}
+
 func testInvalidUTF8(t *testing.T, chainid string, cfg *config.VentConfig, tcli rpctransact.TransactClient, inputAddress crypto.Address) {
 	create := test.CreateContract(t, tcli, inputAddress)
 
@@ -180,6 +403,39 @@ func testInvalidUTF8(t *testing.T, chainid string, cfg *config.VentConfig, tcli
 	//require.Contains(t, err.Error(), "pq: invalid byte sequence for encoding \"UTF8\": 0xf3 0x6e")
 }
 
+func testJSONFileSink(t *testing.T, chainid string, cfg *config.VentConfig, tcli rpctransact.TransactClient, inputAddress crypto.Address) {
+	create := test.CreateContract(t, tcli, inputAddress)
+	txe := test.CallAddEvent(t, tcli, inputAddress, create.Receipt.ContractAddress, "TestSinkEvent", "Sunk")
+
+	dir, err := ioutil.TempDir("", "vent-sink")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	cfg.Sink = sink.JSONFile
+	cfg.SinkTarget = path.Join(dir, "blocks.json")
+	runConsumer(t, cfg)
+
+	bs, err := ioutil.ReadFile(cfg.SinkTarget)
+	require.NoError(t, err)
+	var found bool
+	for _, line := range strings.Split(strings.TrimSpace(string(bs)), "\n") {
+		block := new(sink.Block)
+		require.NoError(t, json.Unmarshal([]byte(line), block))
+		if block.BlockHeight == txe.Height {
+			rows := block.Tables["EventTest"]
+			require.Len(t, rows, 1)
+			require.Equal(t, "TestSinkEvent", rows[0].RowData["testname"])
+			found = true
+		}
+	}
+	require.True(t, found, "block %d should have been written to the JSON file sink", txe.Height)
+
+	// The checkpoint (rather than the database) records how far we got
+	height, err := sink.NewCheckpoint(cfg.SinkTarget+".checkpoint", chainid).Load()
+	require.NoError(t, err)
+	require.True(t, height >= txe.Height)
+}
+
 func newConsumer(t *testing.T, cfg *config.VentConfig) *service.Consumer {
 	// Resolve relative path to test dir
 	_, testFile, _, _ := runtime.Caller(0)
@@ -32,7 +32,10 @@ func decodeEvent(header *exec.Header, log *exec.LogEvent, origin *exec.Origin, a
 	data[types.TxTxHashLabel] = header.TxHash.String()
 
 	// build expected interface type array to get log event values
-	unpackedData := abi.GetPackingTypes(evAbi.Inputs)
+	unpackedData := make([]interface{}, len(evAbi.Inputs))
+	for i, input := range evAbi.Inputs {
+		unpackedData[i] = unpackTarget(input)
+	}
 
 	// unpack event data (topics & data part)
 	if err := abi.UnpackEvent(&evAbi, log.Topics, log.Data, unpackedData...); err != nil {
@@ -41,17 +44,46 @@ func decodeEvent(header *exec.Header, log *exec.LogEvent, origin *exec.Origin, a
 
 	// for each decoded item value, stores it in given item name
 	for i, input := range evAbi.Inputs {
-		switch v := unpackedData[i].(type) {
-		case *crypto.Address:
-			data[input.Name] = v.String()
-		case *big.Int:
-			data[input.Name] = v.String()
-		case *string:
-			data[input.Name] = *v
-		default:
-			data[input.Name] = v
-		}
+		setField(data, input.Name, unpackedData[i])
 	}
 
 	return data, nil
 }
+
+// unpackTarget returns a value to unpack an event field into. Arrays are unpacked into their string representation
+// and tuples into a map holding a value for each component.
+func unpackTarget(input abi.Argument) interface{} {
+	if input.IsArray {
+		return new(string)
+	}
+	if tuple, ok := input.EVM.(abi.EVMTuple); ok {
+		components := make(map[string]interface{}, len(tuple.Components))
+		for i, c := range tuple.Components {
+			name := c.Name
+			if name == "" {
+				name = fmt.Sprintf("%d", i)
+			}
+			components[name] = unpackTarget(c)
+		}
+		return &components
+	}
+	return abi.GetPackingTypes([]abi.Argument{input})[0]
+}
+
+// setField stores a decoded value under name, the components of tuples are stored under name.component
+func setField(data map[string]interface{}, name string, value interface{}) {
+	switch v := value.(type) {
+	case *map[string]interface{}:
+		for component, cv := range *v {
+			setField(data, name+"."+component, cv)
+		}
+	case *crypto.Address:
+		data[name] = v.String()
+	case *big.Int:
+		data[name] = v.String()
+	case *string:
+		data[name] = *v
+	default:
+		data[name] = v
+	}
+}
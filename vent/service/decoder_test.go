@@ -0,0 +1,37 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeEventTuple(t *testing.T) {
+	abiSpec, err := abi.ReadAbiSpec([]byte(`[{"anonymous":false,"inputs":[{"indexed":true,"name":"id","type":"uint256"},
+		{"indexed":false,"name":"order","type":"tuple","components":[{"name":"buyer","type":"address"},
+		{"name":"price","type":"uint256"},{"name":"memo","type":"string"}]},
+		{"indexed":false,"name":"quantities","type":"uint64[]"}],"name":"Placed","type":"event"}]`))
+	require.NoError(t, err)
+	eventSpec := abiSpec.Events["Placed"]
+	buyer := crypto.Address{1, 2, 3}
+	data, err := abi.Pack(eventSpec.Inputs[1:], map[string]interface{}{"buyer": buyer, "price": 10, "memo": "rush"},
+		[]uint64{1, 2})
+	require.NoError(t, err)
+	log := &exec.LogEvent{
+		Topics: []binary.Word256{binary.Word256(eventSpec.EventID), binary.Int64ToWord256(7)},
+		Data:   data,
+	}
+
+	decoded, err := decodeEvent(&exec.Header{}, log, &exec.Origin{ChainID: "chain"}, abiSpec)
+	require.NoError(t, err)
+	assert.Equal(t, "7", decoded["id"])
+	assert.Equal(t, buyer.String(), decoded["order.buyer"])
+	assert.Equal(t, "10", decoded["order.price"])
+	assert.Equal(t, "rush", decoded["order.memo"])
+	assert.Equal(t, "[1,2]", decoded["quantities"])
+}
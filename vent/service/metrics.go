@@ -0,0 +1,111 @@
+package service
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/hyperledger/burrow/vent/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const metricsNamespace = "vent"
+
+// Metrics holds the Prometheus metrics of a consumer, they are registered with a registry of their own so that
+// several consumers may run in one process
+type Metrics struct {
+	registry     *prometheus.Registry
+	rowsUpserted *prometheus.CounterVec
+	rowsDeleted  *prometheus.CounterVec
+	decodeErrors prometheus.Counter
+	dbLatency    *prometheus.HistogramVec
+	reconnects   prometheus.Counter
+}
+
+// NewMetrics creates the metrics for consumer, heights are read from the consumer's Status when scraped
+func NewMetrics(consumer *Consumer) *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		rowsUpserted: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "rows_upserted_total",
+			Help:      "Rows upserted per table",
+		}, []string{"table"}),
+		rowsDeleted: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "rows_deleted_total",
+			Help:      "Rows deleted per table",
+		}, []string{"table"}),
+		decodeErrors: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "decode_errors_total",
+			Help:      "Events matching a spec that could not be decoded",
+		}),
+		dbLatency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "db_latency_seconds",
+			Help:      "Latency of database operations",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"operation"}),
+		reconnects: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "grpc_reconnects_total",
+			Help:      "Times the connection to the Burrow gRPC server has been re-established after being lost",
+		}),
+	}
+
+	m.registry.MustRegister(
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "last_processed_height",
+			Help:      "Height of the last block consumed",
+		}, func() float64 {
+			return float64(consumer.status().LastProcessedHeight)
+		}),
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "chain_height",
+			Help:      "Latest block height of the chain as last seen by the consumer",
+		}, func() float64 {
+			return float64(consumer.status().ChainHeight)
+		}),
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "lag_blocks",
+			Help:      "Number of blocks the consumer is behind the chain",
+		}, func() float64 {
+			return float64(consumer.status().lag())
+		}),
+		m.rowsUpserted,
+		m.rowsDeleted,
+		m.decodeErrors,
+		m.dbLatency,
+		m.reconnects,
+	)
+
+	return m
+}
+
+// Handler serves the metrics in the Prometheus exposition format
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// countRows counts the rows of a block by table and action
+func (m *Metrics) countRows(blockEvents types.EventData) {
+	for tableName, table := range blockEvents.Tables {
+		for _, row := range table {
+			switch row.Action {
+			case types.ActionUpsert:
+				m.rowsUpserted.WithLabelValues(tableName).Inc()
+			case types.ActionDelete:
+				m.rowsDeleted.WithLabelValues(tableName).Inc()
+			}
+		}
+	}
+}
+
+// observeDB records the time taken by the database operation started at start
+func (m *Metrics) observeDB(operation string, start time.Time) {
+	m.dbLatency.WithLabelValues(operation).Observe(time.Since(start).Seconds())
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"io/ioutil"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/rpc"
+	"github.com/hyperledger/burrow/vent/config"
+	"github.com/hyperledger/burrow/vent/logger"
+	"github.com/hyperledger/burrow/vent/sqlsol"
+	"github.com/hyperledger/burrow/vent/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetrics(t *testing.T) {
+	consumer := NewConsumer(config.DefaultVentConfig(), logger.NewLogger("error"), make(chan types.EventData))
+	consumer.setLastProcessedHeight(12)
+	consumer.setBurrowStatus(&rpc.ResultStatus{SyncInfo: &bcm.SyncInfo{LatestBlockHeight: 20}})
+	// Blocks streamed since the last status update are more recent
+	consumer.blockReceived(22)
+	consumer.blockReceived(21)
+
+	consumer.Metrics.countRows(types.EventData{
+		BlockHeight: 12,
+		Tables: map[string]types.EventDataTable{
+			"EventTest": {
+				{Action: types.ActionUpsert},
+				{Action: types.ActionUpsert},
+				{Action: types.ActionDelete},
+			},
+		},
+	})
+	consumer.Metrics.decodeErrors.Inc()
+	consumer.Metrics.observeDB("set_block", time.Now())
+
+	server := httptest.NewServer(NewServer(consumer.Config, consumer.Log, consumer))
+	defer server.Close()
+
+	resp, err := server.Client().Get(server.URL + "/metrics")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	bs, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	exposition := string(bs)
+
+	assert.Contains(t, exposition, "vent_last_processed_height 12")
+	assert.Contains(t, exposition, "vent_chain_height 22")
+	assert.Contains(t, exposition, "vent_lag_blocks 10")
+	assert.Contains(t, exposition, `vent_rows_upserted_total{table="EventTest"} 2`)
+	assert.Contains(t, exposition, `vent_rows_deleted_total{table="EventTest"} 1`)
+	assert.Contains(t, exposition, "vent_decode_errors_total 1")
+	assert.Contains(t, exposition, `vent_db_latency_seconds_count{operation="set_block"} 1`)
+	assert.Contains(t, exposition, "vent_grpc_reconnects_total 0")
+}
+
+func TestBackfillLeavesStatus(t *testing.T) {
+	consumer := NewConsumer(config.DefaultVentConfig(), logger.NewLogger("error"), make(chan types.EventData))
+	consumer.setLastProcessedHeight(12)
+	consumer.blockReceived(20)
+	projection := &sqlsol.Projection{Tables: types.EventTables{}}
+
+	// Back-filling replays history, which is not progress through the chain
+	consume := consumer.makeBlockConsumer(projection, nil, nil, nil, nil, true)
+	require.NoError(t, consume(&exec.BlockExecution{Height: 3}))
+	status := consumer.status()
+	assert.Equal(t, uint64(12), status.LastProcessedHeight)
+	assert.Equal(t, uint64(8), status.lag())
+
+	consume = consumer.makeBlockConsumer(projection, nil, nil, nil, nil, false)
+	require.NoError(t, consume(&exec.BlockExecution{Height: 13}))
+	status = consumer.status()
+	assert.Equal(t, uint64(13), status.LastProcessedHeight)
+	assert.Equal(t, uint64(7), status.lag())
+}
@@ -23,6 +23,7 @@ func NewServer(cfg *config.VentConfig, log *logger.Logger, consumer *Consumer) *
 	mux := http.NewServeMux()
 
 	mux.HandleFunc("/health", healthHandler(consumer))
+	mux.Handle("/metrics", consumer.Metrics.Handler())
 
 	return &Server{
 		Config:   cfg,
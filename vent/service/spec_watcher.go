@@ -0,0 +1,105 @@
+package service
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/vent/sqlsol"
+)
+
+// specReload carries a reloaded projection and ABI to the consumer
+type specReload struct {
+	projection *sqlsol.Projection
+	abiSpec    *abi.AbiSpec
+	// height of the first block to be consumed under the reloaded projection
+	height uint64
+	// receives the result of applying the reloaded projection to the database
+	done chan error
+}
+
+// watchSpecs polls the spec and ABI files every WatchEvery and sends a reload to specCh whenever they differ from
+// fingerprint
+func (c *Consumer) watchSpecs(fingerprint string, doneCh <-chan struct{}, specCh chan<- *specReload) {
+	ticker := time.NewTicker(c.Config.WatchEvery)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			newFingerprint, err := specFingerprint(c.Config.SpecFileOrDirs, c.Config.AbiFileOrDirs)
+			if err != nil {
+				c.Log.Error("msg", "could not fingerprint spec and ABI files", "err", err)
+				continue
+			}
+			if newFingerprint == fingerprint {
+				continue
+			}
+			fingerprint = newFingerprint
+
+			c.Log.Info("msg", "Spec or ABI files changed, reloading")
+
+			projection, err := sqlsol.SpecLoader(c.Config.SpecFileOrDirs, c.Config.DBBlockTx)
+			if err != nil {
+				c.Log.Error("msg", "could not reload spec, keeping current projection", "err", err)
+				continue
+			}
+			abiSpec, err := LoadAbiSpec(c.Config.AbiFileOrDirs)
+			if err != nil {
+				c.Log.Error("msg", "could not reload ABI, keeping current projection", "err", err)
+				continue
+			}
+
+			select {
+			case specCh <- &specReload{projection: projection, abiSpec: abiSpec, done: make(chan error, 1)}:
+			case <-doneCh:
+				return
+			}
+
+		case <-doneCh:
+			return
+		}
+	}
+}
+
+// specFingerprint hashes the names, sizes and modification times of the spec and ABI files
+func specFingerprint(specFileOrDirs, abiFileOrDirs []string) (string, error) {
+	hasher := sha256.New()
+
+	walk := func(fileOrDirs []string, exts ...string) error {
+		for _, dir := range fileOrDirs {
+			err := filepath.Walk(dir, func(path string, fi os.FileInfo, err error) error {
+				if err != nil {
+					return fmt.Errorf("error walking '%s': %v", dir, err)
+				}
+				if fi.IsDir() {
+					return nil
+				}
+				for _, ext := range exts {
+					if filepath.Ext(path) == ext {
+						_, err = fmt.Fprintf(hasher, "%s:%d:%d\n", path, fi.Size(), fi.ModTime().UnixNano())
+						return err
+					}
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	// match the extensions read by sqlsol.NewProjectionFromFolder and abi.LoadPath
+	if err := walk(specFileOrDirs, ".json"); err != nil {
+		return "", err
+	}
+	if err := walk(abiFileOrDirs, ".abi", ".bin"); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%X", hasher.Sum(nil)), nil
+}
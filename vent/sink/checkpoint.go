@@ -0,0 +1,73 @@
+package sink
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// Checkpoint records the height of the last block written to a sink in a file
+type Checkpoint struct {
+	path    string
+	chainID string
+}
+
+type checkpointState struct {
+	ChainID     string
+	BlockHeight uint64
+}
+
+// NewCheckpoint returns a checkpoint stored at path for the chain chainID
+func NewCheckpoint(path, chainID string) *Checkpoint {
+	return &Checkpoint{
+		path:    path,
+		chainID: chainID,
+	}
+}
+
+// Load returns the height of the last block written, or zero if nothing has been written yet
+func (cp *Checkpoint) Load() (uint64, error) {
+	bs, err := ioutil.ReadFile(cp.path)
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("could not read checkpoint: %v", err)
+	}
+	state := new(checkpointState)
+	err = json.Unmarshal(bs, state)
+	if err != nil {
+		return 0, fmt.Errorf("could not decode checkpoint %s: %v", cp.path, err)
+	}
+	if state.ChainID != cp.chainID {
+		return 0, fmt.Errorf("checkpoint %s is for chain %s but we are consuming chain %s", cp.path, state.ChainID,
+			cp.chainID)
+	}
+	return state.BlockHeight, nil
+}
+
+// Save records height as the last block written
+func (cp *Checkpoint) Save(height uint64) error {
+	bs, err := json.Marshal(checkpointState{ChainID: cp.chainID, BlockHeight: height})
+	if err != nil {
+		return err
+	}
+	// Write to a temporary file and rename it so that the checkpoint is replaced atomically
+	f, err := ioutil.TempFile(filepath.Dir(cp.path), filepath.Base(cp.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("could not save checkpoint: %v", err)
+	}
+	defer os.Remove(f.Name())
+	_, err = f.Write(bs)
+	if err != nil {
+		f.Close()
+		return fmt.Errorf("could not save checkpoint: %v", err)
+	}
+	err = f.Close()
+	if err != nil {
+		return fmt.Errorf("could not save checkpoint: %v", err)
+	}
+	return os.Rename(f.Name(), cp.path)
+}
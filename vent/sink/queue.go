@@ -0,0 +1,61 @@
+package sink
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/vent/types"
+)
+
+// Publisher publishes messages to a message queue, implement it to plug a particular broker into vent
+type Publisher interface {
+	// Publish should only return once the broker has accepted the message
+	Publish(message []byte) error
+	Close() error
+}
+
+// QueueSink publishes a JSON Block to a message queue for each block
+type QueueSink struct {
+	publisher  Publisher
+	checkpoint *Checkpoint
+	chainID    string
+}
+
+var _ Sink = &QueueSink{}
+
+func NewQueueSink(publisher Publisher, checkpoint *Checkpoint, chainID string) *QueueSink {
+	return &QueueSink{
+		publisher:  publisher,
+		checkpoint: checkpoint,
+		chainID:    chainID,
+	}
+}
+
+// SynchronizeDB is a no-op since messages are self-describing
+func (qs *QueueSink) SynchronizeDB(eventTables types.EventTables) error {
+	return nil
+}
+
+func (qs *QueueSink) GetLastBlockHeight() (uint64, error) {
+	return qs.checkpoint.Load()
+}
+
+// SetBlock publishes the block and then advances the checkpoint, so a block may be published twice but never skipped
+func (qs *QueueSink) SetBlock(eventTables types.EventTables, eventData types.EventData) error {
+	bs, err := EncodeBlock(qs.chainID, eventData)
+	if err != nil {
+		return err
+	}
+	err = qs.publisher.Publish(bs)
+	if err != nil {
+		return fmt.Errorf("could not publish block %d: %v", eventData.BlockHeight, err)
+	}
+	return qs.checkpoint.Save(eventData.BlockHeight)
+}
+
+func (qs *QueueSink) Ping() error {
+	return nil
+}
+
+func (qs *QueueSink) Close() {
+	qs.publisher.Close()
+}
@@ -0,0 +1,61 @@
+// Package sink provides the targets vent can write the rows projected from each block to
+package sink
+
+import (
+	"encoding/json"
+
+	"github.com/hyperledger/burrow/vent/types"
+)
+
+// Sink names
+const (
+	SQL      = "sql"
+	JSONFile = "jsonfile"
+	Webhook  = "webhook"
+)
+
+// Sink is a target for the rows projected from each block that keeps its own checkpoint of the last block written to
+// it. The method set matches sqldb.SQLDB, which is the default sink.
+type Sink interface {
+	// SynchronizeDB prepares the sink to receive rows for eventTables
+	SynchronizeDB(eventTables types.EventTables) error
+	// GetLastBlockHeight returns the checkpoint of the sink, that is the height of the last block written to it
+	GetLastBlockHeight() (uint64, error)
+	// SetBlock writes the rows of a block and advances the checkpoint to its height
+	SetBlock(eventTables types.EventTables, eventData types.EventData) error
+	// Ping checks that the sink is available
+	Ping() error
+	// Close releases the resources held by the sink
+	Close()
+}
+
+// Block is the JSON message written by the non-SQL sinks for each block
+type Block struct {
+	ChainID     string
+	BlockHeight uint64
+	// Rows by table name
+	Tables map[string][]Row
+}
+
+// Row is a single row of a Block
+type Row struct {
+	Action  types.DBAction
+	RowData map[string]interface{}
+}
+
+// EncodeBlock encodes the rows of a block as a JSON Block message
+func EncodeBlock(chainID string, eventData types.EventData) ([]byte, error) {
+	block := Block{
+		ChainID:     chainID,
+		BlockHeight: eventData.BlockHeight,
+		Tables:      make(map[string][]Row, len(eventData.Tables)),
+	}
+	for tableName, table := range eventData.Tables {
+		rows := make([]Row, len(table))
+		for i, row := range table {
+			rows[i] = Row{Action: row.Action, RowData: row.RowData}
+		}
+		block.Tables[tableName] = rows
+	}
+	return json.Marshal(block)
+}
@@ -0,0 +1,170 @@
+package sink
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/vent/logger"
+	"github.com/hyperledger/burrow/vent/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const chainID = "TestChain"
+
+func TestCheckpoint(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vent-sink")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	cp := NewCheckpoint(path.Join(dir, "checkpoint"), chainID)
+	height, err := cp.Load()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), height)
+
+	require.NoError(t, cp.Save(42))
+	height, err = cp.Load()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(42), height)
+
+	_, err = NewCheckpoint(path.Join(dir, "checkpoint"), "OtherChain").Load()
+	assert.Error(t, err)
+}
+
+func TestJSONFileSink(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vent-sink")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	file := path.Join(dir, "blocks.json")
+	js, err := NewJSONFileSink(file, NewCheckpoint(file+".checkpoint", chainID), chainID)
+	require.NoError(t, err)
+
+	require.NoError(t, js.SetBlock(nil, eventData(3)))
+	require.NoError(t, js.SetBlock(nil, eventData(5)))
+	js.Close()
+
+	// Reopening resumes from the checkpoint and appends
+	js, err = NewJSONFileSink(file, NewCheckpoint(file+".checkpoint", chainID), chainID)
+	require.NoError(t, err)
+	height, err := js.GetLastBlockHeight()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), height)
+	require.NoError(t, js.SetBlock(nil, eventData(6)))
+	js.Close()
+
+	f, err := os.Open(file)
+	require.NoError(t, err)
+	defer f.Close()
+	var heights []uint64
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		block := new(Block)
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), block))
+		assert.Equal(t, chainID, block.ChainID)
+		assert.Equal(t, types.ActionUpsert, block.Tables["EventTest"][0].Action)
+		heights = append(heights, block.BlockHeight)
+	}
+	assert.Equal(t, []uint64{3, 5, 6}, heights)
+}
+
+func TestWebhookSink(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vent-sink")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	attempts := 0
+	var received []*Block
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		// Fail every other attempt
+		if attempts%2 == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		block := new(Block)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(block))
+		received = append(received, block)
+	}))
+	defer server.Close()
+
+	ws := NewWebhookSink(server.URL, NewCheckpoint(path.Join(dir, "checkpoint"), chainID), chainID,
+		logger.NewLogger("none"))
+	ws.Backoff = time.Millisecond
+
+	require.NoError(t, ws.SetBlock(nil, eventData(7)))
+	require.Len(t, received, 1)
+	assert.Equal(t, uint64(7), received[0].BlockHeight)
+	height, err := ws.GetLastBlockHeight()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), height)
+
+	// Give up once retries are exhausted without advancing the checkpoint
+	ws.Retries = 0
+	require.Error(t, ws.SetBlock(nil, eventData(8)))
+	height, err = ws.GetLastBlockHeight()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), height)
+}
+
+func TestQueueSink(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vent-sink")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	publisher := &testPublisher{}
+	qs := NewQueueSink(publisher, NewCheckpoint(path.Join(dir, "checkpoint"), chainID), chainID)
+
+	require.NoError(t, qs.SetBlock(nil, eventData(2)))
+	publisher.err = fmt.Errorf("broker unavailable")
+	require.Error(t, qs.SetBlock(nil, eventData(3)))
+
+	require.Len(t, publisher.messages, 1)
+	height, err := qs.GetLastBlockHeight()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), height)
+
+	qs.Close()
+	assert.True(t, publisher.closed)
+}
+
+type testPublisher struct {
+	messages [][]byte
+	err      error
+	closed   bool
+}
+
+func (tp *testPublisher) Publish(message []byte) error {
+	if tp.err != nil {
+		return tp.err
+	}
+	tp.messages = append(tp.messages, message)
+	return nil
+}
+
+func (tp *testPublisher) Close() error {
+	tp.closed = true
+	return nil
+}
+
+func eventData(height uint64) types.EventData {
+	return types.EventData{
+		BlockHeight: height,
+		Tables: map[string]types.EventDataTable{
+			"EventTest": {
+				{
+					Action:  types.ActionUpsert,
+					RowData: map[string]interface{}{"testname": fmt.Sprintf("event at %d", height)},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,100 @@
+package sink
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/hyperledger/burrow/vent/logger"
+	"github.com/hyperledger/burrow/vent/types"
+)
+
+const (
+	DefaultWebhookRetries = 5
+	DefaultWebhookBackoff = time.Second
+	DefaultWebhookTimeout = 10 * time.Second
+)
+
+// WebhookSink posts a JSON Block to a URL for each block, retrying with exponential backoff until the endpoint
+// responds with a 2xx status
+type WebhookSink struct {
+	url        string
+	client     *http.Client
+	checkpoint *Checkpoint
+	chainID    string
+	log        *logger.Logger
+	// Times a post is retried before giving up
+	Retries int
+	// Wait before the first retry, doubling for each subsequent retry
+	Backoff time.Duration
+}
+
+var _ Sink = &WebhookSink{}
+
+func NewWebhookSink(url string, checkpoint *Checkpoint, chainID string, log *logger.Logger) *WebhookSink {
+	return &WebhookSink{
+		url:        url,
+		client:     &http.Client{Timeout: DefaultWebhookTimeout},
+		checkpoint: checkpoint,
+		chainID:    chainID,
+		log:        log,
+		Retries:    DefaultWebhookRetries,
+		Backoff:    DefaultWebhookBackoff,
+	}
+}
+
+// SynchronizeDB is a no-op since messages are self-describing
+func (ws *WebhookSink) SynchronizeDB(eventTables types.EventTables) error {
+	return nil
+}
+
+func (ws *WebhookSink) GetLastBlockHeight() (uint64, error) {
+	return ws.checkpoint.Load()
+}
+
+// SetBlock posts the block and then advances the checkpoint, so a block may be delivered twice but never skipped
+func (ws *WebhookSink) SetBlock(eventTables types.EventTables, eventData types.EventData) error {
+	bs, err := EncodeBlock(ws.chainID, eventData)
+	if err != nil {
+		return err
+	}
+	backoff := ws.Backoff
+	for attempt := 0; ; attempt++ {
+		err = ws.post(bs)
+		if err == nil {
+			return ws.checkpoint.Save(eventData.BlockHeight)
+		}
+		if attempt >= ws.Retries {
+			return fmt.Errorf("could not post block %d to webhook after %d attempts: %v", eventData.BlockHeight,
+				attempt+1, err)
+		}
+		ws.log.Info("msg", "Error posting block to webhook, retrying", "height", eventData.BlockHeight,
+			"backoff", backoff, "err", err)
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+func (ws *WebhookSink) post(bs []byte) error {
+	resp, err := ws.client.Post(ws.url, "application/json", bytes.NewReader(bs))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// drain the body so the connection can be reused
+	io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook %s responded with status %s", ws.url, resp.Status)
+	}
+	return nil
+}
+
+func (ws *WebhookSink) Ping() error {
+	return nil
+}
+
+func (ws *WebhookSink) Close() {
+}
@@ -44,6 +44,10 @@ type DBAdapter interface {
 	CleanDBQueries() types.SQLCleanDBQuery
 	// DropTableQuery builds a DROP TABLE query to delete a table
 	DropTableQuery(tableName string) string
+	// TruncateTableQuery builds a DELETE query to remove every row from a table
+	TruncateTableQuery(tableName string) string
+	// RewindDBQueries returns necessary queries to rewind the database to a given height or reset a single table
+	RewindDBQueries() types.SQLRewindDBQuery
 }
 
 type DBNotifyTriggerAdapter interface {
@@ -245,13 +245,14 @@ func (adapter *PostgresAdapter) SelectRowQuery(tableName, fields, indexValue str
 // SelectLogQuery returns a query for selecting all tables involved in a block trn
 func (adapter *PostgresAdapter) SelectLogQuery() string {
 	query := `
-		SELECT DISTINCT %s,%s FROM %s.%s l WHERE %s = $1 AND %s = $2;`
+		SELECT DISTINCT %s,%s FROM %s.%s l WHERE %s = $1 AND %s = $2 AND %s IN ('%s','%s');`
 
 	return Cleanf(query,
 		types.SQLColumnLabelTableName, types.SQLColumnLabelEventName, // select
 		adapter.Schema, types.SQLLogTableName, // from
 		types.SQLColumnLabelHeight,
-		types.SQLColumnLabelChainID) // where
+		types.SQLColumnLabelChainID, // where
+		types.SQLColumnLabelAction, types.ActionUpsert, types.ActionDelete)
 }
 
 // InsertLogQuery returns a query to insert a row in log table
@@ -484,6 +485,61 @@ func (adapter *PostgresAdapter) DropTableQuery(tableName string) string {
 	return Cleanf(`DROP TABLE %s CASCADE;`, adapter.schemaName(tableName))
 }
 
+func (adapter *PostgresAdapter) TruncateTableQuery(tableName string) string {
+	return Cleanf(`DELETE FROM %s;`, adapter.schemaName(tableName))
+}
+
+func (adapter *PostgresAdapter) RewindDBQueries() types.SQLRewindDBQuery {
+	// heights are stored as varchar in the log so must be cast to compare them numerically, rows logged for
+	// table creation and alteration have no height and are never matched
+	selectLogTablesQry := Cleanf(`
+		SELECT DISTINCT %s
+		FROM %s.%s
+		WHERE %s = $1 AND %s IN ('%s','%s') AND CAST(%s AS NUMERIC) > $2;`,
+		types.SQLColumnLabelTableName,
+		adapter.Schema, types.SQLLogTableName,
+		types.SQLColumnLabelChainID, types.SQLColumnLabelAction, types.ActionUpsert, types.ActionDelete,
+		types.SQLColumnLabelHeight)
+
+	selectTableLogQry := Cleanf(`
+		SELECT %s, %s, %s
+		FROM %s.%s
+		WHERE %s = $1 AND %s = $2 AND %s IN ('%s','%s') AND CAST(%s AS NUMERIC) <= $3
+		ORDER BY %s;`,
+		types.SQLColumnLabelAction, types.SQLColumnLabelSqlStmt, types.SQLColumnLabelSqlValues,
+		adapter.Schema, types.SQLLogTableName,
+		types.SQLColumnLabelChainID, types.SQLColumnLabelTableName,
+		types.SQLColumnLabelAction, types.ActionUpsert, types.ActionDelete,
+		types.SQLColumnLabelHeight,
+		types.SQLColumnLabelId)
+
+	deleteLogQry := Cleanf(`
+		DELETE FROM %s.%s
+		WHERE %s = $1 AND CAST(%s AS NUMERIC) > $2;`,
+		adapter.Schema, types.SQLLogTableName,
+		types.SQLColumnLabelChainID, types.SQLColumnLabelHeight)
+
+	deleteTableLogQry := Cleanf(`
+		DELETE FROM %s.%s
+		WHERE %s = $1 AND %s = $2;`,
+		adapter.Schema, types.SQLLogTableName,
+		types.SQLColumnLabelChainID, types.SQLColumnLabelTableName)
+
+	deleteTableDictionaryQry := Cleanf(`
+		DELETE FROM %s.%s
+		WHERE %s = $1;`,
+		adapter.Schema, types.SQLDictionaryTableName,
+		types.SQLColumnLabelTableName)
+
+	return types.SQLRewindDBQuery{
+		SelectLogTablesQry:       selectLogTablesQry,
+		SelectTableLogQry:        selectTableLogQry,
+		DeleteLogQry:             deleteLogQry,
+		DeleteTableLogQry:        deleteTableLogQry,
+		DeleteTableDictionaryQry: deleteTableDictionaryQry,
+	}
+}
+
 func (adapter *PostgresAdapter) CreateNotifyFunctionQuery(function, channel string, columns ...string) string {
 	return Cleanf(`CREATE OR REPLACE FUNCTION %s() RETURNS trigger AS
 		$trigger$
@@ -216,13 +216,14 @@ func (adapter *SQLiteAdapter) SelectRowQuery(tableName, fields, indexValue strin
 // SelectLogQuery returns a query for selecting all tables involved in a block trn
 func (adapter *SQLiteAdapter) SelectLogQuery() string {
 	query := `
-		SELECT DISTINCT %s,%s FROM %s l WHERE %s = $1 AND %s = $2;`
+		SELECT DISTINCT %s,%s FROM %s l WHERE %s = $1 AND %s = $2 AND %s IN ('%s','%s');`
 
 	return Cleanf(query,
 		types.SQLColumnLabelTableName, types.SQLColumnLabelEventName, // select
 		types.SQLLogTableName,      // from
 		types.SQLColumnLabelHeight, // where
-		types.SQLColumnLabelChainID)
+		types.SQLColumnLabelChainID,
+		types.SQLColumnLabelAction, types.ActionUpsert, types.ActionDelete)
 }
 
 // InsertLogQuery returns a query to insert a row in log table
@@ -466,3 +467,58 @@ func (adapter *SQLiteAdapter) DropTableQuery(tableName string) string {
 	// SQLite does not support DROP TABLE CASCADE so this will fail if there are dependent objects
 	return Cleanf(`DROP TABLE %s;`, adapter.SecureName(tableName))
 }
+
+func (adapter *SQLiteAdapter) TruncateTableQuery(tableName string) string {
+	return Cleanf(`DELETE FROM %s;`, adapter.SecureName(tableName))
+}
+
+func (adapter *SQLiteAdapter) RewindDBQueries() types.SQLRewindDBQuery {
+	// heights are stored as varchar in the log so must be cast to compare them numerically, rows logged for
+	// table creation and alteration have no height and are never matched
+	selectLogTablesQry := Cleanf(`
+		SELECT DISTINCT %s
+		FROM %s
+		WHERE %s = $1 AND %s IN ('%s','%s') AND CAST(%s AS INTEGER) > $2;`,
+		types.SQLColumnLabelTableName,
+		types.SQLLogTableName,
+		types.SQLColumnLabelChainID, types.SQLColumnLabelAction, types.ActionUpsert, types.ActionDelete,
+		types.SQLColumnLabelHeight)
+
+	selectTableLogQry := Cleanf(`
+		SELECT %s, %s, %s
+		FROM %s
+		WHERE %s = $1 AND %s = $2 AND %s IN ('%s','%s') AND CAST(%s AS INTEGER) <= $3
+		ORDER BY %s;`,
+		types.SQLColumnLabelAction, types.SQLColumnLabelSqlStmt, types.SQLColumnLabelSqlValues,
+		types.SQLLogTableName,
+		types.SQLColumnLabelChainID, types.SQLColumnLabelTableName,
+		types.SQLColumnLabelAction, types.ActionUpsert, types.ActionDelete,
+		types.SQLColumnLabelHeight,
+		types.SQLColumnLabelId)
+
+	deleteLogQry := Cleanf(`
+		DELETE FROM %s
+		WHERE %s = $1 AND CAST(%s AS INTEGER) > $2;`,
+		types.SQLLogTableName,
+		types.SQLColumnLabelChainID, types.SQLColumnLabelHeight)
+
+	deleteTableLogQry := Cleanf(`
+		DELETE FROM %s
+		WHERE %s = $1 AND %s = $2;`,
+		types.SQLLogTableName,
+		types.SQLColumnLabelChainID, types.SQLColumnLabelTableName)
+
+	deleteTableDictionaryQry := Cleanf(`
+		DELETE FROM %s
+		WHERE %s = $1;`,
+		types.SQLDictionaryTableName,
+		types.SQLColumnLabelTableName)
+
+	return types.SQLRewindDBQuery{
+		SelectLogTablesQry:       selectLogTablesQry,
+		SelectTableLogQry:        selectTableLogQry,
+		DeleteLogQry:             deleteLogQry,
+		DeleteTableLogQry:        deleteTableLogQry,
+		DeleteTableDictionaryQry: deleteTableDictionaryQry,
+	}
+}
@@ -88,3 +88,11 @@ func (*SQLiteAdapter) CleanDBQueries() types.SQLCleanDBQuery {
 func (*SQLiteAdapter) DropTableQuery(tableName string) string {
 	panic("implement me")
 }
+
+func (*SQLiteAdapter) TruncateTableQuery(tableName string) string {
+	panic("implement me")
+}
+
+func (*SQLiteAdapter) RewindDBQueries() types.SQLRewindDBQuery {
+	panic("implement me")
+}
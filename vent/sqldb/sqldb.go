@@ -572,6 +572,10 @@ func (db *SQLDB) RestoreDB(time time.Time, prefix string) error {
 				db.Log.Info("msg", "Error executing alter/create table command ", "err", err, "value", sqlSmt)
 				return err
 			}
+		case types.ActionRewind, types.ActionReproject:
+			// Markers carry no SQL to restore
+			continue
+
 		default:
 			// Invalid Action
 			db.Log.Info("msg", "invalid action", "value", action)
@@ -580,3 +584,162 @@ func (db *SQLDB) RestoreDB(time time.Time, prefix string) error {
 	}
 	return nil
 }
+
+// RewindToHeight reverts every table changed after the given height to its state at that height by discarding later
+// entries from the log and replaying the remaining log for each of those tables, the rewind is recorded in the log so
+// that consumption resumes from the block following height
+func (db *SQLDB) RewindToHeight(height uint64) error {
+	var tx *sql.Tx
+	var err error
+
+	rewindQueries := db.DBAdapter.RewindDBQueries()
+
+	db.Log.Info("msg", "REWINDING DB..................................", "height", height)
+
+	// Begin tx
+	if tx, err = db.DB.Begin(); err != nil {
+		db.Log.Info("msg", "Error beginning transaction", "err", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	// Load tables changed after height
+	query := rewindQueries.SelectLogTablesQry
+	rows, err := tx.Query(query, db.ChainID, height)
+	if err != nil {
+		db.Log.Info("msg", "error querying log", "err", err, "query", query)
+		return err
+	}
+
+	tables := make([]string, 0)
+	for rows.Next() {
+		var tableName string
+		if err = rows.Scan(&tableName); err != nil {
+			rows.Close()
+			db.Log.Info("msg", "error scanning table name", "err", err)
+			return err
+		}
+		tables = append(tables, tableName)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		db.Log.Info("msg", "error during rows iteration", "err", err)
+		return err
+	}
+
+	// Delete log
+	query = rewindQueries.DeleteLogQry
+	if _, err = tx.Exec(query, db.ChainID, height); err != nil {
+		db.Log.Info("msg", "Error deleting log", "err", err, "query", query)
+		return err
+	}
+
+	// Rebuild tables from the remaining log
+	for _, tableName := range tables {
+		query = db.DBAdapter.TruncateTableQuery(tableName)
+		db.Log.Info("msg", "TRUNCATE TABLE", "query", query)
+		if _, err = tx.Exec(query); err != nil {
+			// if error == table does not exists, continue
+			if db.DBAdapter.ErrorEquals(err, types.SQLErrorTypeUndefinedTable) {
+				db.Log.Warn("msg", "Table not found", "value", tableName)
+				continue
+			}
+			db.Log.Info("msg", "Error truncating table", "err", err, "value", tableName)
+			return err
+		}
+
+		if err = db.replayTableLog(tx, tableName, height); err != nil {
+			return err
+		}
+	}
+
+	// Insert rewind marker in log
+	if err = db.insertMarker(tx, "", height, types.ActionRewind); err != nil {
+		return err
+	}
+
+	// Commit
+	if err = tx.Commit(); err != nil {
+		db.Log.Info("msg", "Error commiting transaction", "err", err)
+		return err
+	}
+
+	return nil
+}
+
+// ResetTable drops a table along with its dictionary and log entries and creates it empty from the given
+// structure, leaving every other table untouched
+func (db *SQLDB) ResetTable(table *types.SQLTable) error {
+	var tx *sql.Tx
+	var err error
+
+	rewindQueries := db.DBAdapter.RewindDBQueries()
+	safeTable := safe(table.Name)
+
+	db.Log.Info("msg", "Resetting table", "value", safeTable)
+
+	// Begin tx
+	if tx, err = db.DB.Begin(); err != nil {
+		db.Log.Info("msg", "Error beginning transaction", "err", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	// Delete log
+	query := rewindQueries.DeleteTableLogQry
+	if _, err = tx.Exec(query, db.ChainID, safeTable); err != nil {
+		db.Log.Info("msg", "Error deleting table log", "err", err, "query", query)
+		return err
+	}
+
+	// Delete dictionary
+	query = rewindQueries.DeleteTableDictionaryQry
+	if _, err = tx.Exec(query, safeTable); err != nil {
+		db.Log.Info("msg", "Error deleting table dictionary", "err", err, "query", query)
+		return err
+	}
+
+	// Drop table
+	query = db.DBAdapter.DropTableQuery(safeTable)
+	if _, err = tx.Exec(query); err != nil {
+		// if error == table does not exists, continue
+		if !db.DBAdapter.ErrorEquals(err, types.SQLErrorTypeUndefinedTable) {
+			db.Log.Info("msg", "error dropping table", "err", err, "value", safeTable, "query", query)
+			return err
+		}
+	}
+
+	// Commit
+	if err = tx.Commit(); err != nil {
+		db.Log.Info("msg", "Error commiting transaction", "err", err)
+		return err
+	}
+
+	return db.createTable(table, false)
+}
+
+// MarkReprojected records in the log that a table has been rebuilt up to the given height so that consumption
+// resumes from the block following height
+func (db *SQLDB) MarkReprojected(tableName string, height uint64) error {
+	var tx *sql.Tx
+	var err error
+
+	// Begin tx
+	if tx, err = db.DB.Begin(); err != nil {
+		db.Log.Info("msg", "Error beginning transaction", "err", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	if err = db.insertMarker(tx, safe(tableName), height, types.ActionReproject); err != nil {
+		return err
+	}
+
+	// Commit
+	if err = tx.Commit(); err != nil {
+		db.Log.Info("msg", "Error commiting transaction", "err", err)
+		return err
+	}
+
+	return nil
+}
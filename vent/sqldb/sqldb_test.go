@@ -117,6 +117,88 @@ func testSetBlock(t *testing.T, cfg *config.VentConfig) {
 	})
 }
 
+func testRewind(t *testing.T, cfg *config.VentConfig) {
+	t.Run(fmt.Sprintf("%s: successfully rewinds to a previous height", cfg.DBAdapter),
+		func(t *testing.T) {
+			db, closeDB := test.NewTestDB(t, "CHAIN 123", cfg)
+			defer closeDB()
+
+			table := &types.SQLTable{
+				Name: "test_rewind",
+				Columns: []*types.SQLTableColumn{
+					{Name: "test_id", Type: types.SQLColumnTypeInt, Primary: true},
+					{Name: "col1", Type: types.SQLColumnTypeVarchar, Length: 100, Primary: false},
+					{Name: "_height", Type: types.SQLColumnTypeVarchar, Length: 100, Primary: false},
+				},
+			}
+			tables := types.EventTables{table.Name: table}
+
+			setRow := func(height uint64, action types.DBAction, id, value string) {
+				dat := types.EventData{
+					BlockHeight: height,
+					Tables: map[string]types.EventDataTable{
+						table.Name: {{Action: action, RowData: map[string]interface{}{"test_id": id, "col1": value, "_height": height}}},
+					},
+				}
+				require.NoError(t, db.SetBlock(tables, dat))
+			}
+
+			setRow(1, types.ActionUpsert, "1", "first")
+			setRow(2, types.ActionUpsert, "2", "second")
+			setRow(3, types.ActionUpsert, "1", "updated")
+			setRow(4, types.ActionDelete, "2", "second")
+
+			err := db.RewindToHeight(2)
+			require.NoError(t, err)
+
+			height, err := db.GetLastBlockHeight()
+			require.NoError(t, err)
+			require.Equal(t, uint64(2), height)
+
+			eventData, err := db.GetBlock(1)
+			require.NoError(t, err)
+			require.Equal(t, 1, len(eventData.Tables[table.Name]))
+			require.Equal(t, "first", eventData.Tables[table.Name][0].RowData["col1"])
+
+			eventData, err = db.GetBlock(2)
+			require.NoError(t, err)
+			require.Equal(t, 1, len(eventData.Tables[table.Name]))
+			require.Equal(t, "second", eventData.Tables[table.Name][0].RowData["col1"])
+
+			// rows from rewound blocks are no longer logged
+			eventData, err = db.GetBlock(3)
+			require.NoError(t, err)
+			require.Equal(t, 0, len(eventData.Tables))
+		})
+
+	t.Run(fmt.Sprintf("%s: successfully resets a single table", cfg.DBAdapter),
+		func(t *testing.T) {
+			db, closeDB := test.NewTestDB(t, "CHAIN 123", cfg)
+			defer closeDB()
+
+			str, dat := getBlock()
+			err := db.SetBlock(str, dat)
+			require.NoError(t, err)
+
+			table := str["3"]
+			table.Columns = append(table.Columns, &types.SQLTableColumn{Name: "val_reset", Type: types.SQLColumnTypeInt})
+			err = db.ResetTable(table)
+			require.NoError(t, err)
+
+			err = db.MarkReprojected(table.Name, dat.BlockHeight)
+			require.NoError(t, err)
+
+			height, err := db.GetLastBlockHeight()
+			require.NoError(t, err)
+			require.Equal(t, dat.BlockHeight, height)
+
+			eventData, err := db.GetBlock(dat.BlockHeight)
+			require.NoError(t, err)
+			require.Equal(t, 0, len(eventData.Tables[table.Name]))
+			require.Equal(t, 4, len(eventData.Tables["test_table1"]))
+		})
+}
+
 func getBlock() (types.EventTables, types.EventData) {
 	longtext := "qwertyuiopasdfghjklzxcvbnm1234567890QWERTYUIOPASDFGHJKLZXCVBNM"
 	longtext = fmt.Sprintf("%s %s %s %s %s", longtext, longtext, longtext, longtext, longtext)
@@ -1,6 +1,7 @@
 package sqldb
 
 import (
+	"database/sql"
 	"errors"
 	"fmt"
 	"strconv"
@@ -462,6 +463,74 @@ func (db *SQLDB) getBlockTables(chainid string, height uint64) (types.EventTable
 	return tables, nil
 }
 
+// replayTableLog re-executes the upserts and deletes logged for a table up to and including the given height
+func (db *SQLDB) replayTableLog(tx *sql.Tx, tableName string, height uint64) error {
+	type logEntry struct {
+		action    types.DBAction
+		sqlStmt   string
+		sqlValues string
+	}
+
+	query := db.DBAdapter.RewindDBQueries().SelectTableLogQry
+	db.Log.Info("msg", "QUERY LOG", "query", query, "value", tableName, "height", height)
+
+	rows, err := tx.Query(query, db.ChainID, tableName, height)
+	if err != nil {
+		db.Log.Info("msg", "Error querying log", "err", err)
+		return err
+	}
+
+	// entries must be read in full before executing them since the transaction cannot be shared with open rows
+	var entries []logEntry
+	for rows.Next() {
+		var entry logEntry
+		if err = rows.Scan(&entry.action, &entry.sqlStmt, &entry.sqlValues); err != nil {
+			rows.Close()
+			db.Log.Info("msg", "Error scanning log", "err", err)
+			return err
+		}
+		entries = append(entries, entry)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		db.Log.Info("msg", "Error during rows iteration", "err", err)
+		return err
+	}
+
+	for _, entry := range entries {
+		pointers, err := db.getExactValuesFromJSON(entry.sqlValues)
+		if err != nil {
+			db.Log.Info("msg", "error unmarshaling json", "err", err, "value", entry.sqlValues)
+			return err
+		}
+
+		db.Log.Info("msg", "SQL COMMAND", "sql", entry.sqlStmt)
+		if _, err = tx.Exec(entry.sqlStmt, pointers...); err != nil {
+			db.Log.Info("msg", fmt.Sprintf("Error replaying %s", entry.action), "err", err, "value", entry.sqlStmt,
+				"data", entry.sqlValues)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// insertMarker inserts a log row with no SQL of its own fixing the last processed height
+func (db *SQLDB) insertMarker(tx *sql.Tx, tableName string, height uint64, action types.DBAction) error {
+	logQuery := db.DBAdapter.InsertLogQuery()
+	jsonData, _ := db.getJSON(nil)
+	sqlValues, _ := db.getJSONFromValues(nil)
+
+	db.Log.Info("msg", "INSERT LOG", "query", logQuery, "value",
+		fmt.Sprintf("chainid = %s tableName = %s action = %s block = %d", db.ChainID, tableName, action, height))
+
+	if _, err := tx.Exec(logQuery, db.ChainID, tableName, "", "", height, nil, action, jsonData, "", sqlValues); err != nil {
+		db.Log.Info("msg", "Error inserting into log", "err", err)
+		return err
+	}
+	return nil
+}
+
 // safe sanitizes a parameter
 func safe(parameter string) string {
 	replacer := strings.NewReplacer(";", "", ",", "")
@@ -491,3 +560,13 @@ func (db *SQLDB) getValuesFromJSON(JSON string) ([]interface{}, error) {
 	err := json.Unmarshal(bytes, &pointers)
 	return pointers, err
 }
+
+//getExactValuesFromJSON returns query values from unmarshaled JSON column with numbers decoded as json.Number to
+//preserve their exact textual representation (e.g. heights compared when rewinding)
+func (db *SQLDB) getExactValuesFromJSON(JSON string) ([]interface{}, error) {
+	pointers := make([]interface{}, 0)
+	decoder := json.NewDecoder(strings.NewReader(JSON))
+	decoder.UseNumber()
+	err := decoder.Decode(&pointers)
+	return pointers, err
+}
@@ -172,6 +172,54 @@ func (p *Projection) GetColumn(tableName, columnName string) (*types.SQLTableCol
 	return nil, fmt.Errorf("GetColumn: table does not exist projection: %s ", tableName)
 }
 
+// ForTable returns a projection restricted to a single table and the event classes that project onto it
+func (p *Projection) ForTable(tableName string) (*Projection, error) {
+	table, ok := p.Tables[tableName]
+	if !ok {
+		return nil, fmt.Errorf("ForTable: table does not exist projection: %s ", tableName)
+	}
+
+	eventSpec := types.EventSpec{}
+	for _, eventClass := range p.EventSpec {
+		if eventClass.TableName == tableName {
+			eventSpec = append(eventSpec, eventClass)
+		}
+	}
+
+	return &Projection{
+		Tables:    types.EventTables{tableName: table},
+		EventSpec: eventSpec,
+	}, nil
+}
+
+// NewEventClasses restricts the projection to the event classes projecting into tableName that old does not have, or
+// returns nil if there are none
+func (p *Projection) NewEventClasses(old *Projection, tableName string) (*Projection, error) {
+	projection, err := p.ForTable(tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	oldFilters := make(map[string]bool)
+	for _, eventClass := range old.EventSpec {
+		if eventClass.TableName == tableName {
+			oldFilters[eventClass.Filter] = true
+		}
+	}
+
+	eventSpec := types.EventSpec{}
+	for _, eventClass := range projection.EventSpec {
+		if !oldFilters[eventClass.Filter] {
+			eventSpec = append(eventSpec, eventClass)
+		}
+	}
+	if len(eventSpec) == 0 {
+		return nil, nil
+	}
+	projection.EventSpec = eventSpec
+	return projection, nil
+}
+
 func ValidateJSONEventSpec(bs []byte) error {
 	schemaLoader := gojsonschema.NewGoLoader(types.EventSpecSchema())
 	specLoader := gojsonschema.NewBytesLoader(bs)
@@ -214,7 +262,10 @@ func getSQLType(evmSignature string, bytesToString bool) (types.SQLColumnType, i
 	typeSize, _ := strconv.Atoi(re.FindString(evmSignature))
 
 	switch {
-	// solidity address => sql varchar
+	// solidity arrays => sql text holding their elements in brackets
+	case strings.HasSuffix(evmSignature, "]"):
+		return types.SQLColumnTypeText, 0, nil
+		// solidity address => sql varchar
 	case evmSignature == types.EventFieldTypeAddress:
 		return types.SQLColumnTypeVarchar, 40, nil
 		// solidity bool => sql bool
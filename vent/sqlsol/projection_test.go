@@ -134,6 +134,67 @@ func TestGetEventSpec(t *testing.T) {
 	})
 }
 
+func TestForTable(t *testing.T) {
+	goodJSON := test.GoodJSONConfFile(t)
+
+	byteValue := []byte(goodJSON)
+	tableStruct, _ := sqlsol.NewProjectionFromBytes(byteValue)
+
+	t.Run("successfully restricts projection to a single table", func(t *testing.T) {
+		projection, err := tableStruct.ForTable("TEST_TABLE")
+		require.NoError(t, err)
+		require.Equal(t, 1, len(projection.Tables))
+		require.Equal(t, "TEST_TABLE", projection.Tables["TEST_TABLE"].Name)
+		require.Equal(t, 1, len(projection.EventSpec))
+		require.Equal(t, "Log1Text = 'EVENT_TEST'", projection.EventSpec[0].Filter)
+	})
+
+	t.Run("unsuccessfully restricts projection to a non existent table", func(t *testing.T) {
+		_, err := tableStruct.ForTable("NOT_EXISTS")
+		require.Error(t, err)
+	})
+}
+
+func TestNewEventClasses(t *testing.T) {
+	eventClass := func(filter, field string) *types.EventClass {
+		return &types.EventClass{
+			TableName: "BurnNotices",
+			Filter:    filter,
+			FieldMappings: []*types.EventFieldMapping{
+				{
+					Field:      field,
+					Type:       types.EventFieldTypeString,
+					ColumnName: "name",
+					Primary:    true,
+				},
+			},
+		}
+	}
+	old, err := sqlsol.NewProjectionFromEventSpec(types.EventSpec{eventClass("LOG1Text = 'CIA/burn'", "codename")})
+	require.NoError(t, err)
+
+	projection, err := sqlsol.NewProjectionFromEventSpec(types.EventSpec{
+		eventClass("LOG1Text = 'CIA/burn'", "codename"),
+		eventClass("LOG1Text = 'MI5/burn'", "alias"),
+	})
+	require.NoError(t, err)
+
+	t.Run("restricts projection to event classes not in the old projection", func(t *testing.T) {
+		history, err := projection.NewEventClasses(old, "BurnNotices")
+		require.NoError(t, err)
+		require.NotNil(t, history)
+		require.Equal(t, 1, len(history.EventSpec))
+		require.Equal(t, "LOG1Text = 'MI5/burn'", history.EventSpec[0].Filter)
+		require.Equal(t, "BurnNotices", history.Tables["BurnNotices"].Name)
+	})
+
+	t.Run("returns nil when there are no new event classes", func(t *testing.T) {
+		history, err := old.NewEventClasses(projection, "BurnNotices")
+		require.NoError(t, err)
+		require.Nil(t, history)
+	})
+}
+
 func TestNewProjectionFromEventSpec(t *testing.T) {
 	tableName := "BurnNotices"
 	eventSpec := types.EventSpec{
@@ -23,16 +23,29 @@ func NewTransactClient(t testing.TB, listenAddress string) rpctransact.TransactC
 
 func CreateContract(t testing.TB, cli rpctransact.TransactClient, inputAddress crypto.Address) *exec.TxExecution {
 	t.Helper()
+	return createContract(t, cli, inputAddress, "")
+}
+
+// Create the contract storing its ABI on chain
+func CreateContractWithAbi(t testing.TB, cli rpctransact.TransactClient, inputAddress crypto.Address) *exec.TxExecution {
+	t.Helper()
+	return createContract(t, cli, inputAddress, string(Abi_EventsTest))
+}
+
+func createContract(t testing.TB, cli rpctransact.TransactClient, inputAddress crypto.Address,
+	contractMeta string) *exec.TxExecution {
+	t.Helper()
 
 	txe, err := cli.CallTxSync(context.Background(), &payload.CallTx{
 		Input: &payload.TxInput{
 			Address: inputAddress,
 			Amount:  2,
 		},
-		Address:  nil,
-		Data:     Bytecode_EventsTest,
-		Fee:      2,
-		GasLimit: 10000,
+		Address:      nil,
+		Data:         Bytecode_EventsTest,
+		Fee:          2,
+		GasLimit:     10000,
+		ContractMeta: contractMeta,
 	})
 	require.NoError(t, err)
 
@@ -9,6 +9,9 @@ const (
 	ActionRead        DBAction = "READ"
 	ActionCreateTable DBAction = "CREATE"
 	ActionAlterTable  DBAction = "ALTER"
+	// Markers recorded in the log to fix the height from which consumption resumes
+	ActionRewind    DBAction = "REWIND"
+	ActionReproject DBAction = "REPROJECT"
 )
 
 // EventData contains data for each block of events
@@ -19,3 +19,12 @@ type SQLCleanDBQuery struct {
 	DeleteDictionaryQry string
 	DeleteLogQry        string
 }
+
+// SQLRewindDBQuery stores queries needed to rewind the database to a previous height or reset a single table
+type SQLRewindDBQuery struct {
+	SelectLogTablesQry       string
+	SelectTableLogQry        string
+	DeleteLogQry             string
+	DeleteTableLogQry        string
+	DeleteTableDictionaryQry string
+}